@@ -3,11 +3,16 @@ package builder
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"io/ioutil"
+	"os"
 	"os/exec"
+	"strings"
 
+	"github.com/blocklayerhq/chainkit/hooks"
 	"github.com/blocklayerhq/chainkit/ui"
+	"github.com/pkg/errors"
 )
 
 // Builder is a wrapper around `docker build` which provides a better UX.
@@ -21,8 +26,17 @@ type Builder struct {
 type BuildOpts struct {
 	Verbose bool
 	NoCache bool
+	// Force builds even if the sources didn't change since the last build.
+	// Implied by NoCache.
+	Force bool
+	// Hooks receives the pre-build and post-build events (optional).
+	Hooks *hooks.Dispatcher
 }
 
+// sourcesLabel is the label of the images recording the hash of the
+// sources they were built from.
+const sourcesLabel = "chainkit.sources"
+
 // New creates a new Builder.
 func New(rootDir, image string) *Builder {
 	return &Builder{
@@ -32,56 +46,89 @@ func New(rootDir, image string) *Builder {
 	}
 }
 
-// Build executes a build.
+// Build executes a build. It is skipped, after the pre-build hooks which may
+// generate sources, if the image was built from the current sources.
+//
+// Builds use BuildKit, whose cache mounts keep the Go build and module
+// caches across builds.
 func (b *Builder) Build(ctx context.Context, opts BuildOpts) error {
-	args := []string{"build", "-t", b.image}
+	if err := opts.Hooks.Fire(ctx, hooks.PreBuild, map[string]string{"image": b.image}); err != nil {
+		return err
+	}
+
+	hash, err := SourcesHash(b.rootDir)
+	if err != nil {
+		return errors.Wrap(err, "unable to hash the sources")
+	}
+	if !opts.Force && !opts.NoCache && b.imageSources(ctx) == hash {
+		ui.Success("Build skipped: the sources didn't change since the last build")
+		return nil
+	}
+
+	args := []string{
+		"build", "-t", b.image,
+		"--label", sourcesLabel + "=" + hash,
+		"--progress", "plain",
+	}
 	if opts.NoCache {
 		args = append(args, "--no-cache")
 	}
 	args = append(args, b.rootDir)
 	cmd := exec.CommandContext(ctx, "docker", args...)
-	outReader, err := cmd.StdoutPipe()
-	if err != nil {
-		return err
-	}
-	defer outReader.Close()
-	errReader, err := cmd.StderrPipe()
-	if err != nil {
-		return err
-	}
-	defer errReader.Close()
+	cmd.Env = append(os.Environ(), "DOCKER_BUILDKIT=1")
 
-	// Combine stdout and stderr into a single reader.
-	cmdReader := io.MultiReader(outReader, errReader)
+	// Combine stdout and stderr into a single reader: BuildKit reports the
+	// progress on stderr.
+	outReader, outWriter := io.Pipe()
+	cmd.Stdout = outWriter
+	cmd.Stderr = outWriter
 
 	// Keep the build output as a buffer.
 	// We'll need it to log build errors.
 	var output bytes.Buffer
-	tee := io.TeeReader(cmdReader, &output)
+	tee := io.TeeReader(outReader, &output)
 
-	errCh := make(chan error)
+	errCh := make(chan error, 1)
 	go func() {
 		defer close(errCh)
 		errCh <- b.parser.Parse(tee, opts)
 	}()
 	err = cmd.Start()
 	if err != nil {
+		outWriter.Close()
 		return err
 	}
 
 	err = cmd.Wait()
+	outWriter.Close()
+	parseErr := <-errCh
 	if err != nil {
 		b.buildLog(output)
 		return err
 	}
-
-	if err := <-errCh; err != nil {
+	if parseErr != nil {
 		b.buildLog(output)
-		return err
+		return parseErr
 	}
 
-	ui.Success("Build successful")
-	return nil
+	if cached, total := b.parser.CacheHits(); total > 0 {
+		ui.Success("Build successful (%d/%d steps cached)", cached, total)
+	} else {
+		ui.Success("Build successful")
+	}
+
+	return opts.Hooks.Fire(ctx, hooks.PostBuild, map[string]string{"image": b.image})
+}
+
+// imageSources returns the hash of the sources the image was built from, or
+// an empty string if unknown.
+func (b *Builder) imageSources(ctx context.Context) string {
+	format := fmt.Sprintf("{{ index .Config.Labels %q }}", sourcesLabel)
+	out, err := exec.CommandContext(ctx, "docker", "image", "inspect", "--format", format, b.image+":latest").Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
 }
 
 func (b *Builder) buildLog(output bytes.Buffer) error {
@@ -95,6 +142,9 @@ func (b *Builder) buildLog(output bytes.Buffer) error {
 		return err
 	}
 
+	for _, e := range compilerErrors(output.Bytes()) {
+		ui.Error("    %s", e)
+	}
 	ui.Error("A complete log of this build can be found in:")
 	ui.Error("    %s", logfile.Name())
 
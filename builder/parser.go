@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"fmt"
 	"io"
+	"regexp"
 	"strings"
 
 	"github.com/acarl005/stripansi"
@@ -11,14 +12,44 @@ import (
 	"github.com/schollz/progressbar"
 )
 
+// buildkitStepRegexp matches the steps of the plain BuildKit output (e.g.
+// "#5 [build-env 2/7] RUN apk add --no-cache git").
+var buildkitStepRegexp = regexp.MustCompile(`^#(\d+) \[[^\]]*\d+/\d+\] (.*)$`)
+
+// buildkitCachedRegexp matches the steps of the plain BuildKit output whose
+// result came from the cache (e.g. "#5 CACHED").
+var buildkitCachedRegexp = regexp.MustCompile(`^#(\d+) CACHED$`)
+
+// compilerErrorRegexp matches the errors of the Go compiler within the build
+// output (e.g. "#12 3.141 ./app.go:42:2: undefined: foo").
+var compilerErrorRegexp = regexp.MustCompile(`[\w./-]+\.go:\d+(:\d+)?: .*$`)
+
+// compilerErrors returns the errors of the Go compiler found in output.
+func compilerErrors(output []byte) []string {
+	errs := []string{}
+	for _, line := range strings.Split(stripansi.Strip(string(output)), "\n") {
+		if m := compilerErrorRegexp.FindString(line); m != "" {
+			errs = append(errs, m)
+		}
+	}
+	return errs
+}
+
 // Parser is the build output parser
 type Parser struct {
 	progress *progressbar.ProgressBar
+
+	// steps are the build steps seen so far, by id. cached counts those
+	// whose result came from the cache.
+	steps  map[string]bool
+	cached int
 }
 
 // Parse parses the build output
 func (p *Parser) Parse(r io.Reader, opts BuildOpts) error {
 	scanner := bufio.NewScanner(r)
+	p.steps = map[string]bool{}
+	p.cached = 0
 
 	// Clear the console on exit.
 	defer ui.Live("")
@@ -34,10 +65,28 @@ func (p *Parser) Parse(r io.Reader, opts BuildOpts) error {
 	return nil
 }
 
+// CacheHits returns the number of build steps of the last build whose
+// result came from the cache, and the total number of steps.
+func (p *Parser) CacheHits() (cached, total int) {
+	return p.cached, len(p.steps)
+}
+
 func (p *Parser) processLine(text string, opts BuildOpts) {
 	// Print the current build step.
-	if strings.HasPrefix(text, "Step ") {
+	switch {
+	case strings.HasPrefix(text, "Step "):
+		p.steps[strings.SplitN(text, " ", 3)[1]] = true
 		p.processStep(text)
+	case strings.TrimSpace(text) == "---> Using cache":
+		p.cached++
+	default:
+		if m := buildkitStepRegexp.FindStringSubmatch(text); m != nil && !p.steps[m[1]] {
+			p.steps[m[1]] = true
+			p.processStep(m[2])
+		}
+		if m := buildkitCachedRegexp.FindStringSubmatch(text); m != nil && p.steps[m[1]] {
+			p.cached++
+		}
 	}
 
 	// If we're in verbose mode, just print the line.
@@ -57,13 +106,15 @@ func (p *Parser) processLine(text string, opts BuildOpts) {
 
 func (p *Parser) processStep(text string) {
 	switch {
-	case strings.Contains(text, "RUN apk add --no-cache"):
+	case strings.Contains(text, "apk add --no-cache"):
 		fmt.Println(ui.Small("[1/4]"), "📦 Setting up the build environment...")
-	case strings.Contains(text, "RUN dep ensure"):
+	case strings.Contains(text, "dep ensure"), strings.Contains(text, "go mod download"):
 		fmt.Println(ui.Small("[2/4]"), "🔎 Fetching dependencies...")
-	case strings.Contains(text, "RUN find vendor"):
+	case strings.Contains(text, "find vendor"):
 		fmt.Println(ui.Small("[3/4]"), "🔗 Installing dependencies...")
-	case strings.Contains(text, "RUN     CGO_ENABLED=0 go build"):
+	case strings.Contains(text, "go mod verify"):
+		fmt.Println(ui.Small("[3/4]"), "🔒 Verifying dependencies...")
+	case strings.Contains(text, "go build"):
 		fmt.Println(ui.Small("[4/4]"), "🔨 Compiling application...")
 	}
 }
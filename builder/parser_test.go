@@ -0,0 +1,106 @@
+package builder
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCacheHits(t *testing.T) {
+	tests := []struct {
+		name   string
+		output string
+		cached int
+		total  int
+	}{
+		{
+			name: "buildkit",
+			output: `#1 [internal] load build definition from Dockerfile
+#1 transferring dockerfile: 37B done
+#1 DONE 0.0s
+
+#2 [internal] load .dockerignore
+#2 DONE 0.0s
+
+#3 [build-env 1/4] FROM docker.io/library/golang:alpine
+#3 DONE 0.0s
+
+#4 [build-env 2/4] RUN apk add --no-cache git
+#4 CACHED
+
+#5 [build-env 3/4] COPY . .
+#5 CACHED
+
+#6 [build-env 4/4] RUN go build -o /bin/appd ./cmd/appd
+#6 0.312 go: downloading github.com/cosmos/cosmos-sdk v0.26.0
+#6 DONE 12.3s
+
+#6 [build-env 4/4] RUN go build -o /bin/appd ./cmd/appd
+`,
+			cached: 2,
+			total:  4,
+		},
+		{
+			name: "buildkit with colors",
+			output: "\x1b[34m#4 [build-env 2/4] RUN apk add --no-cache git\x1b[0m\n" +
+				"\x1b[34m#4 CACHED\x1b[0m\n",
+			cached: 1,
+			total:  1,
+		},
+		{
+			name: "buildkit cached steps which were not announced",
+			output: `#2 [internal] load .dockerignore
+#2 CACHED
+#7 CACHED
+`,
+			cached: 0,
+			total:  0,
+		},
+		{
+			name: "legacy builder",
+			output: `Step 1/3 : FROM golang:alpine
+ ---> 3c67a5ac2a2b
+Step 2/3 : RUN apk add --no-cache git
+ ---> Using cache
+ ---> 1fbd1e2a4f3c
+Step 3/3 : RUN go build ./cmd/appd
+ ---> Running in 6b4c8f1e2d3a
+`,
+			cached: 1,
+			total:  3,
+		},
+		{
+			name:   "empty",
+			output: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &Parser{}
+			if err := p.Parse(strings.NewReader(tt.output), BuildOpts{}); err != nil {
+				t.Fatal(err)
+			}
+			cached, total := p.CacheHits()
+			if cached != tt.cached || total != tt.total {
+				t.Fatalf("got %d/%d cached steps, expected %d/%d", cached, total, tt.cached, tt.total)
+			}
+		})
+	}
+}
+
+func TestCompilerErrors(t *testing.T) {
+	output := []byte(`#6 [build-env 4/4] RUN go build ./cmd/appd
+#6 2.104 # github.com/example/app/x/names
+#6 2.104 x/names/handler.go:42:2: undefined: foo
+#6 2.105 x/names/keeper.go:7: imported and not used: "fmt"
+#6 ERROR: executor failed running [/bin/sh -c go build ./cmd/appd]: exit code: 2
+`)
+	expected := []string{
+		"x/names/handler.go:42:2: undefined: foo",
+		`x/names/keeper.go:7: imported and not used: "fmt"`,
+	}
+	errs := compilerErrors(output)
+	if strings.Join(errs, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("got %q, expected %q", errs, expected)
+	}
+}
@@ -0,0 +1,125 @@
+package builder
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ignoredDirs are the directories of a project which are never part of its
+// sources, whether or not .dockerignore excludes them: the chain data may
+// even change while being read.
+var ignoredDirs = map[string]bool{
+	".git":              true,
+	".scaffold":         true,
+	".scaffold-modules": true,
+	"build":             true,
+	"k8s":               true,
+	"log":               true,
+	"state":             true,
+}
+
+// SourcesHash returns a digest of the build context of rootDir: the path,
+// mode and contents of its files, except the ones of ignoredDirs and the
+// ones excluded by .dockerignore. It changes whenever the image may change.
+func SourcesHash(rootDir string) (string, error) {
+	ignored, err := readDockerignore(rootDir)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	err = filepath.Walk(rootDir, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(rootDir, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if rel == "." {
+			return nil
+		}
+		if ignoredDirs[rel] || ignored(rel) {
+			if fi.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		fmt.Fprintf(h, "%s %o\n", rel, fi.Mode())
+		switch {
+		case fi.Mode()&os.ModeSymlink != 0:
+			target, err := os.Readlink(p)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(h, target)
+		case fi.Mode().IsRegular():
+			f, err := os.Open(p)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			if _, err := io.Copy(h, f); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// readDockerignore returns a function telling whether a path, relative to
+// rootDir, is excluded from the build context by the patterns of
+// .dockerignore. Exceptions (!pattern) aren't supported: nothing is
+// excluded if there are any, which at worst causes extra builds.
+func readDockerignore(rootDir string) (func(string) bool, error) {
+	none := func(string) bool { return false }
+	patterns := []string{}
+	f, err := os.Open(filepath.Join(rootDir, ".dockerignore"))
+	switch {
+	case os.IsNotExist(err):
+		return none, nil
+	case err != nil:
+		return nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.HasPrefix(line, "!") {
+			return none, nil
+		}
+		line = strings.Trim(filepath.ToSlash(filepath.Clean(line)), "/")
+		patterns = append(patterns, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return func(rel string) bool {
+		for _, pattern := range patterns {
+			// A pattern matching a directory excludes its contents.
+			for p := rel; p != "."; p = filepath.ToSlash(filepath.Dir(p)) {
+				if ok, _ := filepath.Match(pattern, p); ok {
+					return true
+				}
+			}
+		}
+		return false
+	}, nil
+}
@@ -0,0 +1,185 @@
+package builder
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// writeFiles creates files in dir, given their relative path and contents.
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, data := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(p, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestReadDockerignore(t *testing.T) {
+	tests := []struct {
+		name     string
+		patterns string
+		ignored  []string
+		included []string
+	}{
+		{
+			name:     "no patterns",
+			patterns: "",
+			included: []string{"app.go", "vendor/lib.go"},
+		},
+		{
+			name:     "directory",
+			patterns: "vendor\n",
+			ignored:  []string{"vendor", "vendor/lib.go", "vendor/a/b/c.go"},
+			included: []string{"app.go", "vendors/lib.go", "x/vendor/lib.go"},
+		},
+		{
+			name:     "cleaned patterns",
+			patterns: "  ./docs/  \n/tmp\n",
+			ignored:  []string{"docs/index.md", "tmp/a"},
+			included: []string{"doc/index.md"},
+		},
+		{
+			name:     "wildcards",
+			patterns: "*.md\n*/testdata\n",
+			ignored:  []string{"README.md", "x/testdata/a.json"},
+			included: []string{"x/README.md", "testdata/a.json", "app.go"},
+		},
+		{
+			name:     "comments and blank lines",
+			patterns: "# build artifacts\n\nbin\n",
+			ignored:  []string{"bin/appd"},
+			included: []string{"# build artifacts", "app.go"},
+		},
+		{
+			name:     "exceptions disable the patterns",
+			patterns: "docs\n!docs/index.md\n",
+			included: []string{"docs/index.md", "docs/other.md"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "chainkit-dockerignore")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+			writeFiles(t, dir, map[string]string{".dockerignore": tt.patterns})
+
+			ignored, err := readDockerignore(dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, p := range tt.ignored {
+				if !ignored(p) {
+					t.Errorf("%s is not ignored", p)
+				}
+			}
+			for _, p := range tt.included {
+				if ignored(p) {
+					t.Errorf("%s is ignored", p)
+				}
+			}
+		})
+	}
+}
+
+func TestReadDockerignoreMissing(t *testing.T) {
+	dir, err := ioutil.TempDir("", "chainkit-dockerignore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	ignored, err := readDockerignore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ignored("app.go") {
+		t.Error("app.go is ignored without .dockerignore")
+	}
+}
+
+func TestSourcesHash(t *testing.T) {
+	dir, err := ioutil.TempDir("", "chainkit-sources")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	writeFiles(t, dir, map[string]string{
+		".dockerignore":         "docs\n",
+		"app.go":                "package app\n",
+		"cmd/appd/main.go":      "package main\n",
+		"docs/index.md":         "# App\n",
+		"state/data/state.db":   "1",
+		"state/config/app.toml": "",
+		"log/appd.log":          "started",
+		".git/HEAD":             "ref: refs/heads/master\n",
+	})
+
+	hash := func() string {
+		t.Helper()
+		h, err := SourcesHash(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return h
+	}
+	initial := hash()
+
+	// The chain state, the logs and the files excluded by .dockerignore
+	// are not part of the sources.
+	writeFiles(t, dir, map[string]string{
+		"state/data/state.db": "2",
+		"state/data/new.db":   "",
+		"log/appd.log":        "started\nstopped",
+		".git/HEAD":           "ref: refs/heads/other\n",
+		"docs/index.md":       "# My app\n",
+		"docs/new.md":         "",
+	})
+	if h := hash(); h != initial {
+		t.Fatal("the hash changed with files which are not part of the sources")
+	}
+
+	// The state is excluded even if .dockerignore includes it.
+	writeFiles(t, dir, map[string]string{".dockerignore": "!state\n"})
+	initial = hash()
+	writeFiles(t, dir, map[string]string{"state/data/state.db": "3"})
+	if h := hash(); h != initial {
+		t.Fatal("the hash changed with the state")
+	}
+
+	// Changing, adding or removing a source changes the hash.
+	writeFiles(t, dir, map[string]string{"app.go": "package app\n\nconst v = 2\n"})
+	changed := hash()
+	if changed == initial {
+		t.Fatal("the hash didn't change with a source")
+	}
+	writeFiles(t, dir, map[string]string{"x/names/keeper.go": "package names\n"})
+	added := hash()
+	if added == changed {
+		t.Fatal("the hash didn't change with a new source")
+	}
+	if err := os.Remove(filepath.Join(dir, "x", "names", "keeper.go")); err != nil {
+		t.Fatal(err)
+	}
+	if h := hash(); h == added {
+		t.Fatal("the hash didn't change with a removed source")
+	}
+
+	// So does changing the mode of a source.
+	before := hash()
+	if err := os.Chmod(filepath.Join(dir, "app.go"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if h := hash(); h == before {
+		t.Fatal("the hash didn't change with the mode of a source")
+	}
+}
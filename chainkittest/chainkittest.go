@@ -0,0 +1,315 @@
+// Package chainkittest runs chainkit chains from Go tests.
+//
+// It scaffolds (or reuses) a project, builds it and starts one or more nodes
+// on free ports, without taking part in any public network. Everything is
+// torn down by Close.
+//
+//	func TestTransfer(t *testing.T) {
+//		chain := chainkittest.New(t, chainkittest.Options{
+//			ProjectDir: "..",
+//			Nodes:      2,
+//		})
+//		defer chain.Close()
+//		status, err := chain.Nodes[1].Client.Status()
+//		...
+//	}
+package chainkittest
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/blocklayerhq/chainkit/builder"
+	"github.com/blocklayerhq/chainkit/client"
+	"github.com/blocklayerhq/chainkit/config"
+	"github.com/blocklayerhq/chainkit/discovery"
+	"github.com/blocklayerhq/chainkit/hooks"
+	"github.com/blocklayerhq/chainkit/node"
+	"github.com/blocklayerhq/chainkit/project"
+	"github.com/blocklayerhq/chainkit/scaffold"
+	"github.com/blocklayerhq/chainkit/util"
+)
+
+const (
+	defaultName    = "chainkittest"
+	defaultTimeout = 5 * time.Minute
+)
+
+// Options configures a test chain.
+type Options struct {
+	// ProjectDir is the directory of an existing project to run. If empty,
+	// a new project is scaffolded from the chainkit templates.
+	ProjectDir string
+	// Name is the name of the scaffolded project. Defaults to "chainkittest".
+	Name string
+	// Template is the template the project is scaffolded from: a built-in
+	// template, a directory or a git URL. Defaults to the default template.
+	Template string
+	// Vars are the values of the variables of the template.
+	Vars map[string]string
+	// Nodes is the number of nodes to start. Defaults to 1.
+	Nodes int
+	// Build rebuilds the image of an existing project. Scaffolded projects
+	// are always built.
+	Build bool
+	// Timeout bounds building the project and waiting for the nodes to
+	// produce their first block. Defaults to 5 minutes.
+	Timeout time.Duration
+}
+
+// Chain is a running test chain.
+type Chain struct {
+	// Dir is the directory of the project.
+	Dir     string
+	Project *project.Project
+	Nodes   []*Node
+
+	tmpDir string
+}
+
+// Node is a node of a test chain.
+type Node struct {
+	// Config is the configuration of the node. Each node has its own root
+	// directory and ports.
+	Config *config.Config
+	// Client is connected to the RPC of the node.
+	Client *client.Client
+
+	cancel context.CancelFunc
+	errCh  chan error
+}
+
+// New starts a test chain, which must be stopped with Close. It fails the
+// test if the chain can't be started.
+func New(t testing.TB, opts Options) *Chain {
+	t.Helper()
+
+	if opts.Nodes <= 0 {
+		opts.Nodes = 1
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = defaultTimeout
+	}
+
+	tmpDir, err := ioutil.TempDir("", "chainkittest")
+	if err != nil {
+		t.Fatalf("unable to create temporary directory: %v", err)
+	}
+	c := &Chain{tmpDir: tmpDir}
+
+	// Tear down what was started if the test fails.
+	started := false
+	defer func() {
+		if !started {
+			c.Close()
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), opts.Timeout)
+	defer cancel()
+
+	build := opts.Build
+	if opts.ProjectDir == "" {
+		name := opts.Name
+		if name == "" {
+			name = defaultName
+		}
+		c.Dir = filepath.Join(tmpDir, name)
+		c.Project = project.New(name)
+		source := opts.Template
+		if source == "" {
+			source = scaffold.DefaultTemplate
+		}
+		tmpl, err := scaffold.Load(ctx, source)
+		if err != nil {
+			t.Fatalf("unable to load the template: %v", err)
+		}
+		err = scaffold.Create(c.Dir, c.Project, scaffold.Options{
+			GoPkg:    defaultName + "/" + name,
+			Template: tmpl,
+			Vars:     opts.Vars,
+		})
+		tmpl.Close()
+		if err != nil {
+			t.Fatalf("unable to scaffold %s: %v", name, err)
+		}
+		build = true
+	} else {
+		c.Dir, err = filepath.Abs(opts.ProjectDir)
+		if err != nil {
+			t.Fatalf("unable to parse %q: %v", opts.ProjectDir, err)
+		}
+		c.Project, err = project.Load(c.Dir)
+		if err != nil {
+			t.Fatalf("%v", err)
+		}
+	}
+
+	if build {
+		b := builder.New(c.Dir, c.Project.Image)
+		if err := b.Build(ctx, builder.BuildOpts{Hooks: hooks.New(c.Dir, c.Project)}); err != nil {
+			t.Fatalf("unable to build %s: %v", c.Project.Name, err)
+		}
+	}
+
+	for i := 0; i < opts.Nodes; i++ {
+		n, err := c.startNode(ctx, filepath.Join(tmpDir, fmt.Sprintf("node%d", i)))
+		if err != nil {
+			t.Fatalf("unable to start node %d: %v", i, err)
+		}
+		c.Nodes = append(c.Nodes, n)
+	}
+
+	started = true
+	return c
+}
+
+// Close stops the nodes of the chain and removes its files.
+func (c *Chain) Close() {
+	for i := len(c.Nodes) - 1; i >= 0; i-- {
+		c.Nodes[i].stop()
+	}
+	c.Nodes = nil
+	os.RemoveAll(c.tmpDir)
+}
+
+// startNode starts a node rooted at rootDir. The first node creates the
+// chain, the others join it.
+func (c *Chain) startNode(ctx context.Context, rootDir string) (*Node, error) {
+	if err := linkProject(c.Dir, rootDir); err != nil {
+		return nil, err
+	}
+
+	ports, err := config.AllocatePorts()
+	if err != nil {
+		return nil, err
+	}
+	cfg := &config.Config{
+		RootDir: rootDir,
+		Ports:   ports,
+	}
+
+	opts := node.StartOpts{
+		NoExplorer: true,
+	}
+	if len(c.Nodes) > 0 {
+		first := c.Nodes[0]
+		opts.Genesis, err = ioutil.ReadFile(first.Config.GenesisPath())
+		if err != nil {
+			config.ReleasePorts(ports)
+			return nil, err
+		}
+		peer, err := first.peerInfo(ctx)
+		if err != nil {
+			config.ReleasePorts(ports)
+			return nil, err
+		}
+		opts.Peers = []*discovery.PeerInfo{peer}
+	}
+
+	nodeCtx, cancel := context.WithCancel(context.Background())
+	n := &Node{
+		Config: cfg,
+		Client: client.NewWithRemote(fmt.Sprintf("tcp://localhost:%d", ports.TendermintRPC), c.Project.AddressPrefix()),
+		cancel: cancel,
+		errCh:  make(chan error, 1),
+	}
+	go func() {
+		n.errCh <- node.New(cfg, nil).Start(nodeCtx, c.Project, opts)
+	}()
+
+	// Wait for the first block.
+	readyCh := make(chan error, 1)
+	go func() {
+		readyCh <- n.Client.WaitForHeight(ctx, 1)
+	}()
+	select {
+	case err := <-readyCh:
+		if err != nil {
+			n.stop()
+			return nil, err
+		}
+	case err := <-n.errCh:
+		n.errCh <- err
+		n.stop()
+		return nil, fmt.Errorf("the node stopped: %v", err)
+	}
+
+	return n, nil
+}
+
+// stop stops the node, waits for it to exit and releases its ports.
+func (n *Node) stop() {
+	n.cancel()
+	<-n.errCh
+	n.Client.Close()
+	config.ReleasePorts(n.Config.Ports)
+}
+
+// peerInfo describes how to reach the node from the other nodes.
+func (n *Node) peerInfo(ctx context.Context) (*discovery.PeerInfo, error) {
+	status, err := n.Client.Status()
+	if err != nil {
+		return nil, err
+	}
+
+	// The nodes reach each other through the ports published on the host.
+	var out bytes.Buffer
+	err = util.RunWithFD(ctx, nil, &out, ioutil.Discard, "docker", "network", "inspect", "bridge",
+		"--format", "{{ (index .IPAM.Config 0).Gateway }}",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find the docker host address: %v", err)
+	}
+
+	return &discovery.PeerInfo{
+		NodeID:            string(status.NodeInfo.ID),
+		IP:                []string{strings.TrimSpace(out.String())},
+		TendermintP2PPort: n.Config.Ports.TendermintP2P,
+	}, nil
+}
+
+// linkProject prepares the root directory of a node: the manifest is
+// copied, and the other files of the project are linked so that paths
+// relative to the project (hooks, genesis patches) work.
+func linkProject(projectDir, rootDir string) error {
+	if err := os.MkdirAll(rootDir, 0755); err != nil {
+		return err
+	}
+
+	cfg := &config.Config{RootDir: projectDir}
+	skip := map[string]bool{
+		filepath.Base(cfg.ManifestPath()): true,
+		filepath.Base(cfg.StateDir()):     true,
+		filepath.Base(cfg.LogFile()):      true,
+	}
+
+	manifest, err := ioutil.ReadFile(cfg.ManifestPath())
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(filepath.Join(rootDir, filepath.Base(cfg.ManifestPath())), manifest, 0644); err != nil {
+		return err
+	}
+
+	entries, err := ioutil.ReadDir(projectDir)
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if skip[e.Name()] {
+			continue
+		}
+		if err := os.Symlink(filepath.Join(projectDir, e.Name()), filepath.Join(rootDir, e.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package client
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+	// bech32MaxLen is the maximum length of a bech32 string (BIP 173).
+	bech32MaxLen = 90
+)
+
+// DecodeAddress decodes a bech32 address with the given prefix (e.g. cosmos
+// for cosmos1...) into its raw bytes.
+func DecodeAddress(address, prefix string) ([]byte, error) {
+	if len(address) > bech32MaxLen {
+		return nil, fmt.Errorf("invalid address %q: too long (%d characters, max: %d)", address, len(address), bech32MaxLen)
+	}
+	if strings.ToLower(address) != address && strings.ToUpper(address) != address {
+		return nil, fmt.Errorf("invalid address %q: mixed case", address)
+	}
+	address = strings.ToLower(address)
+
+	sep := strings.LastIndex(address, "1")
+	if sep < 1 || sep+7 > len(address) {
+		return nil, fmt.Errorf("invalid address %q", address)
+	}
+	hrp, data := address[:sep], address[sep+1:]
+	if hrp != prefix {
+		return nil, fmt.Errorf("invalid address %q: expected the %q prefix", address, prefix)
+	}
+
+	values := make([]byte, 0, len(data))
+	for _, c := range data {
+		v := strings.IndexRune(bech32Charset, c)
+		if v < 0 {
+			return nil, fmt.Errorf("invalid address %q: invalid character %q", address, c)
+		}
+		values = append(values, byte(v))
+	}
+	if bech32Polymod(append(bech32ExpandHRP(hrp), values...)) != 1 {
+		return nil, fmt.Errorf("invalid address %q: invalid checksum", address)
+	}
+
+	// Drop the checksum and convert from 5 bit to 8 bit groups.
+	values = values[:len(values)-6]
+	var (
+		out  []byte
+		acc  uint
+		bits uint
+	)
+	for _, v := range values {
+		acc = acc<<5 | uint(v)
+		bits += 5
+		for bits >= 8 {
+			bits -= 8
+			out = append(out, byte(acc>>bits))
+		}
+	}
+	if bits >= 5 || acc&(1<<bits-1) != 0 {
+		return nil, fmt.Errorf("invalid address %q: invalid padding", address)
+	}
+	return out, nil
+}
+
+func bech32ExpandHRP(hrp string) []byte {
+	out := make([]byte, 0, len(hrp)*2+1)
+	for _, c := range hrp {
+		out = append(out, byte(c>>5))
+	}
+	out = append(out, 0)
+	for _, c := range hrp {
+		out = append(out, byte(c&31))
+	}
+	return out
+}
+
+func bech32Polymod(values []byte) uint32 {
+	gen := []uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	chk := uint32(1)
+	for _, v := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i := uint(0); i < 5; i++ {
+			if (top>>i)&1 == 1 {
+				chk ^= gen[i]
+			}
+		}
+	}
+	return chk
+}
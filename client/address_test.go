@@ -0,0 +1,116 @@
+package client
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestDecodeAddress(t *testing.T) {
+	// The addresses were encoded with the BIP 173 reference implementation.
+	valid := []byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19}
+	tests := []struct {
+		name     string
+		address  string
+		prefix   string
+		expected []byte // nil if the address must be rejected
+	}{
+		{
+			name:     "valid",
+			address:  "cosmos1qqqsyqcyq5rqwzqfpg9scrgwpugpzysnrk363e",
+			prefix:   "cosmos",
+			expected: valid,
+		},
+		{
+			name:     "upper case",
+			address:  "COSMOS1QQQSYQCYQ5RQWZQFPG9SCRGWPUGPZYSNRK363E",
+			prefix:   "cosmos",
+			expected: valid,
+		},
+		{
+			name:     "custom prefix",
+			address:  "terra1qqqsyqcyq5rqwzqfpg9scrgwpugpzysn9jt6ne",
+			prefix:   "terra",
+			expected: valid,
+		},
+		{
+			name:     "19 bytes",
+			address:  "cosmos1qqqsyqcyq5rqwzqfpg9scrgwpugpzysuumzx0",
+			prefix:   "cosmos",
+			expected: valid[:19],
+		},
+		{
+			name:    "wrong prefix",
+			address: "terra1qqqsyqcyq5rqwzqfpg9scrgwpugpzysn9jt6ne",
+			prefix:  "cosmos",
+		},
+		{
+			name:    "bad checksum",
+			address: "cosmos1qqqsyqcyq5rqwzqfpg9scrgwpugpzysnrk363f",
+			prefix:  "cosmos",
+		},
+		{
+			name:    "altered data",
+			address: "cosmos1qqqsyqcyq5rqwzqfpg9scrgwpugpzysmrk363e",
+			prefix:  "cosmos",
+		},
+		{
+			name:    "mixed case",
+			address: "cosmos1qqqsyqcyq5rqwzqfpg9scrgwpugpzysnRk363e",
+			prefix:  "cosmos",
+		},
+		{
+			name:    "non-zero padding bits",
+			address: "cosmos1qqqsyqcyq5rqwzqfpg9scrgwpugpzy3p20hma",
+			prefix:  "cosmos",
+		},
+		{
+			name:    "too many padding bits",
+			address: "cosmos1qqqsyqcyq5rqwzqfpg9scrgwpugpzysnp95mjtv",
+			prefix:  "cosmos",
+		},
+		{
+			name:    "too long",
+			address: "cosmos1" + strings.Repeat("q", 103) + "egrd06",
+			prefix:  "cosmos",
+		},
+		{
+			name:    "invalid character",
+			address: "cosmos1qqqsyqcyq5rqwzqfpg9scrgwpugpzysbrk363e",
+			prefix:  "cosmos",
+		},
+		{
+			name:    "missing separator",
+			address: "cosmosqqqsyqcyq5rqwzqfpg9scrgwpugpzysnrk363e",
+			prefix:  "cosmos",
+		},
+		{
+			name:    "too short",
+			address: "cosmos1rk363",
+			prefix:  "cosmos",
+		},
+		{
+			name:    "empty",
+			address: "",
+			prefix:  "cosmos",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := DecodeAddress(tt.address, tt.prefix)
+			if tt.expected == nil {
+				if err == nil {
+					t.Fatalf("expected an error, got %x", out)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(out, tt.expected) {
+				t.Fatalf("got %x, expected %x", out, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,227 @@
+// Package client provides access to the node of a chainkit project from Go:
+// it broadcasts transactions, queries the application state and subscribes
+// to the chain events through the Tendermint RPC.
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/blocklayerhq/chainkit/config"
+	"github.com/blocklayerhq/chainkit/project"
+	"github.com/blocklayerhq/chainkit/util"
+	"github.com/pkg/errors"
+	tmquery "github.com/tendermint/tendermint/libs/pubsub/query"
+	"github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	"github.com/tendermint/tendermint/types"
+)
+
+const (
+	// subscriber identifies the subscriptions of the client.
+	subscriber = "chainkit-client"
+	// pollInterval is how often the node is polled while waiting.
+	pollInterval = 200 * time.Millisecond
+)
+
+// Client is connected to a chainkit node.
+type Client struct {
+	rpc    *client.HTTP
+	prefix string
+
+	mu      sync.Mutex
+	started bool
+}
+
+// New connects to the running node of the chainkit project in projectDir.
+func New(ctx context.Context, projectDir string) (*Client, error) {
+	rootDir, err := filepath.Abs(projectDir)
+	if err != nil {
+		return nil, err
+	}
+	cfg := &config.Config{
+		RootDir: rootDir,
+	}
+	p, err := project.Load(rootDir)
+	if err != nil {
+		return nil, err
+	}
+
+	containerID, err := util.DaemonContainer(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var out bytes.Buffer
+	if err := util.RunWithFD(ctx, nil, &out, ioutil.Discard, "docker", "port", containerID, "26657/tcp"); err != nil {
+		return nil, errors.Wrap(err, "unable to find the RPC port of the node")
+	}
+	lines := strings.Fields(out.String())
+	if len(lines) == 0 {
+		return nil, errors.New("the RPC port of the node is not published")
+	}
+	port := lines[0][strings.LastIndex(lines[0], ":")+1:]
+
+	return NewWithRemote(fmt.Sprintf("tcp://localhost:%s", port), p.AddressPrefix()), nil
+}
+
+// NewWithRemote connects to the node listening for RPC at remote
+// (e.g. tcp://localhost:26657). prefix is the bech32 prefix of the account
+// addresses of the application (e.g. cosmos).
+func NewWithRemote(remote, prefix string) *Client {
+	return &Client{
+		rpc:    client.NewHTTP(remote, "/websocket"),
+		prefix: prefix,
+	}
+}
+
+// RPC returns the underlying Tendermint RPC client.
+func (c *Client) RPC() *client.HTTP {
+	return c.rpc
+}
+
+// Close closes the event subscriptions.
+func (c *Client) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.started {
+		return nil
+	}
+	c.started = false
+	return c.rpc.Stop()
+}
+
+// Status returns the status of the node.
+func (c *Client) Status() (*ctypes.ResultStatus, error) {
+	return c.rpc.Status()
+}
+
+// ChainID returns the chain ID of the node.
+func (c *Client) ChainID() (string, error) {
+	status, err := c.rpc.Status()
+	if err != nil {
+		return "", err
+	}
+	return status.NodeInfo.Network, nil
+}
+
+// Height returns the height of the latest block.
+func (c *Client) Height() (int64, error) {
+	status, err := c.rpc.Status()
+	if err != nil {
+		return 0, err
+	}
+	return status.SyncInfo.LatestBlockHeight, nil
+}
+
+// WaitForHeight waits until the chain reaches a block height.
+func (c *Client) WaitForHeight(ctx context.Context, height int64) error {
+	for {
+		current, err := c.Height()
+		if err == nil && current >= height {
+			return nil
+		}
+		select {
+		case <-time.After(pollInterval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// BroadcastTx broadcasts a signed transaction, encoded by the application
+// codec, and waits for it to be included in a block.
+func (c *Client) BroadcastTx(ctx context.Context, tx []byte) (*ctypes.ResultTx, error) {
+	res, err := c.rpc.BroadcastTxSync(types.Tx(tx))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to broadcast transaction")
+	}
+	if res.Code != 0 {
+		return nil, fmt.Errorf("transaction rejected (code %d): %s", res.Code, res.Log)
+	}
+	return c.WaitForTx(ctx, res.Hash)
+}
+
+// WaitForTx waits for a transaction to be included in a block. It fails if
+// the transaction was included but failed.
+func (c *Client) WaitForTx(ctx context.Context, hash []byte) (*ctypes.ResultTx, error) {
+	for {
+		res, err := c.rpc.Tx(hash, false)
+		if err == nil {
+			if !res.TxResult.IsOK() {
+				return res, fmt.Errorf("transaction %X failed (code %d): %s", hash, res.TxResult.Code, res.TxResult.Log)
+			}
+			return res, nil
+		}
+		select {
+		case <-time.After(pollInterval):
+		case <-ctx.Done():
+			return nil, errors.Wrapf(ctx.Err(), "transaction %X not included", hash)
+		}
+	}
+}
+
+// Query runs an ABCI query and returns the response value.
+func (c *Client) Query(path string, data []byte) ([]byte, error) {
+	res, err := c.rpc.ABCIQuery(path, data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "query %s failed", path)
+	}
+	if !res.Response.IsOK() {
+		return nil, fmt.Errorf("query %s failed (code %d): %s", path, res.Response.Code, res.Response.Log)
+	}
+	return res.Response.Value, nil
+}
+
+// QueryStore returns the value of a key in a store of the application, or
+// nil if it doesn't exist.
+func (c *Client) QueryStore(store string, key []byte) ([]byte, error) {
+	return c.Query(fmt.Sprintf("/store/%s/key", store), key)
+}
+
+// Account returns the account of a bech32 address, encoded by the
+// application codec, or nil if it doesn't exist.
+func (c *Client) Account(address string) ([]byte, error) {
+	addr, err := DecodeAddress(address, c.prefix)
+	if err != nil {
+		return nil, err
+	}
+	return c.QueryStore("acc", append([]byte("account:"), addr...))
+}
+
+// Subscribe subscribes to the events matching a Tendermint query (e.g.
+// "tm.event = 'NewBlock'"). Events are delivered on the returned channel
+// until the context is cancelled.
+func (c *Client) Subscribe(ctx context.Context, query string) (<-chan interface{}, error) {
+	q, err := tmquery.New(query)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid query %q", query)
+	}
+
+	c.mu.Lock()
+	if !c.started {
+		if err := c.rpc.Start(); err != nil {
+			c.mu.Unlock()
+			return nil, errors.Wrap(err, "unable to connect to the node")
+		}
+		c.started = true
+	}
+	c.mu.Unlock()
+
+	out := make(chan interface{})
+	if err := c.rpc.Subscribe(ctx, subscriber, q, out); err != nil {
+		return nil, errors.Wrapf(err, "unable to subscribe to %q", query)
+	}
+	go func() {
+		<-ctx.Done()
+		c.rpc.Unsubscribe(context.Background(), subscriber, q)
+	}()
+	return out, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/blocklayerhq/chainkit/builder"
+	"github.com/blocklayerhq/chainkit/hooks"
 	"github.com/blocklayerhq/chainkit/project"
 	"github.com/blocklayerhq/chainkit/ui"
 	"github.com/spf13/cobra"
@@ -12,6 +13,7 @@ import (
 var buildCmd = &cobra.Command{
 	Use:   "build",
 	Short: "Build the application",
+	Long:  "Build the application image. The build is skipped if the image was built from the current sources, as recorded by the chainkit.sources label of the image.",
 	Args:  cobra.ExactArgs(0),
 	Run: func(cmd *cobra.Command, args []string) {
 		ctx := context.Background()
@@ -23,6 +25,10 @@ var buildCmd = &cobra.Command{
 		if err != nil {
 			ui.Fatal("unable to resolve flag: %v", err)
 		}
+		force, err := cmd.Flags().GetBool("force")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
 
 		rootDir := getCwd(cmd)
 		p, err := project.Load(rootDir)
@@ -34,6 +40,8 @@ var buildCmd = &cobra.Command{
 		opts := builder.BuildOpts{
 			Verbose: verbose,
 			NoCache: noCache,
+			Force:   force,
+			Hooks:   hooks.New(rootDir, p),
 		}
 		ui.Info("Building %s", ui.Emphasize(p.Name))
 		if err := b.Build(ctx, opts); err != nil {
@@ -46,6 +54,7 @@ func init() {
 	buildCmd.Flags().String("cwd", ".", "specifies the current working directory")
 	buildCmd.Flags().BoolP("verbose", "v", false, "enable verbose mode")
 	buildCmd.Flags().Bool("no-cache", false, "disable caching")
+	buildCmd.Flags().Bool("force", false, "build even if the sources didn't change since the last build")
 
 	rootCmd.AddCommand(buildCmd)
 }
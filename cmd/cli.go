@@ -1,65 +1,233 @@
 package cmd
 
 import (
-	"bufio"
-	"bytes"
 	"context"
-	"os"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"path/filepath"
 	"strings"
 
+	"github.com/blocklayerhq/chainkit/config"
+	"github.com/blocklayerhq/chainkit/genesis"
 	"github.com/blocklayerhq/chainkit/project"
 	"github.com/blocklayerhq/chainkit/ui"
 	"github.com/blocklayerhq/chainkit/util"
 	"github.com/spf13/cobra"
 )
 
+// cliNodeAddress is the RPC address of the daemon, from within its container.
+const cliNodeAddress = "tcp://localhost:26657"
+
 var cliCmd = &cobra.Command{
-	Use:                "cli args ...",
-	Short:              "Run a command from the application CLI",
+	Use:   "cli [--cwd <dir>] [--node <name|id|endpoint>] args ...",
+	Short: "Run a command from the application CLI",
+	Long: `Run a command from the application CLI within the container of a running node.
+
+The chainkit flags (--cwd, --node) must come before the CLI arguments. If
+several nodes of the application are running, --node selects one of them by
+name (the name of its directory) or by container ID.
+
+If the application isn't running, or if --node is a remote RPC endpoint
+(e.g. tcp://host:26657), the CLI runs in a one-off container instead, with
+the keys of the project. Key management and offline signing work while the
+chain is stopped.
+
+The --chain-id and --node flags of the query and tx commands are set
+automatically from the configuration of the node, unless given explicitly.
+Plain --amount values of tx commands (e.g. --amount 10) are in the
+denomination of the project.`,
 	DisableFlagParsing: true,
 	Run: func(cmd *cobra.Command, args []string) {
-		p, err := project.Load(getCwd(cmd))
+		cwd, selector, args := parseCLIFlags(args)
+		rootDir, err := filepath.Abs(cwd)
+		if err != nil {
+			ui.Fatal("unable to parse %q: %v", cwd, err)
+		}
+		p, err := project.Load(rootDir)
 		if err != nil {
 			ui.Fatal("%v", err)
 		}
-		cli(p, args)
+		cfg := &config.Config{
+			RootDir: rootDir,
+		}
+		cli(cfg, p, selector, args)
 	},
 }
 
 func init() {
 	cliCmd.Flags().String("cwd", ".", "specifies the current working directory")
+	cliCmd.Flags().String("node", "", "name or container ID of the node to run the command on, or a remote RPC endpoint")
 
 	rootCmd.AddCommand(cliCmd)
 }
 
-func getContainerID(ctx context.Context, p *project.Project) string {
-	cmd := []string{
-		"ps", "-q",
-		"-f", "label=chainkit.cosmos.daemon",
-		"-f", "label=chainkit.project=" + p.Name,
-	}
-	var b bytes.Buffer
-	bwriter := bufio.NewWriter(&b)
-	if err := util.RunWithFD(ctx, os.Stdin, bwriter, os.Stderr, "docker", cmd...); err != nil {
-		ui.Fatal("Failed to start the cli (can't find the daemon container, is the application running?): %v", err)
-		return ""
-	}
-	// FIXME: if there are multiple chainkit containers running, only the first one will be detected.
-	containerID := strings.Split(b.String(), "\n")[0]
-	return containerID
+// parseCLIFlags extracts the chainkit flags preceding the CLI arguments,
+// since flag parsing is disabled so that CLI flags are passed through.
+func parseCLIFlags(args []string) (cwd string, node string, rest []string) {
+	cwd = "."
+	for len(args) > 0 {
+		arg := args[0]
+		var name, value string
+		switch {
+		case arg == "--":
+			return cwd, node, args[1:]
+		case arg == "--cwd" || arg == "--node":
+			if len(args) < 2 {
+				ui.Fatal("flag needs an argument: %s", arg)
+			}
+			name, value = arg, args[1]
+			args = args[2:]
+		case strings.HasPrefix(arg, "--cwd=") || strings.HasPrefix(arg, "--node="):
+			parts := strings.SplitN(arg, "=", 2)
+			name, value = parts[0], parts[1]
+			args = args[1:]
+		default:
+			return cwd, node, args
+		}
+		if name == "--cwd" {
+			cwd = value
+		} else {
+			node = value
+		}
+	}
+	return cwd, node, args
+}
+
+// isRemoteNode checks whether a --node value is an RPC endpoint rather than
+// a local node.
+func isRemoteNode(selector string) bool {
+	if strings.Contains(selector, "://") {
+		return true
+	}
+	_, port, err := net.SplitHostPort(selector)
+	return err == nil && port != ""
+}
+
+// selectNode returns the running node of the project matching selector, or
+// the only running node if selector is empty.
+func selectNode(p *project.Project, nodes []runningNode, selector string) runningNode {
+	if len(nodes) == 0 {
+		ui.Fatal("%s is not running", p.Name)
+	}
+
+	matches := []runningNode{}
+	for _, n := range nodes {
+		if selector == "" || n.Name() == selector || n.Root == selector || strings.HasPrefix(n.ID, selector) {
+			matches = append(matches, n)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		ui.Fatal("No running node of %s matches %q. Running nodes:\n%s", p.Name, selector, describeNodes(nodes))
+	case 1:
+		return matches[0]
+	}
+	if selector == "" {
+		ui.Fatal("Several nodes of %s are running, select one with --node:\n%s", p.Name, describeNodes(matches))
+	}
+	ui.Fatal("%q matches several nodes of %s, use the container ID instead:\n%s", selector, p.Name, describeNodes(matches))
+	return runningNode{}
+}
+
+func describeNodes(nodes []runningNode) string {
+	lines := []string{}
+	for _, n := range nodes {
+		lines = append(lines, fmt.Sprintf("  %s\t%s (%s)", n.ID, n.Name(), n.Root))
+	}
+	return strings.Join(lines, "\n")
 }
 
-func cli(p *project.Project, args []string) {
+// cliClientFlags returns the client flags to add to the CLI arguments, so
+// that query and tx commands target the node at nodeAddress and use the
+// chain ID of the genesis file in root. Either may be empty.
+func cliClientFlags(root, nodeAddress string, args []string) []string {
+	if len(args) == 0 {
+		return nil
+	}
+	switch args[0] {
+	case "query", "q", "tx":
+	default:
+		return nil
+	}
+
+	flags := []string{}
+	if nodeAddress != "" && !hasFlag(args, "--node") {
+		flags = append(flags, "--node", nodeAddress)
+	}
+	if root != "" && !hasFlag(args, "--chain-id") {
+		cfg := &config.Config{RootDir: root}
+		if doc, err := ioutil.ReadFile(cfg.GenesisPath()); err == nil {
+			if chainID, err := genesis.ChainID(doc); err == nil {
+				flags = append(flags, "--chain-id", chainID)
+			}
+		}
+	}
+	return flags
+}
+
+// denominateAmounts returns the CLI arguments with the plain --amount values
+// of tx commands in the denomination of the project.
+func denominateAmounts(p *project.Project, args []string) []string {
+	if len(args) == 0 || args[0] != "tx" {
+		return args
+	}
+	out := make([]string, len(args))
+	copy(out, args)
+	for i, arg := range out {
+		switch {
+		case arg == "--amount" && i+1 < len(out):
+			out[i+1] = p.Coins(out[i+1])
+		case strings.HasPrefix(arg, "--amount="):
+			out[i] = "--amount=" + p.Coins(strings.TrimPrefix(arg, "--amount="))
+		}
+	}
+	return out
+}
+
+// hasFlag checks whether a flag is present in args.
+func hasFlag(args []string, flag string) bool {
+	for _, arg := range args {
+		if arg == flag || strings.HasPrefix(arg, flag+"=") {
+			return true
+		}
+	}
+	return false
+}
+
+func cli(cfg *config.Config, p *project.Project, selector string, args []string) {
 	ctx := context.Background()
-	containerID := getContainerID(ctx, p)
-	cmd := []string{
-		"exec",
-		"-it",
-		containerID,
-		p.Binaries.CLI,
-	}
-	cmd = append(cmd, args...)
-	if err := util.Run(ctx, "docker", cmd...); err != nil {
-		ui.Fatal("Failed to start the cli (is the application running?): %v", err)
+	args = denominateAmounts(p, args)
+
+	if isRemoteNode(selector) {
+		cliSidecar(ctx, cfg, p, selector, args)
+		return
+	}
+
+	nodes := runningNodes(ctx, p)
+	if len(nodes) == 0 && selector == "" {
+		ui.Verbose("%s is not running, running the CLI in a one-off container", p.Name)
+		cliSidecar(ctx, cfg, p, "", args)
+		return
+	}
+
+	n := selectNode(p, nodes, selector)
+	root := n.Root
+	if root == "" {
+		root = cfg.RootDir
+	}
+	args = append(args, cliClientFlags(root, cliNodeAddress, args)...)
+	if err := util.DockerExecCLI(ctx, n.ID, p, args...); err != nil {
+		ui.Fatal("Failed to run the cli: %v", err)
+	}
+}
+
+// cliSidecar runs the CLI in a one-off container, with the CLI directory of
+// the project mounted. If nodeAddress is set, commands are sent to it.
+func cliSidecar(ctx context.Context, cfg *config.Config, p *project.Project, nodeAddress string, args []string) {
+	args = append(args, cliClientFlags(cfg.RootDir, nodeAddress, args)...)
+	if err := util.DockerRunCLI(ctx, cfg, p, args...); err != nil {
+		ui.Fatal("Failed to run the cli: %v", err)
 	}
 }
@@ -1,62 +1,339 @@
 package cmd
 
 import (
-	"bytes"
 	"context"
 	"fmt"
-	"io/ioutil"
 	"os"
 	"path"
-	"path/filepath"
+	"sort"
 	"strings"
-	"text/template"
 
 	"github.com/blocklayerhq/chainkit/builder"
-	"github.com/blocklayerhq/chainkit/httpfs"
+	"github.com/blocklayerhq/chainkit/genesis"
+	"github.com/blocklayerhq/chainkit/hooks"
 	"github.com/blocklayerhq/chainkit/project"
-	"github.com/blocklayerhq/chainkit/templates"
+	"github.com/blocklayerhq/chainkit/scaffold"
 	"github.com/blocklayerhq/chainkit/ui"
-	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 )
 
-type templateContext struct {
-	Name    string
-	RootDir string
-	GoPkg   string
-}
-
 var createCmd = &cobra.Command{
-	Use:   "create <name>",
+	Use:   "create [name]",
 	Short: "Create an application",
-	Args:  cobra.ExactArgs(1),
+	Long: `Create an application.
+
+When running in a terminal, the options which aren't set with flags are
+prompted for. Use --yes to take their default values instead.`,
+	Args: cobra.MaximumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		name := args[0]
+		w := newWizard(cmd)
+
+		name := ""
+		if len(args) > 0 {
+			name = args[0]
+		}
+		switch {
+		case name != "":
+		case w.interactive:
+			name = w.prompt("Name of the application", "", project.ValidateName)
+		default:
+			ui.Fatal("The name of the application is required")
+		}
+		if err := project.ValidateName(name); err != nil {
+			ui.Fatal("%v", err)
+		}
 		rootDir := path.Join(getCwd(cmd), name)
+		if _, err := os.Stat(rootDir); !os.IsNotExist(err) {
+			ui.Fatal("Destination path %s already exists", ui.Emphasize(rootDir))
+		}
+
+		source, err := cmd.Flags().GetString("template")
+		if err != nil {
+			ui.Fatal("unable to parse --template: %v", err)
+		}
+		if w.ask("template") {
+			source = chooseTemplate(w)
+		}
+		t, err := scaffold.Load(context.Background(), source)
+		if err != nil {
+			ui.Fatal("Failed to load the template: %v", err)
+		}
+		defer t.Close()
+
+		opts := scaffold.Options{Template: t}
+		if opts.GoPkg, err = cmd.Flags().GetString("module"); err != nil {
+			ui.Fatal("unable to parse --module: %v", err)
+		}
+		if w.ask("module") {
+			opts.GoPkg = w.prompt("Go module path", defaultGoPkg(rootDir, name), nil)
+		}
+
+		if opts.SDKVersion, err = cmd.Flags().GetString("sdk-version"); err != nil {
+			ui.Fatal("unable to parse --sdk-version: %v", err)
+		}
+		if versions := t.SDKVersions(); w.ask("sdk-version") && len(versions) > 1 {
+			opts.SDKVersion = versions[w.choose("Cosmos SDK version", versions)]
+		}
+		if err := t.ValidateSDKVersion(opts.SDKVersion); err != nil {
+			ui.Fatal("%v", err)
+		}
+
+		if opts.Bech32Prefix, err = cmd.Flags().GetString("bech32-prefix"); err != nil {
+			ui.Fatal("unable to parse --bech32-prefix: %v", err)
+		}
+		if w.ask("bech32-prefix") && scaffold.SupportsBech32Prefix(opts.SDKVersion) {
+			opts.Bech32Prefix = w.prompt("Bech32 prefix of the addresses", opts.Bech32Prefix, func(prefix string) error {
+				return scaffold.ValidateBech32Prefix(prefix, opts.SDKVersion)
+			})
+		}
+		if err := scaffold.ValidateBech32Prefix(opts.Bech32Prefix, opts.SDKVersion); err != nil {
+			ui.Fatal("%v", err)
+		}
+
+		if opts.Denom, err = cmd.Flags().GetString("denom"); err != nil {
+			ui.Fatal("unable to parse --denom: %v", err)
+		}
+		if w.ask("denom") {
+			opts.Denom = w.prompt("Denomination of the coins", opts.Denom, project.ValidateDenom)
+		}
+		if err := project.ValidateDenom(opts.Denom); err != nil {
+			ui.Fatal("%v", err)
+		}
+
 		p := project.New(name)
-		create(rootDir, p)
+		p.Bech32Prefix = opts.Bech32Prefix
+		p.Denom = opts.Denom
+
+		accounts, err := parseAccounts(cmd, p)
+		if err != nil {
+			ui.Fatal("%v", err)
+		}
+		if w.ask("account") {
+			accounts = promptAccounts(w, p)
+		}
+
+		vars, err := parseVars(cmd)
+		if err != nil {
+			ui.Fatal("%v", err)
+		}
+		opts.Vars = promptVars(w, t, vars)
+
+		noBuild, err := cmd.Flags().GetBool("no-build")
+		if err != nil {
+			ui.Fatal("unable to parse --no-build: %v", err)
+		}
+		if w.ask("no-build") {
+			noBuild = !w.confirm("Build the application now?")
+		}
+
+		if len(accounts) > 0 {
+			p.Genesis = &project.GenesisConfig{
+				Patches: []interface{}{accountsPatch(accounts)},
+			}
+		}
+		create(rootDir, p, opts, !noBuild)
 	},
 }
 
+// chooseTemplate asks to pick one of the built-in templates.
+func chooseTemplate(w *wizard) string {
+	names, err := scaffold.Builtins()
+	if err != nil {
+		ui.Fatal("%v", err)
+	}
+	// The default template comes first.
+	sort.SliceStable(names, func(i, j int) bool {
+		return names[i] == scaffold.DefaultTemplate && names[j] != scaffold.DefaultTemplate
+	})
+
+	items := []string{}
+	for _, name := range names {
+		items = append(items, fmt.Sprintf("%-8s %s", name, templateDescription(name)))
+	}
+	return names[w.choose("Template", items)]
+}
+
+// defaultGoPkg returns the default Go module path of an application: its
+// name, or its import path if created within GOPATH.
+func defaultGoPkg(rootDir, name string) string {
+	// Keep the import path of applications created within GOPATH.
+	if gosource := goSrc(); strings.HasPrefix(rootDir, gosource+"/") {
+		return strings.TrimPrefix(rootDir, gosource+"/")
+	}
+	return name
+}
+
+// account is an account funded in the genesis file.
+type account struct {
+	address string
+	coins   []genesis.Coin
+}
+
+// parseAccount parses an account given as <address>:<coins>. Plain amounts
+// are in the denomination of the project.
+func parseAccount(s string, p *project.Project) (*account, error) {
+	parts := strings.SplitN(s, ":", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid account %q: expected <address>:<coins> (e.g. %s1...:1000%s)", s, p.AddressPrefix(), p.CoinDenom())
+	}
+	if !p.IsAddress(parts[0]) {
+		return nil, fmt.Errorf("invalid account %q: %q is not a %s1... address", s, parts[0], p.AddressPrefix())
+	}
+	coins, err := genesis.ParseCoins(p.Coins(parts[1]))
+	if err != nil {
+		return nil, fmt.Errorf("invalid account %q: %v", s, err)
+	}
+	return &account{address: parts[0], coins: coins}, nil
+}
+
+// parseAccounts parses the --account flags.
+func parseAccounts(cmd *cobra.Command, p *project.Project) ([]*account, error) {
+	flags, err := cmd.Flags().GetStringArray("account")
+	if err != nil {
+		return nil, err
+	}
+	accounts := []*account{}
+	for _, f := range flags {
+		acc, err := parseAccount(f, p)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, acc)
+	}
+	return accounts, nil
+}
+
+// promptAccounts asks for accounts until the answer is empty.
+func promptAccounts(w *wizard, p *project.Project) []*account {
+	accounts := []*account{}
+	for {
+		answer := w.prompt("Initial account as <address>:<coins> (leave empty to continue)", "", func(s string) error {
+			if s == "" {
+				return nil
+			}
+			_, err := parseAccount(s, p)
+			return err
+		})
+		if answer == "" {
+			return accounts
+		}
+		acc, _ := parseAccount(answer, p)
+		accounts = append(accounts, acc)
+	}
+}
+
+// accountsPatch returns the JSON Patch funding accounts in the genesis file.
+func accountsPatch(accounts []*account) []interface{} {
+	ops := []interface{}{}
+	for _, acc := range accounts {
+		coins := []interface{}{}
+		for _, c := range acc.coins {
+			coins = append(coins, map[string]interface{}{"denom": c.Denom, "amount": c.Amount})
+		}
+		ops = append(ops, map[string]interface{}{
+			"op":   "add",
+			"path": "/app_state/accounts/-",
+			"value": map[string]interface{}{
+				"address": acc.address,
+				"coins":   coins,
+			},
+		})
+	}
+	return ops
+}
+
+// parseVars parses the --var key=value flags.
+func parseVars(cmd *cobra.Command) (map[string]string, error) {
+	flags, err := cmd.Flags().GetStringArray("var")
+	if err != nil {
+		return nil, err
+	}
+	vars := map[string]string{}
+	for _, f := range flags {
+		parts := strings.SplitN(f, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid --var %q: expected key=value", f)
+		}
+		vars[parts[0]] = parts[1]
+	}
+	return vars, nil
+}
+
+// promptVars asks for the variables of a template that weren't provided.
+// The others get their default value.
+func promptVars(w *wizard, t *scaffold.Template, vars map[string]string) map[string]string {
+	if !w.interactive {
+		return vars
+	}
+	for _, v := range t.Variables() {
+		if _, ok := vars[v.Name]; ok || v.Prompt == "" {
+			continue
+		}
+		vars[v.Name] = w.prompt(v.Prompt, v.Default, nil)
+	}
+	return vars
+}
+
+// templatesUsage describes the built-in templates.
+func templatesUsage() string {
+	names, err := scaffold.Builtins()
+	if err != nil {
+		return ""
+	}
+	usage := "\nBuilt-in templates:\n"
+	for _, name := range names {
+		usage += fmt.Sprintf("  %-10s %s\n", name, templateDescription(name))
+	}
+	return usage
+}
+
+// templateDescription returns the description of a built-in template.
+func templateDescription(name string) string {
+	t, err := scaffold.Load(context.Background(), name)
+	if err != nil {
+		return ""
+	}
+	defer t.Close()
+	return t.Manifest.Description
+}
+
 func init() {
 	createCmd.Flags().String("cwd", ".", "specifies the current working directory")
+	createCmd.Flags().String("template", scaffold.DefaultTemplate, "template of the application: a built-in template, a directory or a git URL (optionally followed by #<branch or tag>)")
+	createCmd.Flags().StringArray("var", []string{}, "set a variable of the template (key=value)")
+	createCmd.Flags().String("module", "", "Go module path of the application (e.g. github.com/org/app). Defaults to the import path within GOPATH, or the name of the application")
+	createCmd.Flags().String("sdk-version", scaffold.DefaultSDKVersion, fmt.Sprintf("version of the Cosmos SDK (%s)", strings.Join(scaffold.SDKVersions, ", ")))
+	createCmd.Flags().String("bech32-prefix", project.DefaultBech32Prefix, "bech32 prefix of the addresses (e.g. cosmos for cosmos1...). Requires the Cosmos SDK v0.26.0 or later")
+	createCmd.Flags().String("denom", project.DefaultDenom, "denomination of the coins of the application")
+	createCmd.Flags().StringArray("account", []string{}, "fund an account in the genesis file (<address>:<coins>, e.g. cosmos1...:1000mycoin)")
+	createCmd.Flags().Bool("no-build", false, "don't build the application")
+	createCmd.Flags().BoolP("yes", "y", false, "use the default values of the options which aren't set with flags, without prompting")
+
+	createCmd.SetUsageTemplate(createCmd.UsageTemplate() + templatesUsage())
 
 	rootCmd.AddCommand(createCmd)
 }
 
-func create(rootDir string, p *project.Project) {
+func create(rootDir string, p *project.Project, opts scaffold.Options, build bool) {
 	ctx := context.Background()
 
 	ui.Info("Creating a new blockchain app in %s", ui.Emphasize(rootDir))
 
-	if err := scaffold(rootDir, p); err != nil {
+	if err := scaffoldProject(rootDir, p, opts); err != nil {
 		ui.Fatal("Failed to initialize: %v", err)
 	}
 
-	ui.Info("Building %s", ui.Emphasize(p.Name))
-	b := builder.New(rootDir, p.Image)
-	if err := b.Build(ctx, builder.BuildOpts{}); err != nil {
-		ui.Fatal("Failed to build the application: %v", err)
+	h := hooks.New(rootDir, p)
+	if err := h.Fire(ctx, hooks.PostScaffold, nil); err != nil {
+		ui.Fatal("%v", err)
+	}
+
+	if build {
+		ui.Info("Building %s", ui.Emphasize(p.Name))
+		b := builder.New(rootDir, p.Image)
+		if err := b.Build(ctx, builder.BuildOpts{Hooks: h}); err != nil {
+			ui.Fatal("Failed to build the application: %v", err)
+		}
 	}
 
 	ui.Success("Success! Created %s at %s", ui.Emphasize(p.Name), ui.Emphasize(rootDir))
@@ -85,96 +362,19 @@ We suggest that you begin by typing:
 	)
 }
 
-func scaffold(rootDir string, p *project.Project) error {
+func scaffoldProject(rootDir string, p *project.Project, opts scaffold.Options) error {
 	ui.Info("Scaffolding base application")
 
-	gosource := goSrc()
-
-	if !strings.HasPrefix(rootDir, gosource) {
-		return fmt.Errorf("you must run this command within your GOPATH (%q)", goPath())
-	}
-
-	// Make sure the destination path doesn't exist.
-	if _, err := os.Stat(rootDir); !os.IsNotExist(err) {
-		return fmt.Errorf("destination path %q already exists", rootDir)
-	}
-
-	ctx := &templateContext{
-		Name:    p.Name,
-		RootDir: rootDir,
-		GoPkg:   strings.TrimPrefix(rootDir, gosource+"/"),
+	if opts.GoPkg == "" {
+		opts.GoPkg = defaultGoPkg(rootDir, p.Name)
 	}
 
-	if err := extractFiles(ctx, rootDir, p); err != nil {
+	if err := scaffold.Create(rootDir, p, opts); err != nil {
 		return err
 	}
-	if err := ui.Tree(rootDir, []string{"k8s"}); err != nil {
+	if err := ui.Tree(rootDir, []string{"k8s", scaffold.OriginalsDir}); err != nil {
 		return err
 	}
 
 	return nil
 }
-
-func extractFiles(ctx *templateContext, rootDir string, p *project.Project) error {
-	err := httpfs.Walk(templates.Assets, "/", func(path string, fi os.FileInfo, err error) error {
-		if err != nil {
-			return err
-		}
-		return extractFile(ctx, rootDir, path, p, fi)
-	})
-	return err
-}
-
-func extractFile(ctx *templateContext, rootDir, src string, p *project.Project, fi os.FileInfo) error {
-	// Templatize the file name.
-	parsedSrc, err := templatize(ctx, src, src)
-	if err != nil {
-		return err
-	}
-
-	dstPath := path.Join(rootDir, string(parsedSrc))
-	if fi.IsDir() {
-		return os.MkdirAll(dstPath, fi.Mode())
-	}
-
-	// Save the project manifest on disk
-	if err := p.Save(path.Join(rootDir, "chainkit.yml")); err != nil {
-		return errors.Wrap(err, "Failed to create chainkit.yml")
-	}
-
-	data, err := httpfs.ReadFile(templates.Assets, src)
-	if err != nil {
-		return errors.Wrap(err, "unable to read template file")
-	}
-
-	// Handle templates
-	if filepath.Ext(dstPath) == ".tmpl" {
-		// Parse template
-		data, err = templatize(ctx, dstPath, string(data))
-		if err != nil {
-			return errors.Wrap(err, "unable to templetaize")
-		}
-
-		// Remove .tpl from the file path
-		dstPath = strings.TrimSuffix(dstPath, ".tmpl")
-
-	}
-
-	if err := ioutil.WriteFile(dstPath, data, fi.Mode()); err != nil {
-		return errors.Wrap(err, "unable to write to destination")
-	}
-
-	return nil
-}
-
-func templatize(ctx *templateContext, name, input string) ([]byte, error) {
-	t, err := template.New(name).Parse(input)
-	if err != nil {
-		return nil, err
-	}
-	var buf bytes.Buffer
-	if err := t.Execute(&buf, ctx); err != nil {
-		return nil, err
-	}
-	return buf.Bytes(), nil
-}
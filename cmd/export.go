@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"github.com/blocklayerhq/chainkit/node"
+	"github.com/blocklayerhq/chainkit/ui"
+	"github.com/spf13/cobra"
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export the state of the chain as a genesis file",
+	Long:  "Export the state of the chain as a genesis file. Use `chainkit start --from-export` to start a new chain from it.",
+	Args:  cobra.ExactArgs(0),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+		cfg, p := loadConfigWithPorts(cmd)
+		ensureStopped(ctx, p)
+
+		height, _ := cmd.Flags().GetInt64("height")
+		output, _ := cmd.Flags().GetString("output")
+
+		var w io.Writer = os.Stdout
+		if output != "" {
+			f, err := os.Create(output)
+			if err != nil {
+				ui.Fatal("%v", err)
+			}
+			defer f.Close()
+			w = f
+		}
+
+		if err := node.Export(ctx, cfg, p, height, w); err != nil {
+			ui.Fatal("Failed to export the chain state: %v", err)
+		}
+		if output != "" {
+			ui.Success("State exported to %s", ui.Emphasize(output))
+		}
+	},
+}
+
+func init() {
+	exportCmd.Flags().String("cwd", ".", "specifies the current working directory")
+	exportCmd.Flags().Int64("height", 0, "export the state at this height (defaults to the latest)")
+	exportCmd.Flags().StringP("output", "o", "", "write the exported genesis file to this path instead of stdout")
+
+	rootCmd.AddCommand(exportCmd)
+}
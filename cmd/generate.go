@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"github.com/blocklayerhq/chainkit/project"
+	"github.com/blocklayerhq/chainkit/scaffold"
+	"github.com/blocklayerhq/chainkit/ui"
+	"github.com/spf13/cobra"
+)
+
+var generateCmd = &cobra.Command{
+	Use:   "generate",
+	Short: "Generate code within the application",
+}
+
+var generateModuleCmd = &cobra.Command{
+	Use:   "module <name>",
+	Short: "Generate a module under x/<name> and register it in the application",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		rootDir := getCwd(cmd)
+		p, err := project.Load(rootDir)
+		if err != nil {
+			ui.Fatal("%v", err)
+		}
+
+		files, err := scaffold.AddModule(rootDir, p, args[0])
+		if err != nil {
+			ui.Fatal("Failed to generate the module: %v", err)
+		}
+		printGenerated(files)
+	},
+}
+
+var generateMessageCmd = &cobra.Command{
+	Use:   "message <module> <MsgName> [field:type...]",
+	Short: "Generate a message in a module, with its handler, CLI command and REST route",
+	Long: `Generate a message in a module, with its handler, CLI command and REST route.
+
+Fields are given as name:type, where type is one of string, bool, int, uint,
+coins or address. The sender of the message is always included.`,
+	Example: "  chainkit generate message nameservice MsgBuyName name:string bid:coins",
+	Args:    cobra.MinimumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		rootDir := getCwd(cmd)
+		p, err := project.Load(rootDir)
+		if err != nil {
+			ui.Fatal("%v", err)
+		}
+
+		m, err := scaffold.NewMessage(args[0], args[1], args[2:])
+		if err != nil {
+			ui.Fatal("%v", err)
+		}
+		files, err := scaffold.AddMessage(rootDir, p, m)
+		if err != nil {
+			ui.Fatal("Failed to generate the message: %v", err)
+		}
+		printGenerated(files)
+	},
+}
+
+func init() {
+	generateModuleCmd.Flags().String("cwd", ".", "specifies the current working directory")
+	generateMessageCmd.Flags().String("cwd", ".", "specifies the current working directory")
+
+	generateCmd.AddCommand(generateModuleCmd)
+	generateCmd.AddCommand(generateMessageCmd)
+	rootCmd.AddCommand(generateCmd)
+}
+
+// printGenerated lists the files created or changed by a generator.
+func printGenerated(files []string) {
+	if len(files) == 0 {
+		ui.Success("Nothing to do, everything was already generated")
+		return
+	}
+	for _, f := range files {
+		ui.Verbose("  %s", f)
+	}
+	ui.Success("Generated %d files. Run %s to build the application", len(files), ui.Emphasize("chainkit build"))
+}
@@ -0,0 +1,216 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/blocklayerhq/chainkit/config"
+	"github.com/blocklayerhq/chainkit/genesis"
+	"github.com/blocklayerhq/chainkit/node"
+	"github.com/blocklayerhq/chainkit/project"
+	"github.com/blocklayerhq/chainkit/ui"
+	"github.com/blocklayerhq/chainkit/util"
+	"github.com/manifoldco/promptui"
+	"github.com/spf13/cobra"
+)
+
+var genesisCmd = &cobra.Command{
+	Use:   "genesis",
+	Short: "Manage the genesis file",
+}
+
+var genesisShowCmd = &cobra.Command{
+	Use:   "show",
+	Short: "Print the genesis file",
+	Args:  cobra.ExactArgs(0),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, _ := loadConfig(cmd)
+		data, err := ioutil.ReadFile(cfg.GenesisPath())
+		if err != nil {
+			ui.Fatal("Unable to read the genesis file (is the chain initialized?): %v", err)
+		}
+		os.Stdout.Write(data)
+	},
+}
+
+var genesisValidateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Validate the genesis file",
+	Args:  cobra.ExactArgs(0),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, _ := loadConfig(cmd)
+		data, err := ioutil.ReadFile(cfg.GenesisPath())
+		if err != nil {
+			ui.Fatal("Unable to read the genesis file (is the chain initialized?): %v", err)
+		}
+		if err := genesis.Validate(data); err != nil {
+			ui.Error("Invalid genesis file %s:", cfg.GenesisPath())
+			if errs, ok := err.(genesis.Errors); ok {
+				for _, e := range errs {
+					ui.Error("  %v", e)
+				}
+				os.Exit(1)
+			}
+			ui.Fatal("  %v", err)
+		}
+		ui.Success("The genesis file is valid")
+	},
+}
+
+var genesisAddAccountCmd = &cobra.Command{
+	Use:   "add-account <address|key name> <coins>",
+	Short: "Add a funded account to the genesis file",
+	Long:  "Add a funded account to the genesis file. Plain amounts (e.g. 1000) are in the denomination of the project.",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+		cfg, p := loadConfigWithPorts(cmd)
+
+		address := resolveAddress(ctx, cfg, p, args[0])
+
+		ui.Info("Adding account %s to the genesis file", ui.Emphasize(address))
+		if err := node.AddGenesisAccount(ctx, cfg, p, address, p.Coins(args[1])); err != nil {
+			ui.Fatal("Failed to add the account: %v", err)
+		}
+		ui.Success("Account added")
+	},
+}
+
+var genesisAddValidatorCmd = &cobra.Command{
+	Use:   "add-validator <public key>",
+	Short: "Add a validator to the genesis file",
+	Long:  "Add a validator to the genesis file. The public key is the base64 encoded ed25519 key found in the validator's priv_validator.json.",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+		cfg, p := loadConfigWithPorts(cmd)
+
+		power, err := cmd.Flags().GetInt64("power")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+		name, err := cmd.Flags().GetString("name")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+
+		ui.Info("Adding validator %s to the genesis file", ui.Emphasize(args[0]))
+		if err := node.AddGenesisValidator(ctx, cfg, p, args[0], power, name); err != nil {
+			ui.Fatal("Failed to add the validator: %v", err)
+		}
+		ui.Success("Validator added")
+	},
+}
+
+var genesisGenTxCmd = &cobra.Command{
+	Use:   "gentx <key name>",
+	Short: "Sign a genesis transaction making the node a validator",
+	Long: `Sign a genesis transaction making the node a validator, with a self delegation from the account of the key.
+The account must be funded in the genesis file (see ` + "`chainkit genesis add-account`" + `). Plain amounts (e.g. 1000) are in the denomination of the project.
+
+The transaction is printed and written to the gentx directory of the node. Add it to the genesis file of the chain with ` + "`chainkit genesis add-gentx`" + `.
+The password of the key is prompted for, unless --password or $CHAINKIT_KEY_PASSWORD is set.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+		cfg, p := loadConfigWithPorts(cmd)
+
+		amount, err := cmd.Flags().GetString("amount")
+		if err != nil {
+			ui.Fatal("unable to resolve flag: %v", err)
+		}
+		if amount != "" {
+			amount = p.Coins(amount)
+		}
+
+		password := keyPassword(cmd)
+		if password == "" {
+			if !util.IsTerminal(os.Stdin) {
+				ui.Fatal("--password is required when not running in a terminal")
+			}
+			prompt := promptui.Prompt{
+				Label: fmt.Sprintf("Password of key %q", args[0]),
+				Mask:  '*',
+			}
+			if password, err = prompt.Run(); err != nil {
+				ui.Fatal("Aborted: %v", err)
+			}
+		}
+
+		tx, err := node.GenTx(ctx, cfg, p, args[0], password, amount)
+		if err != nil {
+			ui.Fatal("Failed to sign the genesis transaction: %v", err)
+		}
+		os.Stdout.Write(tx)
+		ui.Success("Genesis transaction signed")
+	},
+}
+
+var genesisAddGenTxCmd = &cobra.Command{
+	Use:   "add-gentx <file>",
+	Short: "Add a genesis transaction to the genesis file",
+	Long:  "Add a genesis transaction, signed by `chainkit genesis gentx`, to the genesis file. Its validator is created when the chain starts.",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+		cfg, p := loadConfigWithPorts(cmd)
+
+		tx, err := ioutil.ReadFile(args[0])
+		if err != nil {
+			ui.Fatal("Unable to read the genesis transaction: %v", err)
+		}
+
+		ui.Info("Adding genesis transaction %s to the genesis file", ui.Emphasize(args[0]))
+		if err := node.AddGenTx(ctx, cfg, p, tx); err != nil {
+			ui.Fatal("Failed to add the genesis transaction: %v", err)
+		}
+		ui.Success("Genesis transaction added")
+	},
+}
+
+func init() {
+	genesisCmd.PersistentFlags().String("cwd", ".", "specifies the current working directory")
+	genesisAddValidatorCmd.Flags().Int64("power", 10, "voting power of the validator")
+	genesisAddValidatorCmd.Flags().String("name", "", "name of the validator")
+	genesisGenTxCmd.Flags().String("amount", "", "coins delegated to the validator (defaults to the application's)")
+	genesisGenTxCmd.Flags().String("password", "", "password of the key (defaults to $CHAINKIT_KEY_PASSWORD)")
+
+	genesisCmd.AddCommand(
+		genesisShowCmd,
+		genesisValidateCmd,
+		genesisAddAccountCmd,
+		genesisAddValidatorCmd,
+		genesisGenTxCmd,
+		genesisAddGenTxCmd,
+	)
+	rootCmd.AddCommand(genesisCmd)
+}
+
+// resolveAddress returns s if it is an address of the project, or the
+// address of the key named s.
+func resolveAddress(ctx context.Context, cfg *config.Config, p *project.Project, s string) string {
+	if !genesis.IsAddress(s) {
+		return keyAddress(ctx, cfg, p, s)
+	}
+	if !p.IsAddress(s) {
+		ui.Fatal("Invalid address %q: the addresses of %s start with %s1", s, p.Name, p.AddressPrefix())
+	}
+	return s
+}
+
+// keyAddress resolves a key name into an address using the application CLI.
+func keyAddress(ctx context.Context, cfg *config.Config, p *project.Project, name string) string {
+	var out bytes.Buffer
+	if err := util.DockerRunCLIWithFD(ctx, cfg, p, nil, &out, os.Stderr, "keys", "show", name, "--address"); err != nil {
+		ui.Fatal("Unable to resolve key %q: %v", name, err)
+	}
+	address := strings.TrimSpace(out.String())
+	if !genesis.IsAddress(address) {
+		ui.Fatal("Unable to resolve key %q: unexpected output %q", name, address)
+	}
+	return address
+}
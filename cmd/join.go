@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"context"
+	"io/ioutil"
 	"os"
 	"os/signal"
 	"path"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/blocklayerhq/chainkit/config"
 	"github.com/blocklayerhq/chainkit/discovery"
+	"github.com/blocklayerhq/chainkit/genesis"
 	"github.com/blocklayerhq/chainkit/node"
 	"github.com/blocklayerhq/chainkit/ui"
 	"github.com/spf13/cobra"
@@ -20,21 +22,20 @@ var (
 )
 
 var joinCmd = &cobra.Command{
-	Use:   "join",
+	Use:   "join <network name|network ID>",
 	Short: "Join a network",
 	Args:  cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		var (
-			ctx     = context.Background()
-			err     error
-			chainID = args[0]
+			ctx  = context.Background()
+			err  error
+			name = args[0]
 		)
 
-		ui.Info("Joining network %s", ui.Emphasize(chainID))
+		ui.Info("Joining network %s", ui.Emphasize(name))
 		cfg := &config.Config{
-			RootDir:        path.Join(networksDir, filepath.Base(chainID)),
+			RootDir:        path.Join(networksDir, filepath.Base(name)),
 			PublishNetwork: false,
-			ChainID:        chainID,
 		}
 		cfg.Ports, err = config.AllocatePorts()
 		if err != nil {
@@ -48,10 +49,8 @@ var joinCmd = &cobra.Command{
 		defer d.Stop()
 
 		ui.Info("Retrieving network information...")
-		network, err := d.Join(ctx, cfg.ChainID)
-		if err != nil {
-			ui.Fatal("Unable to retrieve network information for %q: %v", cfg.ChainID, err)
-		}
+		var network *discovery.NetworkInfo
+		cfg.ChainID, network = resolveNetwork(ctx, d, name)
 		if err := network.WriteManifest(cfg.ManifestPath()); err != nil {
 			ui.Fatal("%v", err)
 		}
@@ -60,11 +59,31 @@ var joinCmd = &cobra.Command{
 			ui.Fatal("%v", err)
 		}
 
+		snapshotInterval, err := cmd.Flags().GetDuration("snapshot-interval")
+		if err != nil {
+			ui.Fatal("unable to parse --snapshot-interval: %v", err)
+		}
+		opts := node.StartOpts{
+			Genesis:          network.Genesis,
+			Release:          network.Release,
+			SnapshotInterval: snapshotInterval,
+		}
+
+		if useSnapshot, _ := cmd.Flags().GetBool("snapshot"); useSnapshot {
+			if _, err := os.Stat(cfg.BlockStorePath()); err == nil {
+				ui.Info("The node already has chain data, not using a snapshot")
+			} else {
+				trust, _ := cmd.Flags().GetBool("trust-snapshot")
+				opts.Snapshot = fetchSnapshot(ctx, d, cfg.ChainID, network, trust)
+				defer os.Remove(opts.Snapshot)
+			}
+		}
+
 		n := node.New(cfg, d)
 		errCh := make(chan error)
 		go func() {
 			defer close(errCh)
-			errCh <- n.Start(ctx, p, network.Genesis, false)
+			errCh <- n.Start(ctx, p, opts)
 		}()
 
 		// Wait for the application to error out or the user to quit.
@@ -86,6 +105,54 @@ var joinCmd = &cobra.Command{
 	},
 }
 
+// fetchSnapshot retrieves and verifies the latest snapshot of a network.
+// Unless trusted, the snapshot must be confirmed by another node of the
+// network. It returns the path of the archive, or an empty string if no
+// snapshot is available.
+func fetchSnapshot(ctx context.Context, d *discovery.Server, networkID string, network *discovery.NetworkInfo, trust bool) string {
+	ui.Info("Looking for snapshots...")
+	info, peers, err := d.LatestSnapshot(ctx, networkID, genesis.Hash(network.Genesis))
+	if err != nil {
+		ui.Fatal("%v", err)
+	}
+	if info == nil {
+		ui.Info("No snapshot available, syncing from the genesis")
+		return ""
+	}
+
+	chainID, err := genesis.ChainID(network.Genesis)
+	if err != nil {
+		ui.Fatal("%v", err)
+	}
+	if err := node.ConfirmSnapshot(ctx, chainID, info, peers); err != nil {
+		if !trust {
+			ui.Fatal("Refusing the snapshot of block %d: %v (use --trust-snapshot to restore it anyway)", info.Height, err)
+		}
+		ui.Error("Restoring an unconfirmed snapshot: %v", err)
+	}
+
+	ui.Info("Retrieving snapshot of block %d...", info.Height)
+	f, err := ioutil.TempFile(os.TempDir(), "chainkit-snapshot")
+	if err != nil {
+		ui.Fatal("Unable to create temporary file: %v", err)
+	}
+	defer f.Close()
+
+	if err := d.FetchSnapshot(ctx, info, f); err != nil {
+		os.Remove(f.Name())
+		ui.Fatal("Failed to retrieve the snapshot: %v", err)
+	}
+	if err := node.VerifySnapshot(f.Name(), info); err != nil {
+		os.Remove(f.Name())
+		ui.Fatal("Failed to verify the snapshot: %v", err)
+	}
+	return f.Name()
+}
+
 func init() {
+	joinCmd.Flags().Bool("snapshot", false, "bootstrap the node from the latest snapshot of the network instead of replaying the chain")
+	joinCmd.Flags().Bool("trust-snapshot", false, "restore the snapshot even if no other node of the network confirms its application hash")
+	joinCmd.Flags().Duration("snapshot-interval", 0, "how often to publish a snapshot of the chain data, e.g. 1h (disabled by default: the daemon is stopped while taking a snapshot)")
+
 	rootCmd.AddCommand(joinCmd)
 }
@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/blocklayerhq/chainkit/discovery"
+	"github.com/blocklayerhq/chainkit/ui"
+	"github.com/spf13/cobra"
+)
+
+var networkCmd = &cobra.Command{
+	Use:   "network",
+	Short: "Manage network names",
+}
+
+var networkListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List known network names",
+	Args:    cobra.ExactArgs(0),
+	Run: func(cmd *cobra.Command, args []string) {
+		names, err := discovery.NewRegistry(discovery.DefaultRegistryPath).List()
+		if err != nil {
+			ui.Fatal("%v", err)
+		}
+
+		keys := make([]string, 0, len(names))
+		for name := range names {
+			keys = append(keys, name)
+		}
+		sort.Strings(keys)
+		for _, name := range keys {
+			fmt.Printf("%s\t%s\n", name, names[name])
+		}
+	},
+}
+
+var networkAliasCmd = &cobra.Command{
+	Use:   "alias <name> <network ID>",
+	Short: "Give a local name to a network",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := discovery.NewRegistry(discovery.DefaultRegistryPath).Add(args[0], args[1]); err != nil {
+			ui.Fatal("%v", err)
+		}
+		ui.Success("%s is now an alias for %s", ui.Emphasize(args[0]), args[1])
+	},
+}
+
+func init() {
+	networkCmd.AddCommand(
+		networkListCmd,
+		networkAliasCmd,
+	)
+	rootCmd.AddCommand(networkCmd)
+}
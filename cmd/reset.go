@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/blocklayerhq/chainkit/node"
+	"github.com/blocklayerhq/chainkit/ui"
+	"github.com/spf13/cobra"
+)
+
+var resetCmd = &cobra.Command{
+	Use:   "reset",
+	Short: "Reset the chain",
+	Long:  "Reset the chain, deleting its data. The keys and genesis file are deleted as well, unless --keep-keys and --keep-genesis are set. They are generated again on the next start.",
+	Args:  cobra.ExactArgs(0),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+		cfg, p := loadConfigWithPorts(cmd)
+		ensureStopped(ctx, p)
+
+		opts := node.ResetOpts{}
+		opts.KeepKeys, _ = cmd.Flags().GetBool("keep-keys")
+		opts.KeepGenesis, _ = cmd.Flags().GetBool("keep-genesis")
+		if opts.KeepGenesis && !opts.KeepKeys {
+			ui.Fatal("--keep-genesis requires --keep-keys: the genesis file refers to the node keys")
+		}
+
+		ui.Info("Resetting %s", ui.Emphasize(p.Name))
+		if err := node.Reset(ctx, cfg, p, opts); err != nil {
+			ui.Fatal("Failed to reset the chain: %v", err)
+		}
+		ui.Success("The chain has been reset")
+	},
+}
+
+func init() {
+	resetCmd.Flags().String("cwd", ".", "specifies the current working directory")
+	resetCmd.Flags().Bool("keep-keys", false, "keep the CLI keys as well as the node and validator keys")
+	resetCmd.Flags().Bool("keep-genesis", false, "keep the genesis file (requires --keep-keys)")
+
+	rootCmd.AddCommand(resetCmd)
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"context"
+	"io/ioutil"
 	"os"
 	"os/signal"
 	"syscall"
@@ -39,6 +40,46 @@ var startCmd = &cobra.Command{
 			ui.Fatal("both options --join and --edit-genesis cannot be combined")
 		}
 
+		genesisPatches, err := cmd.Flags().GetStringSlice("genesis-patch")
+		if err != nil {
+			ui.Fatal("unable to parse --genesis-patch: %v", err)
+		}
+
+		if len(genesisPatches) > 0 && chainID != "" {
+			ui.Fatal("both options --join and --genesis-patch cannot be combined")
+		}
+
+		fromExport, err := cmd.Flags().GetString("from-export")
+		if err != nil {
+			ui.Fatal("unable to parse --from-export: %v", err)
+		}
+
+		if fromExport != "" && chainID != "" {
+			ui.Fatal("both options --join and --from-export cannot be combined")
+		}
+
+		var exported []byte
+		if fromExport != "" {
+			exported, err = ioutil.ReadFile(fromExport)
+			if err != nil {
+				ui.Fatal("Unable to read the exported state: %v", err)
+			}
+		}
+
+		snapshotInterval, err := cmd.Flags().GetDuration("snapshot-interval")
+		if err != nil {
+			ui.Fatal("unable to parse --snapshot-interval: %v", err)
+		}
+
+		watch, err := cmd.Flags().GetBool("watch")
+		if err != nil {
+			ui.Fatal("unable to parse --watch: %v", err)
+		}
+
+		if watch && chainID != "" {
+			ui.Fatal("both options --join and --watch cannot be combined")
+		}
+
 		ctx := context.Background()
 		cfg := &config.Config{
 			RootDir:        rootDir,
@@ -65,10 +106,7 @@ var startCmd = &cobra.Command{
 			cfg.PublishNetwork = false
 
 			ui.Info("Joining network %s...", chainID)
-			network, err = d.Join(ctx, cfg.ChainID)
-			if err != nil {
-				ui.Fatal("Unable to retrieve network information for %q: %v", cfg.ChainID, err)
-			}
+			cfg.ChainID, network = resolveNetwork(ctx, d, chainID)
 		}
 
 		n := node.New(cfg, d)
@@ -76,11 +114,19 @@ var startCmd = &cobra.Command{
 		go func() {
 			defer close(errCh)
 
-			var genesis []byte
+			opts := node.StartOpts{
+				EditGenesis:    editGenesis,
+				GenesisPatches: genesisPatches,
+				FromExport:     exported,
+
+				SnapshotInterval: snapshotInterval,
+				Watch:            watch,
+			}
 			if network != nil {
-				genesis = network.Genesis
+				opts.Genesis = network.Genesis
+				opts.Release = network.Release
 			}
-			errCh <- n.Start(ctx, p, genesis, editGenesis)
+			errCh <- n.Start(ctx, p, opts)
 		}()
 
 		// Wait for the application to error out or the user to quit.
@@ -104,8 +150,12 @@ var startCmd = &cobra.Command{
 
 func init() {
 	startCmd.Flags().String("cwd", ".", "specifies the current working directory")
-	startCmd.Flags().String("join", "", "join a network")
+	startCmd.Flags().String("join", "", "join a network (by name or network ID)")
 	startCmd.Flags().Bool("edit-genesis", false, "spawns an editor to change the genesis file before the chain starts (only works if the chain hasn't been initialized)")
+	startCmd.Flags().String("from-export", "", "starts a new chain from a state exported by \"chainkit export\" (only works if the chain hasn't started)")
+	startCmd.Flags().Duration("snapshot-interval", 0, "how often to publish a snapshot of the chain data, e.g. 1h (disabled by default: the daemon is stopped while taking a snapshot)")
+	startCmd.Flags().Bool("watch", false, "rebuilds the application and restarts the node, keeping its state, whenever the Go sources change")
+	startCmd.Flags().StringSlice("genesis-patch", []string{}, "applies a JSON Patch or JSON Merge Patch file to the genesis file before the chain starts (only works if the chain hasn't been initialized)")
 
 	rootCmd.AddCommand(startCmd)
 }
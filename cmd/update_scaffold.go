@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/blocklayerhq/chainkit/project"
+	"github.com/blocklayerhq/chainkit/scaffold"
+	"github.com/blocklayerhq/chainkit/ui"
+	"github.com/spf13/cobra"
+)
+
+var updateScaffoldCmd = &cobra.Command{
+	Use:   "update-scaffold",
+	Short: "Merge the changes of the template into the application",
+	Long: `Merge the changes made to the template of the application since it was
+created (or last updated) into its files, keeping the changes made to the
+application.
+
+Changes to the same lines conflict: both versions are written, surrounded by
+conflict markers, and must be resolved by hand. Use --dry-run to review the
+changes first.`,
+	Args: cobra.ExactArgs(0),
+	Run: func(cmd *cobra.Command, args []string) {
+		rootDir := getCwd(cmd)
+		p, err := project.Load(rootDir)
+		if err != nil {
+			ui.Fatal("%v", err)
+		}
+		dryRun, err := cmd.Flags().GetBool("dry-run")
+		if err != nil {
+			ui.Fatal("unable to parse --dry-run: %v", err)
+		}
+		source, err := cmd.Flags().GetString("template")
+		if err != nil {
+			ui.Fatal("unable to parse --template: %v", err)
+		}
+
+		if source == "" && p.Scaffold == nil {
+			ui.Fatal("The template of %s isn't recorded in chainkit.yml: specify it with --template", p.Name)
+		}
+
+		opts := scaffold.UpdateOptions{DryRun: dryRun}
+		if source != "" {
+			t, err := scaffold.Load(context.Background(), source)
+			if err != nil {
+				ui.Fatal("Failed to load the template: %v", err)
+			}
+			defer t.Close()
+			opts.Template = t
+		}
+
+		ui.Info("Updating %s from its template", ui.Emphasize(p.Name))
+		changes, err := scaffold.Update(rootDir, p, opts)
+		if err != nil {
+			ui.Fatal("Failed to update the application: %v", err)
+		}
+		printUpdate(changes, dryRun)
+	},
+}
+
+func init() {
+	updateScaffoldCmd.Flags().String("cwd", ".", "specifies the current working directory")
+	updateScaffoldCmd.Flags().Bool("dry-run", false, "show the changes without applying them")
+	updateScaffoldCmd.Flags().String("template", "", "update from this template (a built-in template, a directory or a git URL) instead of the one recorded in chainkit.yml")
+
+	rootCmd.AddCommand(updateScaffoldCmd)
+}
+
+// printUpdate describes the changes of update-scaffold.
+func printUpdate(changes []*scaffold.Change, dryRun bool) {
+	if len(changes) == 0 {
+		ui.Success("Nothing to do, the application is up to date")
+		return
+	}
+
+	conflicts := []string{}
+	for _, c := range changes {
+		switch c.Status {
+		case scaffold.Conflict:
+			conflicts = append(conflicts, c.Path)
+			ui.Error("%-8s %s", c.Status, c.Path)
+		case scaffold.Kept:
+			ui.Verbose("%-8s %s %s", c.Status, c.Path, ui.Small("(changed on one side, removed on the other)"))
+		default:
+			ui.Verbose("%-8s %s", c.Status, c.Path)
+		}
+		if dryRun && c.Diff != "" {
+			fmt.Print(c.Diff)
+		}
+	}
+
+	switch {
+	case dryRun && len(conflicts) > 0:
+		ui.Fatal("%d files would conflict. Nothing was changed", len(conflicts))
+	case dryRun:
+		ui.Success("%d files would be updated. Nothing was changed", len(changes))
+	case len(conflicts) > 0:
+		ui.Fatal("%d files have conflicts: resolve the conflict markers, then run %s", len(conflicts), ui.Emphasize("chainkit build"))
+	default:
+		ui.Success("Updated %d files. Run %s to build the application", len(changes), ui.Emphasize("chainkit build"))
+	}
+}
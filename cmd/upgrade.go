@@ -0,0 +1,141 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"path"
+	"path/filepath"
+
+	"github.com/blocklayerhq/chainkit/config"
+	"github.com/blocklayerhq/chainkit/discovery"
+	"github.com/blocklayerhq/chainkit/node"
+	"github.com/blocklayerhq/chainkit/ui"
+	"github.com/blocklayerhq/chainkit/util"
+	"github.com/spf13/cobra"
+)
+
+var upgradeCmd = &cobra.Command{
+	Use:   "upgrade [network name]",
+	Short: "Upgrade the node to the latest release of its network",
+	Long:  "Upgrade the node to the latest release of its network. Without arguments, upgrades the project in the current directory. With a network name, upgrades the node created by `chainkit join`.\n\nWith --height, schedules a coordinated upgrade instead: the node stops at the given block, switches to --image and restarts on the same state.",
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+
+		joined := len(args) == 1
+		rootDir := getCwd(cmd)
+		if joined {
+			rootDir = path.Join(networksDir, filepath.Base(args[0]))
+		}
+
+		cfg := &config.Config{
+			RootDir: rootDir,
+		}
+
+		if cancel, _ := cmd.Flags().GetBool("cancel"); cancel {
+			if err := node.CancelUpgrade(cfg); err != nil {
+				ui.Fatal("%v", err)
+			}
+			ui.Success("Upgrade cancelled")
+			return
+		}
+		height, _ := cmd.Flags().GetInt64("height")
+		image, _ := cmd.Flags().GetString("image")
+		if height != 0 || image != "" {
+			scheduleUpgrade(ctx, cmd, cfg, height, image)
+			return
+		}
+
+		membership, err := discovery.LoadMembership(cfg.MembershipPath())
+		if err != nil {
+			ui.Fatal("%v", err)
+		}
+
+		cfg.Ports, err = config.AllocatePorts()
+		if err != nil {
+			ui.Fatal("%v", err)
+		}
+
+		d := discovery.New(cfg.IPFSDir(), cfg.Ports.IPFS)
+		if err := d.Start(ctx); err != nil {
+			ui.Fatal("Failed to initialize discovery (is the node still running?): %v", err)
+		}
+		defer d.Stop()
+
+		ui.Info("Checking for new releases of %s...", ui.Emphasize(membership.NetworkID))
+		release, err := d.ResolveRelease(ctx, membership.NetworkID)
+		if err != nil {
+			ui.Fatal("%v", err)
+		}
+		if release == membership.Release {
+			ui.Success("Already running the latest release (%s)", release)
+			return
+		}
+
+		ui.Info("Retrieving release %s...", ui.Emphasize(release))
+		network, err := d.Join(ctx, membership.NetworkID)
+		if err != nil {
+			ui.Fatal("Unable to retrieve release %s: %v", release, err)
+		}
+		defer network.Image.Close()
+
+		genesis, err := ioutil.ReadFile(cfg.GenesisPath())
+		if err == nil && !bytes.Equal(genesis, network.Genesis) {
+			ui.Error("The new release has a different genesis file: its chain cannot be joined without resetting the node state")
+		}
+
+		if err := util.DockerLoad(ctx, network.Image); err != nil {
+			ui.Fatal("Failed to load the image: %v", err)
+		}
+
+		// Nodes created by `chainkit join` run the network's manifest.
+		if joined {
+			if err := network.WriteManifest(cfg.ManifestPath()); err != nil {
+				ui.Fatal("%v", err)
+			}
+		}
+
+		membership.Release = network.Release
+		if err := membership.Save(cfg.MembershipPath()); err != nil {
+			ui.Fatal("%v", err)
+		}
+
+		ui.Success("Upgraded to release %s, restart the node to run it", ui.Emphasize(network.Release))
+	},
+}
+
+func scheduleUpgrade(ctx context.Context, cmd *cobra.Command, cfg *config.Config, height int64, image string) {
+	if height <= 0 || image == "" {
+		ui.Fatal("--height and --image must be used together")
+	}
+	if err := util.RunWithFD(ctx, nil, ioutil.Discard, ioutil.Discard, "docker", "image", "inspect", image); err != nil {
+		ui.Fatal("Image %s not found", image)
+	}
+
+	migrate, _ := cmd.Flags().GetString("migrate")
+	export, _ := cmd.Flags().GetBool("export")
+	plan := &node.UpgradePlan{
+		Height:  height,
+		Image:   image,
+		Migrate: migrate,
+		Export:  export,
+	}
+	if err := node.ScheduleUpgrade(cfg, plan); err != nil {
+		ui.Fatal("%v", err)
+	}
+
+	ui.Success("Upgrade to %s scheduled at block %d", ui.Emphasize(image), height)
+	ui.Info("A running node applies it when reaching that block, otherwise it is applied on the next start")
+}
+
+func init() {
+	upgradeCmd.Flags().String("cwd", ".", "specifies the current working directory")
+	upgradeCmd.Flags().Int64("height", 0, "stop the node at this block and switch to --image")
+	upgradeCmd.Flags().String("image", "", "image to switch to at --height")
+	upgradeCmd.Flags().String("migrate", "", "shell command to run within the new image before restarting")
+	upgradeCmd.Flags().Bool("export", false, "restart the chain from an export of its state")
+	upgradeCmd.Flags().Bool("cancel", false, "cancel the scheduled upgrade")
+
+	rootCmd.AddCommand(upgradeCmd)
+}
@@ -1,11 +1,19 @@
 package cmd
 
 import (
+	"bytes"
+	"context"
 	"os"
 	"path"
 	"path/filepath"
+	"strings"
 
+	"github.com/blocklayerhq/chainkit/config"
+	"github.com/blocklayerhq/chainkit/discovery"
+	"github.com/blocklayerhq/chainkit/genesis"
+	"github.com/blocklayerhq/chainkit/project"
 	"github.com/blocklayerhq/chainkit/ui"
+	"github.com/blocklayerhq/chainkit/util"
 	"github.com/spf13/cobra"
 )
 
@@ -29,6 +37,101 @@ func getCwd(cmd *cobra.Command) string {
 	return abs
 }
 
+// loadConfig loads the project in the working directory and its config.
+func loadConfig(cmd *cobra.Command) (*config.Config, *project.Project) {
+	rootDir := getCwd(cmd)
+	p, err := project.Load(rootDir)
+	if err != nil {
+		ui.Fatal("%v", err)
+	}
+
+	cfg := &config.Config{
+		RootDir: rootDir,
+	}
+	return cfg, p
+}
+
+// loadConfigWithPorts is like loadConfig, but also allocates the ports
+// needed to run the daemon.
+func loadConfigWithPorts(cmd *cobra.Command) (*config.Config, *project.Project) {
+	cfg, p := loadConfig(cmd)
+
+	var err error
+	cfg.Ports, err = config.AllocatePorts()
+	if err != nil {
+		ui.Fatal("%v", err)
+	}
+	return cfg, p
+}
+
+// runningNode is a running daemon container.
+type runningNode struct {
+	ID string
+	// Root is the root directory of the node on the host.
+	Root string
+}
+
+// Name returns the name of the node, which is the name of its root directory.
+func (n runningNode) Name() string {
+	return filepath.Base(n.Root)
+}
+
+// runningNodes returns the running daemon containers of the project.
+func runningNodes(ctx context.Context, p *project.Project) []runningNode {
+	var out bytes.Buffer
+	err := util.RunWithFD(ctx, nil, &out, os.Stderr, "docker", "ps",
+		"--format", `{{.ID}} {{.Label "chainkit.root"}}`,
+		"-f", "label=chainkit.cosmos.daemon",
+		"-f", "label=chainkit.project="+p.Name,
+	)
+	if err != nil {
+		ui.Fatal("Unable to list containers: %v", err)
+	}
+
+	nodes := []runningNode{}
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		fields := strings.SplitN(strings.TrimSpace(line), " ", 2)
+		if fields[0] == "" {
+			continue
+		}
+		n := runningNode{ID: fields[0]}
+		if len(fields) == 2 {
+			n.Root = fields[1]
+		}
+		nodes = append(nodes, n)
+	}
+	return nodes
+}
+
+// runningContainers returns the IDs of the running daemon containers of the project.
+func runningContainers(ctx context.Context, p *project.Project) []string {
+	ids := []string{}
+	for _, n := range runningNodes(ctx, p) {
+		ids = append(ids, n.ID)
+	}
+	return ids
+}
+
+// runningContainer returns the ID of the running daemon container of the project.
+func runningContainer(ctx context.Context, p *project.Project) string {
+	ids := runningContainers(ctx, p)
+	switch len(ids) {
+	case 0:
+		ui.Fatal("%s is not running", p.Name)
+	case 1:
+		return ids[0]
+	}
+	ui.Fatal("Several nodes of %s are running", p.Name)
+	return ""
+}
+
+// ensureStopped aborts if the daemon of the project is running.
+func ensureStopped(ctx context.Context, p *project.Project) {
+	if len(runningContainers(ctx, p)) > 0 {
+		ui.Fatal("%s is running, stop it first", p.Name)
+	}
+}
+
 func goPath() string {
 	p := os.Getenv("GOPATH")
 	if p != "" {
@@ -40,3 +143,31 @@ func goPath() string {
 func goSrc() string {
 	return path.Join(goPath(), "src")
 }
+
+// resolveNetwork resolves a network name (or ID) and retrieves the network
+// information. It returns the network ID.
+func resolveNetwork(ctx context.Context, d *discovery.Server, name string) (string, *discovery.NetworkInfo) {
+	networkID, err := d.Resolve(ctx, name)
+	if err != nil {
+		ui.Fatal("%v", err)
+	}
+
+	network, err := d.Join(ctx, networkID)
+	if err != nil {
+		ui.Fatal("Unable to retrieve network information for %q: %v", name, err)
+	}
+
+	// Make sure the network name and the genesis chain ID are consistent.
+	chainID, err := genesis.ChainID(network.Genesis)
+	if err != nil {
+		ui.Fatal("%v", err)
+	}
+	if !discovery.IsNetworkID(name) && chainID != name {
+		ui.Fatal("Network %s has chain ID %q, which doesn't match its name", networkID, chainID)
+	}
+	if err := d.Names.Add(chainID, networkID); err != nil {
+		ui.Error("Unable to register network name %q: %v", chainID, err)
+	}
+
+	return networkID, network
+}
@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/blocklayerhq/chainkit/ui"
+	"github.com/blocklayerhq/chainkit/util"
+	"github.com/manifoldco/promptui"
+	"github.com/spf13/cobra"
+)
+
+// wizard prompts for the options of a command which weren't set with
+// flags, when running in a terminal.
+type wizard struct {
+	cmd         *cobra.Command
+	interactive bool
+}
+
+func newWizard(cmd *cobra.Command) *wizard {
+	yes, err := cmd.Flags().GetBool("yes")
+	if err != nil {
+		ui.Fatal("unable to parse --yes: %v", err)
+	}
+	return &wizard{
+		cmd:         cmd,
+		interactive: util.IsTerminal(os.Stdin) && !yes,
+	}
+}
+
+// ask returns true if the user should be prompted for the value of flag.
+func (w *wizard) ask(flag string) bool {
+	return w.interactive && !w.cmd.Flags().Changed(flag)
+}
+
+// prompt asks for a value, def by default. validate may be nil.
+func (w *wizard) prompt(label, def string, validate func(string) error) string {
+	prompt := promptui.Prompt{
+		Label:     label,
+		Default:   def,
+		AllowEdit: true,
+		Validate:  validate,
+	}
+	value, err := prompt.Run()
+	if err != nil {
+		ui.Fatal("Aborted: %v", err)
+	}
+	return value
+}
+
+// choose asks to pick one of items and returns its index.
+func (w *wizard) choose(label string, items []string) int {
+	prompt := promptui.Select{
+		Label: label,
+		Items: items,
+	}
+	i, _, err := prompt.Run()
+	if err != nil {
+		ui.Fatal("Aborted: %v", err)
+	}
+	return i
+}
+
+// confirm asks a yes or no question, yes being the first choice.
+func (w *wizard) confirm(label string) bool {
+	return w.choose(label, []string{"Yes", "No"}) == 0
+}
@@ -27,6 +27,12 @@ func (c *Config) DataDir() string {
 	return path.Join(c.StateDir(), "data")
 }
 
+// BlockStorePath returns the path of the block store. It only exists once
+// the chain has started producing blocks.
+func (c *Config) BlockStorePath() string {
+	return path.Join(c.DataDir(), "blockstore.db")
+}
+
 // ConfigDir returns the config directory within the project state.
 func (c *Config) ConfigDir() string {
 	return path.Join(c.StateDir(), "config")
@@ -47,6 +53,23 @@ func (c *Config) GenesisPath() string {
 	return path.Join(c.ConfigDir(), "genesis.json")
 }
 
+// GenesisHashPath returns the path where the digest of the genesis file
+// the chain was started with is recorded.
+func (c *Config) GenesisHashPath() string {
+	return path.Join(c.StateDir(), "genesis.sha256")
+}
+
+// MembershipPath returns the path of the file recording the network the
+// node belongs to.
+func (c *Config) MembershipPath() string {
+	return path.Join(c.StateDir(), "network.yml")
+}
+
+// UpgradePlanPath returns the path of the file describing a scheduled upgrade.
+func (c *Config) UpgradePlanPath() string {
+	return path.Join(c.StateDir(), "upgrade.yml")
+}
+
 // CLIDir returns the CLI directory within the project state.
 func (c *Config) CLIDir() string {
 	return path.Join(c.StateDir(), "cli")
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"sync"
 
 	"github.com/blocklayerhq/chainkit/ui"
 )
@@ -14,7 +15,7 @@ const (
 	// maxPort is the maximum port that will be used
 	maxPort = 60000
 	// numPorts is the number of ports that will be used
-	numPorts = 4
+	numPorts = 5
 	// portStep is the step between port ranges
 	portStep = 10
 )
@@ -22,6 +23,11 @@ const (
 var (
 	// ErrPortsUnavailable is returned when no ports can be found.
 	ErrPortsUnavailable = errors.New("unable to allocate ports")
+
+	// reserved holds the port ranges allocated by this process and not yet
+	// released, which may not be bound yet.
+	reserved   = make(map[int]bool)
+	reservedMu sync.Mutex
 )
 
 // PortMapper holds port configuration.
@@ -30,14 +36,19 @@ type PortMapper struct {
 	TendermintRPC int
 	TendermintP2P int
 	IPFS          int
+	Faucet        int
 }
 
 // AllocatePorts will allocate a set of ports
 func AllocatePorts() (*PortMapper, error) {
+	reservedMu.Lock()
+	defer reservedMu.Unlock()
+
 	for port := minPort; port < maxPort; port += portStep {
-		if !portRangeAvailable(port, numPorts) {
+		if reserved[port] || !portRangeAvailable(port, numPorts) {
 			continue
 		}
+		reserved[port] = true
 		if port != minPort {
 			ui.Error("Port range %d-%d not available, using %d-%d instead",
 				minPort, minPort+numPorts,
@@ -48,12 +59,22 @@ func AllocatePorts() (*PortMapper, error) {
 			TendermintRPC: port + 1,
 			TendermintP2P: port + 2,
 			IPFS:          port + 3,
+			Faucet:        port + 4,
 		}, nil
 	}
 
 	return nil, ErrPortsUnavailable
 }
 
+// ReleasePorts releases ports allocated by AllocatePorts, once they are no
+// longer in use.
+func ReleasePorts(m *PortMapper) {
+	reservedMu.Lock()
+	defer reservedMu.Unlock()
+
+	delete(reserved, m.Explorer)
+}
+
 func portRangeAvailable(base, n int) bool {
 	// We are dialing in addition to listening because for some reason,
 	// if the port is being used by a container, it will listen just fine
@@ -9,6 +9,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path"
+	"sync"
 	"time"
 
 	"github.com/blocklayerhq/chainkit/project"
@@ -49,10 +50,20 @@ type PeerInfo struct {
 	NodeID            string   `json:"node_id"`
 	IP                []string `json:"ips"`
 	TendermintP2PPort int      `json:"tendermint_p2p_port"`
+	// TendermintRPCPort is used by joining nodes to check snapshots against
+	// the chain.
+	TendermintRPCPort int    `json:"tendermint_rpc_port,omitempty"`
+	NetworkID         string `json:"network_id,omitempty"`
+	ChainID           string `json:"chain_id,omitempty"`
+
+	// Snapshot is the latest snapshot of the chain data published by the node.
+	Snapshot *SnapshotInfo `json:"snapshot,omitempty"`
 }
 
 // NetworkInfo represents a network.
 type NetworkInfo struct {
+	// Release is the content ID of the network release.
+	Release  string
 	Manifest []byte
 	Genesis  []byte
 	Image    io.ReadCloser
@@ -73,6 +84,9 @@ func (n *NetworkInfo) WriteManifest(dst string) error {
 
 // Server is the discovery server
 type Server struct {
+	// Names is the local registry of network names.
+	Names *Registry
+
 	root string
 	port int
 	node *core.IpfsNode
@@ -81,11 +95,15 @@ type Server struct {
 	connectedCh chan (struct{})
 
 	api iface.CoreAPI
+
+	mu       sync.Mutex
+	snapshot *SnapshotInfo
 }
 
 // New returns a new discovery server
 func New(root string, port int) *Server {
 	return &Server{
+		Names:       NewRegistry(DefaultRegistryPath),
 		root:        root,
 		port:        port,
 		connectedCh: make(chan struct{}),
@@ -177,7 +195,7 @@ func (s *Server) dhtConnect(ctx context.Context) {
 	}
 }
 
-// Publish publishes chain information. Returns the chain ID.
+// Publish publishes chain information. Returns the network ID.
 func (s *Server) Publish(ctx context.Context, manifestPath, genesisPath, imagePath string) (string, error) {
 	sandbox, err := ioutil.TempDir(os.TempDir(), "chainkit-network")
 	if err != nil {
@@ -213,8 +231,13 @@ func (s *Server) Publish(ctx context.Context, manifestPath, genesisPath, imagePa
 }
 
 // Join joins a network.
-func (s *Server) Join(ctx context.Context, chainID string) (*NetworkInfo, error) {
-	manifestPath, err := iface.ParsePath(path.Join("/ipfs", chainID, "chainkit.yml"))
+func (s *Server) Join(ctx context.Context, networkID string) (*NetworkInfo, error) {
+	release, err := s.ResolveRelease(ctx, networkID)
+	if err != nil {
+		return nil, err
+	}
+
+	manifestPath, err := iface.ParsePath(path.Join("/ipfs", release, "chainkit.yml"))
 	if err != nil {
 		return nil, err
 	}
@@ -227,7 +250,7 @@ func (s *Server) Join(ctx context.Context, chainID string) (*NetworkInfo, error)
 		return nil, errors.Wrap(err, "unable to read genesis file")
 	}
 
-	genesisPath, err := iface.ParsePath(path.Join("/ipfs", chainID, "genesis.json"))
+	genesisPath, err := iface.ParsePath(path.Join("/ipfs", release, "genesis.json"))
 	if err != nil {
 		return nil, err
 	}
@@ -240,13 +263,14 @@ func (s *Server) Join(ctx context.Context, chainID string) (*NetworkInfo, error)
 		return nil, errors.Wrap(err, "unable to read genesis file")
 	}
 
-	imagePath, err := iface.ParsePath(path.Join("/ipfs", chainID, "image.tgz"))
+	imagePath, err := iface.ParsePath(path.Join("/ipfs", release, "image.tgz"))
 	imageFile, err := s.api.Unixfs().Get(ctx, imagePath)
 	if err != nil {
 		return nil, err
 	}
 
 	return &NetworkInfo{
+		Release:  release,
 		Manifest: manifestData,
 		Genesis:  genesisData,
 		Image:    imageFile,
@@ -256,19 +280,30 @@ func (s *Server) Join(ctx context.Context, chainID string) (*NetworkInfo, error)
 }
 
 // Announce announces our presence as a network node.
-func (s *Server) Announce(ctx context.Context, chainID string, peer *PeerInfo) error {
+// If the peer has a chain ID, it is also announced as the network name.
+func (s *Server) Announce(ctx context.Context, networkID string, peer *PeerInfo) error {
 	// Wait for the DHT to be connected before searching.
 	<-s.connectedCh
 
-	id, err := cid.Decode(chainID)
+	id, err := networkKey(networkID)
 	if err != nil {
 		return err
 	}
+	keys := []cid.Cid{id}
+	if peer.ChainID != "" {
+		key, err := nameKey(peer.ChainID)
+		if err != nil {
+			return err
+		}
+		keys = append(keys, key)
+	}
 
 	s.node.PeerHost.SetStreamHandler("/chainkit/0.1.0", func(stream net.Stream) {
 		defer stream.Close()
+		info := *peer
+		info.Snapshot = s.announcedSnapshot()
 		enc := json.NewEncoder(stream)
-		if err := enc.Encode(peer); err != nil {
+		if err := enc.Encode(&info); err != nil {
 			ui.Error("failed to encode: %v", err)
 			return
 		}
@@ -276,22 +311,29 @@ func (s *Server) Announce(ctx context.Context, chainID string, peer *PeerInfo) e
 
 	cctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
-	if err := s.dht.Provide(cctx, id, true); err != nil {
-		return err
+	for _, key := range keys {
+		if err := s.dht.Provide(cctx, key, true); err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
 // Peers looks for peers in the network
-func (s *Server) Peers(ctx context.Context, chainID string) (<-chan *PeerInfo, error) {
-	// Wait for the DHT to be connected before searching.
-	<-s.connectedCh
-
-	id, err := cid.Decode(chainID)
+func (s *Server) Peers(ctx context.Context, networkID string) (<-chan *PeerInfo, error) {
+	id, err := networkKey(networkID)
 	if err != nil {
 		return nil, err
 	}
 
+	return s.findPeers(ctx, id), nil
+}
+
+// findPeers looks for peers providing the given key.
+func (s *Server) findPeers(ctx context.Context, key cid.Cid) <-chan *PeerInfo {
+	// Wait for the DHT to be connected before searching.
+	<-s.connectedCh
+
 	ch := make(chan *PeerInfo)
 	go func() {
 		tctx, cancel := context.WithTimeout(ctx, 10*time.Second)
@@ -299,7 +341,7 @@ func (s *Server) Peers(ctx context.Context, chainID string) (<-chan *PeerInfo, e
 		defer cancel()
 		defer close(ch)
 
-		peers := s.dht.FindProvidersAsync(tctx, id, 10)
+		peers := s.dht.FindProvidersAsync(tctx, key, 10)
 		for p := range peers {
 			if p.ID != s.node.PeerHost.ID() && len(p.Addrs) > 0 {
 				stream, err := s.node.PeerHost.NewStream(ctx, p.ID, "/chainkit/0.1.0")
@@ -330,5 +372,5 @@ func (s *Server) Peers(ctx context.Context, chainID string) (<-chan *PeerInfo, e
 		}
 	}()
 
-	return ch, nil
+	return ch
 }
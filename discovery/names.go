@@ -0,0 +1,157 @@
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	cid "github.com/ipsn/go-ipfs/gxlibs/github.com/ipfs/go-cid"
+	mh "github.com/ipsn/go-ipfs/gxlibs/github.com/multiformats/go-multihash"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	// DefaultRegistryPath is the location of the local network names registry.
+	DefaultRegistryPath = os.ExpandEnv("$HOME/.chainkit/names.yml")
+
+	nameRegexp = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]*$`)
+)
+
+// ValidateName checks a network name is valid. Network names are used as
+// the Tendermint chain ID, hence the size limit.
+func ValidateName(name string) error {
+	if len(name) > 50 || !nameRegexp.MatchString(name) {
+		return fmt.Errorf("invalid network name %q: only letters, digits, '.', '_' and '-' are allowed (max 50 characters)", name)
+	}
+	return nil
+}
+
+// IsNetworkID returns true if s is a raw network ID (as opposed to a name).
+func IsNetworkID(s string) bool {
+	if IsPointer(s) {
+		return true
+	}
+	_, err := cid.Decode(s)
+	return err == nil
+}
+
+// nameKey returns the DHT key used to announce a network name.
+func nameKey(name string) (cid.Cid, error) {
+	hash, err := mh.Sum([]byte("chainkit/network/"+name), mh.SHA2_256, -1)
+	if err != nil {
+		return cid.Cid{}, err
+	}
+	return cid.NewCidV1(cid.Raw, hash), nil
+}
+
+// Registry is a local registry mapping network names to network IDs.
+type Registry struct {
+	path string
+}
+
+// NewRegistry returns a registry stored at the given path.
+func NewRegistry(path string) *Registry {
+	return &Registry{
+		path: path,
+	}
+}
+
+// List returns all the known names.
+func (r *Registry) List() (map[string]string, error) {
+	names := make(map[string]string)
+	data, err := ioutil.ReadFile(r.path)
+	if os.IsNotExist(err) {
+		return names, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(data, &names); err != nil {
+		return nil, errors.Wrapf(err, "unable to parse %q", r.path)
+	}
+	return names, nil
+}
+
+// Lookup returns the network ID for a name, or an empty string if unknown.
+func (r *Registry) Lookup(name string) (string, error) {
+	names, err := r.List()
+	if err != nil {
+		return "", err
+	}
+	return names[name], nil
+}
+
+// Add records a name for a network ID, replacing any previous entry.
+func (r *Registry) Add(name, networkID string) error {
+	if err := ValidateName(name); err != nil {
+		return err
+	}
+	if !IsNetworkID(networkID) {
+		return fmt.Errorf("invalid network ID %q", networkID)
+	}
+	names, err := r.List()
+	if err != nil {
+		return err
+	}
+	names[name] = networkID
+
+	data, err := yaml.Marshal(names)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(path.Dir(r.path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(r.path, data, 0644)
+}
+
+// Resolve returns the network ID for a name or network ID.
+// Names are looked up in the local registry first, then by asking the
+// nodes announcing that name on the network.
+func (s *Server) Resolve(ctx context.Context, name string) (string, error) {
+	if IsNetworkID(name) {
+		return name, nil
+	}
+	if err := ValidateName(name); err != nil {
+		return "", err
+	}
+
+	networkID, err := s.Names.Lookup(name)
+	if err != nil {
+		return "", err
+	}
+	if networkID != "" {
+		return networkID, nil
+	}
+
+	key, err := nameKey(name)
+	if err != nil {
+		return "", err
+	}
+	found := make(map[string]struct{})
+	for peer := range s.findPeers(ctx, key) {
+		if peer.ChainID == name && IsNetworkID(peer.NetworkID) {
+			found[peer.NetworkID] = struct{}{}
+		}
+	}
+
+	ids := []string{}
+	for id := range found {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("unable to resolve network %q: no node found", name)
+	case 1:
+		return ids[0], nil
+	}
+	return "", fmt.Errorf("network name %q is ambiguous, use one of the following network IDs instead: %s", name, strings.Join(ids, ", "))
+}
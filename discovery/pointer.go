@@ -0,0 +1,162 @@
+package discovery
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+
+	iface "github.com/ipsn/go-ipfs/core/coreapi/interface"
+	"github.com/ipsn/go-ipfs/core/coreapi/interface/options"
+	cid "github.com/ipsn/go-ipfs/gxlibs/github.com/ipfs/go-cid"
+	peer "github.com/ipsn/go-ipfs/gxlibs/github.com/libp2p/go-libp2p-peer"
+	mh "github.com/ipsn/go-ipfs/gxlibs/github.com/multiformats/go-multihash"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// pointerValidity is how long a published pointer stays valid.
+	// The IPFS node republishes it periodically while running.
+	pointerValidity = 7 * 24 * time.Hour
+)
+
+// IsPointer returns true if the network ID is a mutable pointer (an IPNS
+// name) rather than the content ID of a single release.
+// Pointers are published using ed25519 keys, which are inlined in the ID.
+func IsPointer(networkID string) bool {
+	id, err := peer.IDB58Decode(networkID)
+	if err != nil {
+		return false
+	}
+	decoded, err := mh.Decode([]byte(id))
+	return err == nil && decoded.Code == mh.ID
+}
+
+// networkKey returns the DHT key used to announce network members.
+func networkKey(networkID string) (cid.Cid, error) {
+	if IsPointer(networkID) {
+		hash, err := mh.Sum([]byte("chainkit/pointer/"+networkID), mh.SHA2_256, -1)
+		if err != nil {
+			return cid.Cid{}, err
+		}
+		return cid.NewCidV1(cid.Raw, hash), nil
+	}
+	return cid.Decode(networkID)
+}
+
+// PublishPointer points the stable ID of the network to a release, and
+// returns that ID. The key is generated the first time a network is published.
+func (s *Server) PublishPointer(ctx context.Context, name, release string) (string, error) {
+	if err := ValidateName(name); err != nil {
+		return "", err
+	}
+
+	key, err := s.pointerKey(ctx, name)
+	if err != nil {
+		return "", err
+	}
+
+	p, err := iface.ParsePath("/ipfs/" + release)
+	if err != nil {
+		return "", err
+	}
+	_, err = s.api.Name().Publish(ctx, p,
+		options.Name.Key(key.Name()),
+		options.Name.ValidTime(pointerValidity),
+	)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to publish network pointer")
+	}
+
+	return key.ID().Pretty(), nil
+}
+
+func (s *Server) pointerKey(ctx context.Context, name string) (iface.Key, error) {
+	keys, err := s.api.Key().List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, k := range keys {
+		if k.Name() == name {
+			return k, nil
+		}
+	}
+	return s.api.Key().Generate(ctx, name, options.Key.Type(options.Ed25519Key))
+}
+
+// ResolveRelease returns the release currently pointed to by a network ID.
+// Network IDs which are not pointers are returned as is.
+func (s *Server) ResolveRelease(ctx context.Context, networkID string) (string, error) {
+	if !IsPointer(networkID) {
+		return networkID, nil
+	}
+	p, err := s.api.Name().Resolve(ctx, networkID)
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to resolve network %s", networkID)
+	}
+	return strings.TrimPrefix(p.String(), "/ipfs/"), nil
+}
+
+// WatchReleases periodically resolves the network pointer and sends the
+// release ID on the returned channel whenever it differs from current.
+func (s *Server) WatchReleases(ctx context.Context, networkID, current string, interval time.Duration) <-chan string {
+	ch := make(chan string)
+	go func() {
+		defer close(ch)
+		if !IsPointer(networkID) {
+			return
+		}
+		for {
+			select {
+			case <-time.After(interval):
+			case <-ctx.Done():
+				return
+			}
+
+			release, err := s.ResolveRelease(ctx, networkID)
+			if err != nil || release == current {
+				continue
+			}
+			current = release
+			select {
+			case ch <- release:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch
+}
+
+// Membership records which network (and release) a node belongs to.
+type Membership struct {
+	NetworkID string `yaml:"network_id"`
+	Release   string `yaml:"release"`
+}
+
+// LoadMembership loads the membership file.
+func LoadMembership(path string) (*Membership, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, errors.New("this node hasn't joined or published any network")
+		}
+		return nil, err
+	}
+	m := &Membership{}
+	if err := yaml.Unmarshal(data, m); err != nil {
+		return nil, errors.Wrapf(err, "unable to parse %q", path)
+	}
+	return m, nil
+}
+
+// Save writes the membership file.
+func (m *Membership) Save(path string) error {
+	data, err := yaml.Marshal(m)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0644)
+}
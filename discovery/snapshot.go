@@ -0,0 +1,145 @@
+package discovery
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+
+	iface "github.com/ipsn/go-ipfs/core/coreapi/interface"
+	"github.com/ipsn/go-ipfs/gxlibs/github.com/ipfs/go-ipfs-files"
+	"github.com/pkg/errors"
+)
+
+// SnapshotInfo describes a snapshot of the chain data published by a node.
+type SnapshotInfo struct {
+	// ID is the content ID of the snapshot.
+	ID string `json:"id"`
+	// Height is the last block included in the snapshot.
+	Height int64 `json:"height"`
+	// AppHash is the hex encoded application hash at that height.
+	AppHash string `json:"app_hash"`
+	// Genesis is the digest of the genesis file of the chain.
+	Genesis string `json:"genesis"`
+}
+
+// PublishSnapshot publishes a snapshot archive and advertises it to the
+// other nodes of the network.
+func (s *Server) PublishSnapshot(ctx context.Context, archivePath string, info *SnapshotInfo) error {
+	sandbox, err := ioutil.TempDir(os.TempDir(), "chainkit-snapshot")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(sandbox)
+
+	if err := os.Link(archivePath, path.Join(sandbox, "snapshot.tgz")); err != nil {
+		return err
+	}
+	meta, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(path.Join(sandbox, "snapshot.json"), meta, 0644); err != nil {
+		return err
+	}
+
+	st, err := os.Stat(sandbox)
+	if err != nil {
+		return err
+	}
+	f, err := files.NewSerialFile("snapshot", sandbox, false, st)
+	if err != nil {
+		return err
+	}
+	p, err := s.api.Unixfs().Add(ctx, f)
+	if err != nil {
+		return errors.Wrap(err, "unable to publish snapshot")
+	}
+
+	published := *info
+	published.ID = p.Cid().String()
+
+	s.mu.Lock()
+	s.snapshot = &published
+	s.mu.Unlock()
+
+	return nil
+}
+
+// LatestSnapshot asks the nodes of the network for their snapshots and
+// returns the most recent one matching the genesis digest, or nil if none is
+// available. It also returns the other nodes found, which can confirm the
+// snapshot against the chain.
+func (s *Server) LatestSnapshot(ctx context.Context, networkID, genesis string) (*SnapshotInfo, []*PeerInfo, error) {
+	peers, err := s.Peers(ctx, networkID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		latest    *SnapshotInfo
+		publisher *PeerInfo
+		found     []*PeerInfo
+	)
+	for peer := range peers {
+		found = append(found, peer)
+		snapshot := peer.Snapshot
+		if snapshot == nil || snapshot.Genesis != genesis {
+			continue
+		}
+		if latest == nil || snapshot.Height > latest.Height {
+			latest, publisher = snapshot, peer
+		}
+	}
+
+	others := []*PeerInfo{}
+	for _, peer := range found {
+		if peer != publisher && (publisher == nil || peer.NodeID != publisher.NodeID) {
+			others = append(others, peer)
+		}
+	}
+	return latest, others, nil
+}
+
+// FetchSnapshot retrieves a snapshot archive.
+func (s *Server) FetchSnapshot(ctx context.Context, info *SnapshotInfo, w io.Writer) error {
+	metaPath, err := iface.ParsePath(path.Join("/ipfs", info.ID, "snapshot.json"))
+	if err != nil {
+		return err
+	}
+	metaFile, err := s.api.Unixfs().Get(ctx, metaPath)
+	if err != nil {
+		return err
+	}
+	meta := &SnapshotInfo{}
+	if err := json.NewDecoder(metaFile).Decode(meta); err != nil {
+		return errors.Wrap(err, "unable to read snapshot information")
+	}
+	if meta.Height != info.Height || meta.AppHash != info.AppHash || meta.Genesis != info.Genesis {
+		return errors.New("the snapshot doesn't match its announcement")
+	}
+
+	archivePath, err := iface.ParsePath(path.Join("/ipfs", info.ID, "snapshot.tgz"))
+	if err != nil {
+		return err
+	}
+	archive, err := s.api.Unixfs().Get(ctx, archivePath)
+	if err != nil {
+		return err
+	}
+	defer archive.Close()
+
+	if _, err := io.Copy(w, archive); err != nil {
+		return errors.Wrap(err, "unable to retrieve snapshot")
+	}
+	return nil
+}
+
+// announcedSnapshot returns the snapshot currently advertised by this node.
+func (s *Server) announcedSnapshot() *SnapshotInfo {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.snapshot
+}
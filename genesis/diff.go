@@ -0,0 +1,59 @@
+package genesis
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/sergi/go-diff/diffmatchpatch"
+)
+
+// Diff returns a line based diff between two documents, suitable for
+// non-interactive output. Removed lines are prefixed with "-" and added
+// lines with "+". It returns an empty string if the documents are identical.
+func Diff(oldDoc, newDoc []byte) string {
+	dmp := diffmatchpatch.New()
+	a, b, lines := dmp.DiffLinesToChars(string(oldDoc), string(newDoc))
+	diffs := dmp.DiffCharsToLines(dmp.DiffMain(a, b, false), lines)
+
+	var out bytes.Buffer
+	for _, d := range diffs {
+		var prefix string
+		switch d.Type {
+		case diffmatchpatch.DiffDelete:
+			prefix = "-"
+		case diffmatchpatch.DiffInsert:
+			prefix = "+"
+		default:
+			continue
+		}
+		for _, line := range strings.Split(strings.TrimSuffix(d.Text, "\n"), "\n") {
+			fmt.Fprintf(&out, "%s %s\n", prefix, line)
+		}
+	}
+	return out.String()
+}
+
+// FromYAML converts a value decoded from YAML into JSON.
+// YAML decodes objects as map[interface{}]interface{}, which can't be
+// marshalled as JSON.
+func FromYAML(v interface{}) ([]byte, error) {
+	return encode(jsonify(v))
+}
+
+func jsonify(v interface{}) interface{} {
+	switch node := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(node))
+		for k, v := range node {
+			m[fmt.Sprintf("%v", k)] = jsonify(v)
+		}
+		return m
+	case []interface{}:
+		for i, v := range node {
+			node[i] = jsonify(v)
+		}
+		return node
+	}
+	return v
+}
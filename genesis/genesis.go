@@ -0,0 +1,273 @@
+package genesis
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const denomPattern = `[a-z][a-z0-9]{2,15}`
+
+var (
+	coinRegexp    = regexp.MustCompile(`^([0-9]+)(` + denomPattern + `)$`)
+	denomRegexp   = regexp.MustCompile(`^` + denomPattern + `$`)
+	addressRegexp = regexp.MustCompile(`^[a-z]+1[02-9ac-hj-np-z]{38}$`)
+)
+
+// Coin is an amount of a given denomination, as found in the genesis file.
+type Coin struct {
+	Denom  string `json:"denom"`
+	Amount string `json:"amount"`
+}
+
+// ParseCoins parses a list of coins (e.g. "10mycoin,5stake").
+func ParseCoins(s string) ([]Coin, error) {
+	coins := []Coin{}
+	for _, c := range strings.Split(s, ",") {
+		m := coinRegexp.FindStringSubmatch(strings.TrimSpace(c))
+		if m == nil {
+			return nil, fmt.Errorf("invalid coin %q (expected e.g. \"10mycoin\")", c)
+		}
+		coins = append(coins, Coin{Denom: m[2], Amount: m[1]})
+	}
+	return coins, nil
+}
+
+// IsDenom returns true if s is a valid coin denomination (e.g. "mycoin").
+func IsDenom(s string) bool {
+	return denomRegexp.MatchString(s)
+}
+
+// IsAddress returns true if s looks like a bech32 account address.
+func IsAddress(s string) bool {
+	return addressRegexp.MatchString(s)
+}
+
+// AddAccount adds a funded account to the application state.
+func AddAccount(doc []byte, address string, coins []Coin) ([]byte, error) {
+	var g struct {
+		AppState struct {
+			Accounts []struct {
+				Address string `json:"address"`
+			} `json:"accounts"`
+		} `json:"app_state"`
+	}
+	if err := json.Unmarshal(doc, &g); err != nil {
+		return nil, errors.Wrap(err, "unable to parse genesis file")
+	}
+	for _, acc := range g.AppState.Accounts {
+		if acc.Address == address {
+			return nil, fmt.Errorf("account %s already exists", address)
+		}
+	}
+
+	account := map[string]interface{}{
+		"address":        address,
+		"coins":          coins,
+		"public_key":     nil,
+		"account_number": "0",
+		"sequence":       "0",
+	}
+
+	ops := []map[string]interface{}{}
+	if g.AppState.Accounts == nil {
+		ops = append(ops, map[string]interface{}{"op": "add", "path": "/app_state/accounts", "value": []interface{}{}})
+	}
+	ops = append(ops, map[string]interface{}{"op": "add", "path": "/app_state/accounts/-", "value": account})
+
+	patch, err := json.Marshal(ops)
+	if err != nil {
+		return nil, err
+	}
+	return JSONPatch(doc, patch)
+}
+
+// AddValidator adds a validator to the genesis validator set.
+// pubKey is the base64 encoded ed25519 public key of the validator.
+func AddValidator(doc []byte, pubKey string, power int64, name string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(pubKey)
+	if err != nil || len(key) != 32 {
+		return nil, fmt.Errorf("invalid validator public key %q: expected a base64 encoded ed25519 key", pubKey)
+	}
+	if power <= 0 {
+		return nil, fmt.Errorf("invalid voting power %d", power)
+	}
+
+	var g struct {
+		Validators []struct {
+			PubKey struct {
+				Value string `json:"value"`
+			} `json:"pub_key"`
+		} `json:"validators"`
+	}
+	if err := json.Unmarshal(doc, &g); err != nil {
+		return nil, errors.Wrap(err, "unable to parse genesis file")
+	}
+	for _, v := range g.Validators {
+		if v.PubKey.Value == pubKey {
+			return nil, fmt.Errorf("validator %s already exists", pubKey)
+		}
+	}
+
+	validator := map[string]interface{}{
+		"pub_key": map[string]string{
+			"type":  "tendermint/PubKeyEd25519",
+			"value": pubKey,
+		},
+		"power": strconv.FormatInt(power, 10),
+		"name":  name,
+	}
+
+	ops := []map[string]interface{}{}
+	if g.Validators == nil {
+		ops = append(ops, map[string]interface{}{"op": "add", "path": "/validators", "value": []interface{}{}})
+	}
+	ops = append(ops, map[string]interface{}{"op": "add", "path": "/validators/-", "value": validator})
+
+	patch, err := json.Marshal(ops)
+	if err != nil {
+		return nil, err
+	}
+	return JSONPatch(doc, patch)
+}
+
+// UsesGenTxs returns true if the validators of the application are created
+// by genesis transactions, listed in the gentxs of the application state.
+func UsesGenTxs(doc []byte) bool {
+	var g struct {
+		AppState struct {
+			GenTxs json.RawMessage `json:"gentxs"`
+		} `json:"app_state"`
+	}
+	if err := json.Unmarshal(doc, &g); err != nil {
+		return false
+	}
+	return g.AppState.GenTxs != nil
+}
+
+// AddGenTx adds a genesis transaction, creating a validator, to the
+// application state.
+func AddGenTx(doc, tx []byte) ([]byte, error) {
+	type createValidator struct {
+		ValidatorAddr string `json:"validator_address"`
+		PubKey        struct {
+			Value string `json:"value"`
+		} `json:"pubkey"`
+	}
+	parse := func(tx []byte) (*createValidator, error) {
+		var t struct {
+			Type  string `json:"type"`
+			Value struct {
+				Msg []struct {
+					Type  string          `json:"type"`
+					Value createValidator `json:"value"`
+				} `json:"msg"`
+			} `json:"value"`
+		}
+		if err := json.Unmarshal(tx, &t); err != nil || t.Type != "auth/StdTx" {
+			return nil, errors.New("not a transaction")
+		}
+		if len(t.Value.Msg) != 1 || t.Value.Msg[0].Type != "cosmos-sdk/MsgCreateValidator" {
+			return nil, errors.New("genesis transactions must have exactly one MsgCreateValidator message")
+		}
+		return &t.Value.Msg[0].Value, nil
+	}
+
+	msg, err := parse(tx)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid genesis transaction")
+	}
+
+	var g struct {
+		AppState struct {
+			GenTxs []json.RawMessage `json:"gentxs"`
+		} `json:"app_state"`
+	}
+	if err := json.Unmarshal(doc, &g); err != nil {
+		return nil, errors.Wrap(err, "unable to parse genesis file")
+	}
+	if !UsesGenTxs(doc) {
+		return nil, errors.New("the application doesn't create validators with genesis transactions")
+	}
+	for _, t := range g.AppState.GenTxs {
+		existing, err := parse(t)
+		if err != nil {
+			continue
+		}
+		if existing.ValidatorAddr == msg.ValidatorAddr || existing.PubKey.Value == msg.PubKey.Value {
+			return nil, fmt.Errorf("validator %s already has a genesis transaction", msg.ValidatorAddr)
+		}
+	}
+
+	ops := []map[string]interface{}{}
+	if g.AppState.GenTxs == nil {
+		ops = append(ops, map[string]interface{}{"op": "add", "path": "/app_state/gentxs", "value": []interface{}{}})
+	}
+	ops = append(ops, map[string]interface{}{"op": "add", "path": "/app_state/gentxs/-", "value": json.RawMessage(tx)})
+
+	patch, err := json.Marshal(ops)
+	if err != nil {
+		return nil, err
+	}
+	return JSONPatch(doc, patch)
+}
+
+// ChainID returns the chain ID of a genesis file.
+func ChainID(doc []byte) (string, error) {
+	var g struct {
+		ChainID string `json:"chain_id"`
+	}
+	if err := json.Unmarshal(doc, &g); err != nil {
+		return "", errors.Wrap(err, "unable to parse genesis file")
+	}
+	return g.ChainID, nil
+}
+
+// SetChainID replaces the chain ID of a genesis file.
+func SetChainID(doc []byte, chainID string) ([]byte, error) {
+	patch, err := json.Marshal([]map[string]interface{}{
+		{"op": "add", "path": "/chain_id", "value": chainID},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return JSONPatch(doc, patch)
+}
+
+// FromExport prepares a genesis file exported by the application for starting
+// a new chain. Applications which don't manage validators don't export any:
+// the validators of the current genesis file are kept in that case.
+func FromExport(current, exported []byte) ([]byte, error) {
+	var e struct {
+		Validators []json.RawMessage `json:"validators"`
+	}
+	if err := json.Unmarshal(exported, &e); err != nil {
+		return nil, errors.Wrap(err, "unable to parse exported genesis file")
+	}
+	if len(e.Validators) > 0 {
+		return Normalize(exported)
+	}
+
+	var c struct {
+		Validators json.RawMessage `json:"validators"`
+	}
+	if err := json.Unmarshal(current, &c); err != nil {
+		return nil, errors.Wrap(err, "unable to parse genesis file")
+	}
+	if isNull(c.Validators) {
+		return nil, errors.New("the exported genesis file has no validators")
+	}
+
+	patch, err := json.Marshal([]map[string]interface{}{
+		{"op": "add", "path": "/validators", "value": c.Validators},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return JSONPatch(exported, patch)
+}
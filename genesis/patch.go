@@ -0,0 +1,368 @@
+package genesis
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// operation is a single RFC 6902 JSON Patch operation.
+type operation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	From  string          `json:"from"`
+	Value json.RawMessage `json:"value"`
+}
+
+// Patch applies a patch to a JSON document.
+// The patch is either a RFC 6902 JSON Patch (an array of operations)
+// or a RFC 7386 JSON Merge Patch (an object).
+func Patch(doc, patch []byte) ([]byte, error) {
+	patch = bytes.TrimSpace(patch)
+	if len(patch) == 0 {
+		return nil, errors.New("empty patch")
+	}
+	switch patch[0] {
+	case '[':
+		return JSONPatch(doc, patch)
+	case '{':
+		return MergePatch(doc, patch)
+	}
+	return nil, errors.New("patch must be either a JSON Patch (array) or a JSON Merge Patch (object)")
+}
+
+// PatchFile is like Patch but reads the patch from a file.
+func PatchFile(doc []byte, patchPath string) ([]byte, error) {
+	patch, err := ioutil.ReadFile(patchPath)
+	if err != nil {
+		return nil, err
+	}
+	out, err := Patch(doc, patch)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to apply %q", patchPath)
+	}
+	return out, nil
+}
+
+// JSONPatch applies a RFC 6902 JSON Patch to a JSON document.
+func JSONPatch(doc, patch []byte) ([]byte, error) {
+	var ops []operation
+	if err := json.Unmarshal(patch, &ops); err != nil {
+		return nil, errors.Wrap(err, "invalid JSON patch")
+	}
+
+	v, err := decode(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, op := range ops {
+		v, err = op.apply(v)
+		if err != nil {
+			return nil, fmt.Errorf("operation %d (%s %q): %v", i, op.Op, op.Path, err)
+		}
+	}
+
+	return encode(v)
+}
+
+// MergePatch applies a RFC 7386 JSON Merge Patch to a JSON document.
+func MergePatch(doc, patch []byte) ([]byte, error) {
+	v, err := decode(doc)
+	if err != nil {
+		return nil, err
+	}
+	p, err := decode(patch)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid merge patch")
+	}
+	return encode(merge(v, p))
+}
+
+func merge(target, patch interface{}) interface{} {
+	p, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+	t, ok := target.(map[string]interface{})
+	if !ok {
+		t = make(map[string]interface{})
+	}
+	for k, v := range p {
+		if v == nil {
+			delete(t, k)
+			continue
+		}
+		t[k] = merge(t[k], v)
+	}
+	return t
+}
+
+func (op *operation) value() (interface{}, error) {
+	if op.Value == nil {
+		return nil, errors.New("missing value")
+	}
+	return decode(op.Value)
+}
+
+func (op *operation) apply(doc interface{}) (interface{}, error) {
+	path, err := parsePointer(op.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch op.Op {
+	case "add":
+		v, err := op.value()
+		if err != nil {
+			return nil, err
+		}
+		return add(doc, path, v)
+	case "remove":
+		return remove(doc, path)
+	case "replace":
+		v, err := op.value()
+		if err != nil {
+			return nil, err
+		}
+		return replace(doc, path, v)
+	case "move", "copy":
+		from, err := parsePointer(op.From)
+		if err != nil {
+			return nil, err
+		}
+		v, err := get(doc, from)
+		if err != nil {
+			return nil, err
+		}
+		if op.Op == "copy" {
+			// Deep copy the value so that further operations don't affect both.
+			if v, err = roundTrip(v); err != nil {
+				return nil, err
+			}
+			return add(doc, path, v)
+		}
+		if strings.HasPrefix(op.Path+"/", op.From+"/") && op.Path != op.From {
+			return nil, errors.New("cannot move a value into one of its children")
+		}
+		if doc, err = remove(doc, from); err != nil {
+			return nil, err
+		}
+		return add(doc, path, v)
+	case "test":
+		expected, err := op.value()
+		if err != nil {
+			return nil, err
+		}
+		actual, err := get(doc, path)
+		if err != nil {
+			return nil, err
+		}
+		if !reflect.DeepEqual(expected, actual) {
+			return nil, errors.New("test failed")
+		}
+		return doc, nil
+	}
+
+	return nil, fmt.Errorf("unknown operation %q", op.Op)
+}
+
+// parsePointer parses a RFC 6901 JSON Pointer.
+func parsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return []string{}, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("invalid pointer %q", pointer)
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, t := range tokens {
+		t = strings.Replace(t, "~1", "/", -1)
+		tokens[i] = strings.Replace(t, "~0", "~", -1)
+	}
+	return tokens, nil
+}
+
+func arrayIndex(a []interface{}, token string, allowEnd bool) (int, error) {
+	if allowEnd && token == "-" {
+		return len(a), nil
+	}
+	idx, err := strconv.Atoi(token)
+	last := len(a) - 1
+	if allowEnd {
+		last = len(a)
+	}
+	if err != nil || idx < 0 || idx > last {
+		return 0, fmt.Errorf("invalid array index %q", token)
+	}
+	return idx, nil
+}
+
+func child(doc interface{}, token string) (interface{}, error) {
+	switch node := doc.(type) {
+	case map[string]interface{}:
+		v, ok := node[token]
+		if !ok {
+			return nil, fmt.Errorf("key %q not found", token)
+		}
+		return v, nil
+	case []interface{}:
+		idx, err := arrayIndex(node, token, false)
+		if err != nil {
+			return nil, err
+		}
+		return node[idx], nil
+	}
+	return nil, fmt.Errorf("cannot traverse %q: not an object or array", token)
+}
+
+func get(doc interface{}, path []string) (interface{}, error) {
+	for _, token := range path {
+		var err error
+		if doc, err = child(doc, token); err != nil {
+			return nil, err
+		}
+	}
+	return doc, nil
+}
+
+// update walks down to the parent of the path's last element and calls fn
+// on it. Containers are written back on the way up since arrays may be
+// reallocated.
+func update(doc interface{}, path []string, fn func(parent interface{}, token string) (interface{}, error)) (interface{}, error) {
+	if len(path) == 1 {
+		return fn(doc, path[0])
+	}
+
+	c, err := child(doc, path[0])
+	if err != nil {
+		return nil, err
+	}
+	c, err = update(c, path[1:], fn)
+	if err != nil {
+		return nil, err
+	}
+
+	switch node := doc.(type) {
+	case map[string]interface{}:
+		node[path[0]] = c
+	case []interface{}:
+		idx, _ := arrayIndex(node, path[0], false)
+		node[idx] = c
+	}
+	return doc, nil
+}
+
+func add(doc interface{}, path []string, value interface{}) (interface{}, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	return update(doc, path, func(parent interface{}, token string) (interface{}, error) {
+		switch node := parent.(type) {
+		case map[string]interface{}:
+			node[token] = value
+			return node, nil
+		case []interface{}:
+			idx, err := arrayIndex(node, token, true)
+			if err != nil {
+				return nil, err
+			}
+			node = append(node, nil)
+			copy(node[idx+1:], node[idx:])
+			node[idx] = value
+			return node, nil
+		}
+		return nil, fmt.Errorf("cannot add %q: parent is not an object or array", token)
+	})
+}
+
+func remove(doc interface{}, path []string) (interface{}, error) {
+	if len(path) == 0 {
+		return nil, errors.New("cannot remove the document root")
+	}
+	return update(doc, path, func(parent interface{}, token string) (interface{}, error) {
+		switch node := parent.(type) {
+		case map[string]interface{}:
+			if _, ok := node[token]; !ok {
+				return nil, fmt.Errorf("key %q not found", token)
+			}
+			delete(node, token)
+			return node, nil
+		case []interface{}:
+			idx, err := arrayIndex(node, token, false)
+			if err != nil {
+				return nil, err
+			}
+			return append(node[:idx], node[idx+1:]...), nil
+		}
+		return nil, fmt.Errorf("cannot remove %q: parent is not an object or array", token)
+	})
+}
+
+func replace(doc interface{}, path []string, value interface{}) (interface{}, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	return update(doc, path, func(parent interface{}, token string) (interface{}, error) {
+		switch node := parent.(type) {
+		case map[string]interface{}:
+			if _, ok := node[token]; !ok {
+				return nil, fmt.Errorf("key %q not found", token)
+			}
+			node[token] = value
+			return node, nil
+		case []interface{}:
+			idx, err := arrayIndex(node, token, false)
+			if err != nil {
+				return nil, err
+			}
+			node[idx] = value
+			return node, nil
+		}
+		return nil, fmt.Errorf("cannot replace %q: parent is not an object or array", token)
+	})
+}
+
+// decode decodes JSON while preserving the precision of numbers.
+func decode(data []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// encode encodes JSON using the same indentation as Tendermint.
+func encode(v interface{}) ([]byte, error) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+func roundTrip(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return decode(data)
+}
+
+// Normalize re-encodes a JSON document so that it can be compared or
+// diffed against a patched version.
+func Normalize(doc []byte) ([]byte, error) {
+	v, err := decode(doc)
+	if err != nil {
+		return nil, err
+	}
+	return encode(v)
+}
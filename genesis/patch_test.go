@@ -0,0 +1,313 @@
+package genesis
+
+import (
+	"reflect"
+	"testing"
+)
+
+// jsonEqual fails the test if a and b aren't the same JSON document.
+func jsonEqual(t *testing.T, a, b []byte) {
+	t.Helper()
+	va, err := decode(a)
+	if err != nil {
+		t.Fatalf("invalid JSON %s: %v", a, err)
+	}
+	vb, err := decode(b)
+	if err != nil {
+		t.Fatalf("invalid JSON %s: %v", b, err)
+	}
+	if !reflect.DeepEqual(va, vb) {
+		t.Fatalf("got %s, expected %s", a, b)
+	}
+}
+
+func TestJSONPatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		doc      string
+		patch    string
+		expected string // empty if the patch must fail
+	}{
+		// RFC 6902, appendix A.
+		{
+			name:     "A.1 adding an object member",
+			doc:      `{"foo": "bar"}`,
+			patch:    `[{"op": "add", "path": "/baz", "value": "qux"}]`,
+			expected: `{"baz": "qux", "foo": "bar"}`,
+		},
+		{
+			name:     "A.2 adding an array element",
+			doc:      `{"foo": ["bar", "baz"]}`,
+			patch:    `[{"op": "add", "path": "/foo/1", "value": "qux"}]`,
+			expected: `{"foo": ["bar", "qux", "baz"]}`,
+		},
+		{
+			name:     "A.3 removing an object member",
+			doc:      `{"baz": "qux", "foo": "bar"}`,
+			patch:    `[{"op": "remove", "path": "/baz"}]`,
+			expected: `{"foo": "bar"}`,
+		},
+		{
+			name:     "A.4 removing an array element",
+			doc:      `{"foo": ["bar", "qux", "baz"]}`,
+			patch:    `[{"op": "remove", "path": "/foo/1"}]`,
+			expected: `{"foo": ["bar", "baz"]}`,
+		},
+		{
+			name:     "A.5 replacing a value",
+			doc:      `{"baz": "qux", "foo": "bar"}`,
+			patch:    `[{"op": "replace", "path": "/baz", "value": "boo"}]`,
+			expected: `{"baz": "boo", "foo": "bar"}`,
+		},
+		{
+			name:     "A.6 moving a value",
+			doc:      `{"foo": {"bar": "baz", "waldo": "fred"}, "qux": {"corge": "grault"}}`,
+			patch:    `[{"op": "move", "from": "/foo/waldo", "path": "/qux/thud"}]`,
+			expected: `{"foo": {"bar": "baz"}, "qux": {"corge": "grault", "thud": "fred"}}`,
+		},
+		{
+			name:     "A.7 moving an array element",
+			doc:      `{"foo": ["all", "grass", "cows", "eat"]}`,
+			patch:    `[{"op": "move", "from": "/foo/1", "path": "/foo/3"}]`,
+			expected: `{"foo": ["all", "cows", "eat", "grass"]}`,
+		},
+		{
+			name: "A.8 testing a value: success",
+			doc:  `{"baz": "qux", "foo": ["a", 2, "c"]}`,
+			patch: `[
+				{"op": "test", "path": "/baz", "value": "qux"},
+				{"op": "test", "path": "/foo/1", "value": 2}
+			]`,
+			expected: `{"baz": "qux", "foo": ["a", 2, "c"]}`,
+		},
+		{
+			name:  "A.9 testing a value: error",
+			doc:   `{"baz": "qux"}`,
+			patch: `[{"op": "test", "path": "/baz", "value": "bar"}]`,
+		},
+		{
+			name:     "A.10 adding a nested member object",
+			doc:      `{"foo": "bar"}`,
+			patch:    `[{"op": "add", "path": "/child", "value": {"grandchild": {}}}]`,
+			expected: `{"foo": "bar", "child": {"grandchild": {}}}`,
+		},
+		{
+			name:     "A.11 ignoring unrecognized elements",
+			doc:      `{"foo": "bar"}`,
+			patch:    `[{"op": "add", "path": "/baz", "value": "qux", "xyz": 123}]`,
+			expected: `{"foo": "bar", "baz": "qux"}`,
+		},
+		{
+			name:  "A.12 adding to a nonexistent target",
+			doc:   `{"foo": "bar"}`,
+			patch: `[{"op": "add", "path": "/baz/bat", "value": "qux"}]`,
+		},
+		{
+			name:     "A.14 ~ escape ordering",
+			doc:      `{"/": 9, "~1": 10}`,
+			patch:    `[{"op": "test", "path": "/~01", "value": 10}]`,
+			expected: `{"/": 9, "~1": 10}`,
+		},
+		{
+			name:  "A.15 comparing strings and numbers",
+			doc:   `{"/": 9, "~1": 10}`,
+			patch: `[{"op": "test", "path": "/~01", "value": "10"}]`,
+		},
+		{
+			name:     "A.16 adding an array value",
+			doc:      `{"foo": ["bar"]}`,
+			patch:    `[{"op": "add", "path": "/foo/-", "value": ["abc", "def"]}]`,
+			expected: `{"foo": ["bar", ["abc", "def"]]}`,
+		},
+
+		// Array indexes.
+		{
+			name:     "add at the end of an array by index",
+			doc:      `{"foo": ["bar"]}`,
+			patch:    `[{"op": "add", "path": "/foo/1", "value": "baz"}]`,
+			expected: `{"foo": ["bar", "baz"]}`,
+		},
+		{
+			name:  "add past the end of an array",
+			doc:   `{"foo": ["bar"]}`,
+			patch: `[{"op": "add", "path": "/foo/2", "value": "baz"}]`,
+		},
+		{
+			name:  "add at a negative index",
+			doc:   `{"foo": ["bar"]}`,
+			patch: `[{"op": "add", "path": "/foo/-1", "value": "baz"}]`,
+		},
+		{
+			name:  "remove the - index",
+			doc:   `{"foo": ["bar"]}`,
+			patch: `[{"op": "remove", "path": "/foo/-"}]`,
+		},
+		{
+			name:  "replace the - index",
+			doc:   `{"foo": ["bar"]}`,
+			patch: `[{"op": "replace", "path": "/foo/-", "value": "baz"}]`,
+		},
+		{
+			name:  "test the - index",
+			doc:   `{"foo": ["bar"]}`,
+			patch: `[{"op": "test", "path": "/foo/-", "value": "bar"}]`,
+		},
+		{
+			name:     "move to the end of an array",
+			doc:      `{"foo": ["a", "b", "c"]}`,
+			patch:    `[{"op": "move", "from": "/foo/0", "path": "/foo/-"}]`,
+			expected: `{"foo": ["b", "c", "a"]}`,
+		},
+		{
+			name:     "add in a nested array",
+			doc:      `{"foo": [{"bar": [1, 3]}]}`,
+			patch:    `[{"op": "add", "path": "/foo/0/bar/1", "value": 2}]`,
+			expected: `{"foo": [{"bar": [1, 2, 3]}]}`,
+		},
+
+		// Other operations and errors.
+		{
+			name:  "move into a child",
+			doc:   `{"foo": {"bar": {}}}`,
+			patch: `[{"op": "move", "from": "/foo", "path": "/foo/bar/baz"}]`,
+		},
+		{
+			name:     "move to a sibling sharing a prefix",
+			doc:      `{"foo": 1}`,
+			patch:    `[{"op": "move", "from": "/foo", "path": "/foobar"}]`,
+			expected: `{"foobar": 1}`,
+		},
+		{
+			name: "copy is deep",
+			doc:  `{"foo": {"bar": 1}}`,
+			patch: `[
+				{"op": "copy", "from": "/foo", "path": "/baz"},
+				{"op": "replace", "path": "/baz/bar", "value": 2}
+			]`,
+			expected: `{"foo": {"bar": 1}, "baz": {"bar": 2}}`,
+		},
+		{
+			name:  "remove a missing member",
+			doc:   `{"foo": 1}`,
+			patch: `[{"op": "remove", "path": "/bar"}]`,
+		},
+		{
+			name:  "replace a missing member",
+			doc:   `{"foo": 1}`,
+			patch: `[{"op": "replace", "path": "/bar", "value": 2}]`,
+		},
+		{
+			name:  "remove the root",
+			doc:   `{"foo": 1}`,
+			patch: `[{"op": "remove", "path": ""}]`,
+		},
+		{
+			name:     "replace the root",
+			doc:      `{"foo": 1}`,
+			patch:    `[{"op": "replace", "path": "", "value": [1]}]`,
+			expected: `[1]`,
+		},
+		{
+			name:  "test a missing member",
+			doc:   `{"foo": 1}`,
+			patch: `[{"op": "test", "path": "/bar", "value": 1}]`,
+		},
+		{
+			name:  "add without value",
+			doc:   `{"foo": 1}`,
+			patch: `[{"op": "add", "path": "/bar"}]`,
+		},
+		{
+			name:  "unknown operation",
+			doc:   `{"foo": 1}`,
+			patch: `[{"op": "increment", "path": "/foo"}]`,
+		},
+		{
+			name:  "invalid pointer",
+			doc:   `{"foo": 1}`,
+			patch: `[{"op": "remove", "path": "foo"}]`,
+		},
+		{
+			name:  "failed operations abort the patch",
+			doc:   `{"foo": 1}`,
+			patch: `[{"op": "add", "path": "/bar", "value": 2}, {"op": "test", "path": "/foo", "value": 2}]`,
+		},
+		{
+			name:     "numbers keep their precision",
+			doc:      `{"supply": 123456789012345678901234567890}`,
+			patch:    `[{"op": "copy", "from": "/supply", "path": "/total"}]`,
+			expected: `{"supply": 123456789012345678901234567890, "total": 123456789012345678901234567890}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := JSONPatch([]byte(tt.doc), []byte(tt.patch))
+			if tt.expected == "" {
+				if err == nil {
+					t.Fatalf("expected an error, got %s", out)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			jsonEqual(t, out, []byte(tt.expected))
+		})
+	}
+}
+
+func TestMergePatch(t *testing.T) {
+	// RFC 7386, appendix A.
+	tests := []struct {
+		doc      string
+		patch    string
+		expected string
+	}{
+		{`{"a": "b"}`, `{"a": "c"}`, `{"a": "c"}`},
+		{`{"a": "b"}`, `{"b": "c"}`, `{"a": "b", "b": "c"}`},
+		{`{"a": "b"}`, `{"a": null}`, `{}`},
+		{`{"a": "b", "b": "c"}`, `{"a": null}`, `{"b": "c"}`},
+		{`{"a": ["b"]}`, `{"a": "c"}`, `{"a": "c"}`},
+		{`{"a": "c"}`, `{"a": ["b"]}`, `{"a": ["b"]}`},
+		{`{"a": {"b": "c"}}`, `{"a": {"b": "d", "c": null}}`, `{"a": {"b": "d"}}`},
+		{`{"a": [{"b": "c"}]}`, `{"a": [1]}`, `{"a": [1]}`},
+		{`["a", "b"]`, `["c", "d"]`, `["c", "d"]`},
+		{`{"a": "b"}`, `["c"]`, `["c"]`},
+		{`{"a": "foo"}`, `null`, `null`},
+		{`{"a": "foo"}`, `"bar"`, `"bar"`},
+		{`{"e": null}`, `{"a": 1}`, `{"e": null, "a": 1}`},
+		{`[1, 2]`, `{"a": "b", "c": null}`, `{"a": "b"}`},
+		{`{}`, `{"a": {"bb": {"ccc": null}}}`, `{"a": {"bb": {}}}`},
+	}
+
+	for _, tt := range tests {
+		out, err := MergePatch([]byte(tt.doc), []byte(tt.patch))
+		if err != nil {
+			t.Fatalf("%s + %s: %v", tt.doc, tt.patch, err)
+		}
+		jsonEqual(t, out, []byte(tt.expected))
+	}
+}
+
+func TestPatch(t *testing.T) {
+	doc := []byte(`{"chain_id": "old", "app_state": {"accounts": []}}`)
+
+	out, err := Patch(doc, []byte(` [{"op": "replace", "path": "/chain_id", "value": "new"}]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	jsonEqual(t, out, []byte(`{"chain_id": "new", "app_state": {"accounts": []}}`))
+
+	out, err = Patch(doc, []byte("\n{\"app_state\": null}"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	jsonEqual(t, out, []byte(`{"chain_id": "old"}`))
+
+	for _, patch := range []string{"", "  ", `"chain_id"`, `42`} {
+		if _, err := Patch(doc, []byte(patch)); err == nil {
+			t.Errorf("expected an error for the patch %q", patch)
+		}
+	}
+}
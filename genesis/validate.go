@@ -0,0 +1,272 @@
+package genesis
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// pubKeySizes maps the supported validator key types to their size.
+var pubKeySizes = map[string]int{
+	"tendermint/PubKeyEd25519":   32,
+	"tendermint/PubKeySecp256k1": 33,
+}
+
+// Errors is the list of problems found in a genesis file.
+type Errors []error
+
+func (e Errors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, err := range e {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "\n")
+}
+
+func (e *Errors) add(field, msg string, args ...interface{}) {
+	*e = append(*e, fmt.Errorf("%s: %s", field, fmt.Sprintf(msg, args...)))
+}
+
+// Validate checks the genesis file can be loaded by Tendermint.
+// If the genesis file is invalid, the returned error is of type Errors and
+// describes every problem found.
+func Validate(doc []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(doc, &fields); err != nil {
+		if serr, ok := err.(*json.SyntaxError); ok {
+			// The offset is the one of the byte following the error.
+			line, col := position(doc, serr.Offset-1)
+			return Errors{fmt.Errorf("syntax error at line %d, column %d: %v", line, col, err)}
+		}
+		return Errors{fmt.Errorf("genesis must be a JSON object: %v", err)}
+	}
+
+	errs := Errors{}
+	validateChainID(&errs, fields["chain_id"])
+	validateGenesisTime(&errs, fields["genesis_time"])
+	validateConsensusParams(&errs, fields["consensus_params"])
+	validateValidators(&errs, fields["validators"])
+	validateAppState(&errs, fields["app_state"])
+	if len(errs) > 0 {
+		return errs
+	}
+
+	// Finally, make sure Tendermint agrees. The vendored version can't load
+	// the consensus params of Tendermint v0.26 and later, checked above.
+	if isLegacyConsensusParams(fields["consensus_params"]) {
+		if _, err := tmtypes.GenesisDocFromJSON(doc); err != nil {
+			return Errors{fmt.Errorf("rejected by tendermint: %v", err)}
+		}
+	}
+
+	return nil
+}
+
+// Hash returns a digest of the genesis file, used to detect changes.
+func Hash(doc []byte) string {
+	sum := sha256.Sum256(doc)
+	return hex.EncodeToString(sum[:])
+}
+
+// position converts an offset into a line and column.
+func position(doc []byte, offset int64) (int, int) {
+	if offset > int64(len(doc)) {
+		offset = int64(len(doc))
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	before := doc[:offset]
+	line := bytes.Count(before, []byte("\n")) + 1
+	col := len(before) - bytes.LastIndex(before, []byte("\n"))
+	return line, col
+}
+
+func isNull(raw json.RawMessage) bool {
+	return raw == nil || string(bytes.TrimSpace(raw)) == "null"
+}
+
+func validateChainID(errs *Errors, raw json.RawMessage) {
+	var chainID string
+	if isNull(raw) {
+		errs.add("chain_id", "missing")
+		return
+	}
+	if err := json.Unmarshal(raw, &chainID); err != nil {
+		errs.add("chain_id", "must be a string")
+		return
+	}
+	switch {
+	case chainID == "":
+		errs.add("chain_id", "must not be empty")
+	case len(chainID) > tmtypes.MaxChainIDLen:
+		errs.add("chain_id", "too long (%d characters, max: %d)", len(chainID), tmtypes.MaxChainIDLen)
+	}
+}
+
+func validateGenesisTime(errs *Errors, raw json.RawMessage) {
+	if isNull(raw) {
+		return
+	}
+	var t string
+	if err := json.Unmarshal(raw, &t); err != nil {
+		errs.add("genesis_time", "must be a string")
+		return
+	}
+	if _, err := time.Parse(time.RFC3339Nano, t); err != nil {
+		errs.add("genesis_time", "invalid time %q (expected RFC 3339)", t)
+	}
+}
+
+// parseInt parses an integer encoded either as a JSON number or as a string,
+// as done by amino for 64 bits integers.
+func parseInt(raw json.RawMessage) (int64, error) {
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		s = string(bytes.TrimSpace(raw))
+	}
+	return strconv.ParseInt(s, 10, 64)
+}
+
+// isLegacyConsensusParams returns true unless the consensus params have
+// the layout of Tendermint v0.26 and later.
+func isLegacyConsensusParams(raw json.RawMessage) bool {
+	var params map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &params); err != nil {
+		return true
+	}
+	_, ok := params["block_size"]
+	return !ok
+}
+
+func validateConsensusParams(errs *Errors, raw json.RawMessage) {
+	if isNull(raw) {
+		return
+	}
+	var params map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &params); err != nil {
+		errs.add("consensus_params", "must be an object")
+		return
+	}
+
+	// Tendermint v0.26 dropped the _params suffix of the sections.
+	section := func(name string) (string, map[string]json.RawMessage) {
+		key := name + "_params"
+		if _, ok := params[key]; !ok {
+			if _, ok := params[name]; ok {
+				key = name
+			}
+		}
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(params[key], &fields); params[key] != nil && err != nil {
+			errs.add("consensus_params."+key, "must be an object")
+		}
+		return "consensus_params." + key, fields
+	}
+	blockSizeKey, blockSize := section("block_size")
+	evidenceKey, evidence := section("evidence")
+
+	check := func(field string, raw json.RawMessage, valid func(int64) string) {
+		if raw == nil {
+			errs.add(field, "missing")
+			return
+		}
+		v, err := parseInt(raw)
+		if err != nil {
+			errs.add(field, "must be an integer")
+			return
+		}
+		if msg := valid(v); msg != "" {
+			errs.add(field, "%s (got %d)", msg, v)
+		}
+	}
+
+	check(blockSizeKey+".max_bytes", blockSize["max_bytes"], func(v int64) string {
+		switch {
+		case v <= 0:
+			return "must be greater than 0"
+		case v > tmtypes.MaxBlockSizeBytes:
+			return fmt.Sprintf("must not exceed %d", tmtypes.MaxBlockSizeBytes)
+		}
+		return ""
+	})
+	check(blockSizeKey+".max_gas", blockSize["max_gas"], func(v int64) string {
+		if v < -1 {
+			return "must be greater or equal to -1"
+		}
+		return ""
+	})
+	check(evidenceKey+".max_age", evidence["max_age"], func(v int64) string {
+		if v <= 0 {
+			return "must be greater than 0"
+		}
+		return ""
+	})
+}
+
+func validateValidators(errs *Errors, raw json.RawMessage) {
+	if isNull(raw) {
+		return
+	}
+	var validators []struct {
+		Address string          `json:"address"`
+		Power   json.RawMessage `json:"power"`
+		PubKey  *struct {
+			Type  string `json:"type"`
+			Value string `json:"value"`
+		} `json:"pub_key"`
+	}
+	if err := json.Unmarshal(raw, &validators); err != nil {
+		errs.add("validators", "must be a list of validators")
+		return
+	}
+
+	seen := map[string]int{}
+	for i, v := range validators {
+		field := fmt.Sprintf("validators[%d]", i)
+
+		if v.PubKey == nil {
+			errs.add(field+".pub_key", "missing")
+		} else if size, ok := pubKeySizes[v.PubKey.Type]; !ok {
+			errs.add(field+".pub_key.type", "unsupported key type %q", v.PubKey.Type)
+		} else if key, err := base64.StdEncoding.DecodeString(v.PubKey.Value); err != nil || len(key) != size {
+			errs.add(field+".pub_key.value", "must be a base64 encoded %d bytes key", size)
+		} else if j, ok := seen[v.PubKey.Value]; ok {
+			errs.add(field+".pub_key", "duplicate of validators[%d]", j)
+		} else {
+			seen[v.PubKey.Value] = i
+		}
+
+		if v.Power == nil {
+			errs.add(field+".power", "missing")
+		} else if power, err := parseInt(v.Power); err != nil {
+			errs.add(field+".power", "must be an integer")
+		} else if power <= 0 {
+			errs.add(field+".power", "must be greater than 0 (got %d)", power)
+		}
+
+		if v.Address != "" {
+			if addr, err := hex.DecodeString(v.Address); err != nil || len(addr) != 20 {
+				errs.add(field+".address", "must be a 20 bytes hex encoded address")
+			}
+		}
+	}
+}
+
+func validateAppState(errs *Errors, raw json.RawMessage) {
+	if isNull(raw) {
+		return
+	}
+	var state map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &state); err != nil {
+		errs.add("app_state", "must be a JSON object")
+	}
+}
@@ -0,0 +1,233 @@
+package genesis
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+const (
+	legacyParams = `{
+		"block_size_params": {"max_bytes": "22020096", "max_gas": "-1"},
+		"evidence_params": {"max_age": "100000"}
+	}`
+	params026 = `{
+		"block_size": {"max_bytes": "22020096", "max_gas": "-1"},
+		"evidence": {"max_age": "100000"},
+		"validator": {"pub_key_types": ["ed25519"]}
+	}`
+
+	key1 = "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA="
+	key2 = "AQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQE="
+)
+
+// validator returns a validator entry using an ed25519 key.
+func validator(key, power string) string {
+	return fmt.Sprintf(`{"pub_key": {"type": "tendermint/PubKeyEd25519", "value": %q}, "power": %s, "name": ""}`, key, power)
+}
+
+// genesisDoc returns a genesis file made of the given fields.
+func genesisDoc(chainID, params string, validators ...string) string {
+	return fmt.Sprintf(`{
+	"genesis_time": "2018-11-20T10:00:00.000000Z",
+	"chain_id": %s,
+	"consensus_params": %s,
+	"validators": [%s],
+	"app_hash": "",
+	"app_state": {"accounts": []}
+}`, chainID, params, strings.Join(validators, ", "))
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		doc      string
+		expected []string // empty if the genesis file is valid
+	}{
+		{
+			name: "legacy consensus params",
+			doc:  genesisDoc(`"test-chain"`, legacyParams, validator(key1, `"10"`)),
+		},
+		{
+			name: "v0.26 consensus params",
+			doc:  genesisDoc(`"test-chain"`, params026, validator(key1, `"10"`)),
+		},
+		{
+			name: "default consensus params",
+			doc:  genesisDoc(`"test-chain"`, "null", validator(key1, `"10"`), validator(key2, `"5"`)),
+		},
+		{
+			name:     "missing chain_id",
+			doc:      genesisDoc("null", legacyParams),
+			expected: []string{"chain_id: missing"},
+		},
+		{
+			name:     "empty chain_id",
+			doc:      genesisDoc(`""`, legacyParams),
+			expected: []string{"chain_id: must not be empty"},
+		},
+		{
+			name:     "overlong chain_id",
+			doc:      genesisDoc(fmt.Sprintf("%q", strings.Repeat("a", 51)), legacyParams),
+			expected: []string{"chain_id: too long (51 characters, max: 50)"},
+		},
+		{
+			name:     "chain_id is not a string",
+			doc:      genesisDoc("42", legacyParams),
+			expected: []string{"chain_id: must be a string"},
+		},
+		{
+			name: "invalid legacy consensus params",
+			doc: genesisDoc(`"test-chain"`, `{
+				"block_size_params": {"max_bytes": "0", "max_gas": "-2"},
+				"evidence_params": {}
+			}`),
+			expected: []string{
+				"consensus_params.block_size_params.max_bytes: must be greater than 0 (got 0)",
+				"consensus_params.block_size_params.max_gas: must be greater or equal to -1 (got -2)",
+				"consensus_params.evidence_params.max_age: missing",
+			},
+		},
+		{
+			name: "invalid v0.26 consensus params",
+			doc: genesisDoc(`"test-chain"`, `{
+				"block_size": {"max_bytes": "104857601", "max_gas": "-1"},
+				"evidence": {"max_age": "ten"}
+			}`),
+			expected: []string{
+				"consensus_params.block_size.max_bytes: must not exceed 104857600 (got 104857601)",
+				"consensus_params.evidence.max_age: must be an integer",
+			},
+		},
+		{
+			name:     "unsupported key type",
+			doc:      genesisDoc(`"test-chain"`, legacyParams, `{"pub_key": {"type": "tendermint/PubKeyRSA", "value": "AA=="}, "power": "10"}`),
+			expected: []string{`validators[0].pub_key.type: unsupported key type "tendermint/PubKeyRSA"`},
+		},
+		{
+			name:     "bad pubkey size",
+			doc:      genesisDoc(`"test-chain"`, legacyParams, validator("AAAA", `"10"`)),
+			expected: []string{"validators[0].pub_key.value: must be a base64 encoded 32 bytes key"},
+		},
+		{
+			name:     "bad pubkey encoding",
+			doc:      genesisDoc(`"test-chain"`, legacyParams, validator("not base64!", `"10"`)),
+			expected: []string{"validators[0].pub_key.value: must be a base64 encoded 32 bytes key"},
+		},
+		{
+			name:     "missing pubkey",
+			doc:      genesisDoc(`"test-chain"`, legacyParams, `{"power": "10"}`),
+			expected: []string{"validators[0].pub_key: missing"},
+		},
+		{
+			name:     "duplicate validator",
+			doc:      genesisDoc(`"test-chain"`, legacyParams, validator(key1, `"10"`), validator(key2, `"10"`), validator(key1, `"5"`)),
+			expected: []string{"validators[2].pub_key: duplicate of validators[0]"},
+		},
+		{
+			name:     "zero power",
+			doc:      genesisDoc(`"test-chain"`, legacyParams, validator(key1, `"0"`)),
+			expected: []string{"validators[0].power: must be greater than 0 (got 0)"},
+		},
+		{
+			name:     "negative power",
+			doc:      genesisDoc(`"test-chain"`, legacyParams, validator(key1, "-3")),
+			expected: []string{"validators[0].power: must be greater than 0 (got -3)"},
+		},
+		{
+			name:     "missing power",
+			doc:      genesisDoc(`"test-chain"`, legacyParams, `{"pub_key": {"type": "tendermint/PubKeyEd25519", "value": "`+key1+`"}}`),
+			expected: []string{"validators[0].power: missing"},
+		},
+		{
+			name: "every problem is reported",
+			doc:  genesisDoc(`""`, legacyParams, validator(key1, `"0"`), validator(key1, `"10"`)),
+			expected: []string{
+				"chain_id: must not be empty",
+				"validators[0].power: must be greater than 0 (got 0)",
+				"validators[1].pub_key: duplicate of validators[0]",
+			},
+		},
+		{
+			name:     "invalid genesis time",
+			doc:      strings.Replace(genesisDoc(`"test-chain"`, legacyParams), "2018-11-20T10:00:00.000000Z", "yesterday", 1),
+			expected: []string{`genesis_time: invalid time "yesterday" (expected RFC 3339)`},
+		},
+		{
+			name:     "app_state is not an object",
+			doc:      strings.Replace(genesisDoc(`"test-chain"`, legacyParams), `{"accounts": []}`, `[]`, 1),
+			expected: []string{"app_state: must be a JSON object"},
+		},
+		{
+			name:     "not an object",
+			doc:      `["chain_id"]`,
+			expected: []string{"genesis must be a JSON object"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate([]byte(tt.doc))
+			if len(tt.expected) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			errs, ok := err.(Errors)
+			if !ok {
+				t.Fatalf("expected Errors, got %#v", err)
+			}
+			if len(errs) != len(tt.expected) {
+				t.Fatalf("got %d errors, expected %d:\n%v", len(errs), len(tt.expected), errs)
+			}
+			for i, expected := range tt.expected {
+				if !strings.HasPrefix(errs[i].Error(), expected) {
+					t.Errorf("got %q, expected %q", errs[i], expected)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateSyntaxError(t *testing.T) {
+	tests := []struct {
+		doc    string
+		line   int
+		column int
+	}{
+		{`{"chain_id": }`, 1, 14},
+		{"{\n  \"chain_id\": \"test\",\n  \"validators\": [,]\n}", 3, 18},
+		{"{\n  \"chain_id\": \"test\"\n  \"validators\": []\n}", 3, 3},
+	}
+
+	for _, tt := range tests {
+		err := Validate([]byte(tt.doc))
+		expected := fmt.Sprintf("syntax error at line %d, column %d: ", tt.line, tt.column)
+		if err == nil || !strings.HasPrefix(err.Error(), expected) {
+			t.Errorf("%q: got %v, expected %q", tt.doc, err, expected)
+		}
+	}
+}
+
+func TestPosition(t *testing.T) {
+	doc := []byte("ab\ncd\n")
+	tests := []struct {
+		offset int64
+		line   int
+		column int
+	}{
+		{-1, 1, 1},
+		{0, 1, 1},
+		{1, 1, 2},
+		{3, 2, 1},
+		{5, 2, 3},
+		{100, 3, 1},
+	}
+	for _, tt := range tests {
+		line, col := position(doc, tt.offset)
+		if line != tt.line || col != tt.column {
+			t.Errorf("offset %d: got %d:%d, expected %d:%d", tt.offset, line, col, tt.line, tt.column)
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package hooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/blocklayerhq/chainkit/project"
+	"github.com/blocklayerhq/chainkit/ui"
+	"github.com/blocklayerhq/chainkit/util"
+	"github.com/pkg/errors"
+)
+
+// Event is a lifecycle event hooks can be attached to.
+type Event string
+
+const (
+	// PostScaffold is emitted once a new project has been scaffolded.
+	PostScaffold Event = "post-scaffold"
+	// PreBuild is emitted before the application image is built.
+	PreBuild Event = "pre-build"
+	// PostBuild is emitted after a successful build.
+	PostBuild Event = "post-build"
+	// PostInit is emitted after the chain is initialized, before the first block.
+	PostInit Event = "post-init"
+	// NodeReady is emitted once the node is up and running.
+	NodeReady Event = "node-ready"
+	// PeerDiscovered is emitted before dialing a newly discovered peer.
+	PeerDiscovered Event = "peer-discovered"
+)
+
+// Events lists all the supported lifecycle events.
+var Events = []Event{
+	PostScaffold,
+	PreBuild,
+	PostBuild,
+	PostInit,
+	NodeReady,
+	PeerDiscovered,
+}
+
+// Dispatcher runs the hooks declared in the project manifest.
+// A nil Dispatcher is valid and doesn't run anything.
+type Dispatcher struct {
+	rootDir string
+	project *project.Project
+}
+
+// New returns a Dispatcher for the project located in rootDir.
+func New(rootDir string, p *project.Project) *Dispatcher {
+	for event := range p.Hooks {
+		if !isKnown(Event(event)) {
+			ui.Error("Ignoring hooks for unknown event %q", event)
+		}
+	}
+	return &Dispatcher{
+		rootDir: rootDir,
+		project: p,
+	}
+}
+
+func isKnown(event Event) bool {
+	for _, e := range Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// Fire runs all hooks registered for the event, in order.
+// Event data is passed to hooks as CHAINKIT_* environment variables and
+// as a JSON document on stdin. If a hook fails, the remaining hooks are
+// skipped and an error is returned so the caller can abort the stage.
+func (d *Dispatcher) Fire(ctx context.Context, event Event, data map[string]string) error {
+	if d == nil {
+		return nil
+	}
+	hooks := d.project.Hooks[string(event)]
+	if len(hooks) == 0 {
+		return nil
+	}
+
+	if data == nil {
+		data = map[string]string{}
+	}
+	payload, err := json.Marshal(map[string]interface{}{
+		"event":   event,
+		"project": d.project.Name,
+		"root":    d.rootDir,
+		"data":    data,
+	})
+	if err != nil {
+		return err
+	}
+	env := d.env(event, data, payload)
+
+	for _, h := range hooks {
+		var err error
+		if h.Run != "" {
+			ui.Info("Running %s hook: %s", event, ui.Emphasize(h.Run))
+			err = d.runHost(ctx, h.Run, env, payload)
+		} else {
+			ui.Info("Running %s hook in container: %s", event, ui.Emphasize(h.Container))
+			err = d.runContainer(ctx, h.Container, env, payload)
+		}
+		if err != nil {
+			return errors.Wrapf(err, "%s hook failed", event)
+		}
+	}
+
+	return nil
+}
+
+// env returns the environment variables describing the event.
+func (d *Dispatcher) env(event Event, data map[string]string, payload []byte) []string {
+	env := []string{
+		"CHAINKIT_EVENT=" + string(event),
+		"CHAINKIT_PROJECT=" + d.project.Name,
+		"CHAINKIT_ROOT=" + d.rootDir,
+		"CHAINKIT_EVENT_DATA=" + string(payload),
+	}
+
+	keys := make([]string, 0, len(data))
+	for k := range data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		name := strings.ToUpper(strings.Replace(k, "-", "_", -1))
+		env = append(env, fmt.Sprintf("CHAINKIT_%s=%s", name, data[k]))
+	}
+
+	return env
+}
+
+func (d *Dispatcher) runHost(ctx context.Context, command string, env []string, payload []byte) error {
+	cmd := exec.Command("sh", "-c", command)
+	cmd.Dir = d.rootDir
+	cmd.Env = append(os.Environ(), env...)
+	cmd.Stdin = bytes.NewReader(payload)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return util.RunCmd(ctx, cmd)
+}
+
+func (d *Dispatcher) runContainer(ctx context.Context, command string, env []string, payload []byte) error {
+	args := []string{"run", "--rm", "-i"}
+	for _, e := range env {
+		args = append(args, "-e", e)
+	}
+
+	// Give access to the chain state if it has been initialized.
+	stateDir := path.Join(d.rootDir, "state")
+	if _, err := os.Stat(stateDir); err == nil {
+		args = append(args,
+			"-v", stateDir+":"+path.Join("/", "root", "."+d.project.Binaries.Daemon),
+			"-v", path.Join(stateDir, "cli")+":"+path.Join("/", "root", "."+d.project.Binaries.CLI),
+		)
+	}
+
+	args = append(args,
+		"-l", "chainkit.project="+d.project.Name,
+		d.project.Image+":latest",
+		"sh", "-c", command,
+	)
+
+	return util.RunWithFD(ctx, bytes.NewReader(payload), os.Stdout, os.Stderr, "docker", args...)
+}
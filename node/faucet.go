@@ -0,0 +1,234 @@
+package node
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/blocklayerhq/chainkit/config"
+	"github.com/blocklayerhq/chainkit/project"
+	"github.com/blocklayerhq/chainkit/ui"
+	"github.com/blocklayerhq/chainkit/util"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultFaucetAccount  = "faucet"
+	defaultFaucetAmount   = "10"
+	defaultFaucetInterval = time.Hour
+)
+
+var faucetPage = template.Must(template.New("faucet").Parse(`<!DOCTYPE html>
+<html>
+<head><title>{{ .Name }} faucet</title></head>
+<body>
+<h1>{{ .Name }} faucet</h1>
+<p>Get {{ .Amount }} on chain <code>{{ .ChainID }}</code>.</p>
+<form method="POST" action="/">
+<input name="address" size="50" placeholder="{{ .Prefix }}1...">
+<button type="submit">Send</button>
+</form>
+</body>
+</html>
+`))
+
+// faucet sends funds from an account funded in the genesis file.
+type faucet struct {
+	config   *config.Config
+	project  *project.Project
+	chainID  string
+	account  string
+	amount   string
+	interval time.Duration
+	password string
+
+	// now returns the current time, used for the rate limits.
+	now func() time.Time
+
+	// mu serializes transfers, which would otherwise conflict on the
+	// account sequence, and protects the rate limits.
+	mu   sync.Mutex
+	last map[string]time.Time
+}
+
+// startFaucet serves the faucet until the context is cancelled. It doesn't
+// do anything if no faucet is configured, or if this node doesn't have the
+// faucet key.
+func startFaucet(ctx context.Context, config *config.Config, p *project.Project, chainID string) error {
+	if p.Faucet == nil {
+		return nil
+	}
+
+	f := &faucet{
+		config:   config,
+		project:  p,
+		chainID:  chainID,
+		account:  p.Faucet.Account,
+		amount:   p.Coins(p.Faucet.Amount),
+		interval: defaultFaucetInterval,
+		password: os.Getenv("CHAINKIT_FAUCET_PASSWORD"),
+		now:      time.Now,
+		last:     make(map[string]time.Time),
+	}
+	if f.account == "" {
+		f.account = defaultFaucetAccount
+	}
+	if p.Faucet.Amount == "" {
+		f.amount = p.Coins(defaultFaucetAmount)
+	}
+	if p.Faucet.Interval != "" {
+		f.interval, _ = time.ParseDuration(p.Faucet.Interval)
+	}
+	if f.password == "" {
+		f.password = os.Getenv("CHAINKIT_KEY_PASSWORD")
+	}
+
+	if err := util.DockerRunCLIWithFD(ctx, config, p, nil, ioutil.Discard, ioutil.Discard, "keys", "show", f.account); err != nil {
+		ui.Info("Not serving the faucet: this node doesn't have the %q key", f.account)
+		return nil
+	}
+	if f.password == "" {
+		ui.Error("Not serving the faucet: set $CHAINKIT_FAUCET_PASSWORD to the password of the %q key", f.account)
+		return nil
+	}
+
+	srv := &http.Server{
+		Addr:    fmt.Sprintf(":%d", config.Ports.Faucet),
+		Handler: f,
+	}
+	go func() {
+		<-ctx.Done()
+		srv.Close()
+	}()
+
+	ui.Success("Faucet is live at %s", ui.Emphasize(fmt.Sprintf("http://localhost:%d/", config.Ports.Faucet)))
+	if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		return errors.Wrap(err, "failed to start the faucet")
+	}
+	return nil
+}
+
+func (f *faucet) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		faucetPage.Execute(w, map[string]string{
+			"Name":    f.project.Name,
+			"Amount":  f.amount,
+			"ChainID": f.chainID,
+			"Prefix":  f.project.AddressPrefix(),
+		})
+	case http.MethodPost:
+		f.serveFund(w, r)
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// serveFund accepts either a form or a JSON object with an address field.
+func (f *faucet) serveFund(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Address string `json:"address"`
+	}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			faucetReply(w, http.StatusBadRequest, "invalid request")
+			return
+		}
+	} else {
+		req.Address = r.FormValue("address")
+	}
+	address := strings.TrimSpace(req.Address)
+	if !f.project.IsAddress(address) {
+		faucetReply(w, http.StatusBadRequest, fmt.Sprintf("invalid address %q: expected a %s1... address", address, f.project.AddressPrefix()))
+		return
+	}
+
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	now := f.now()
+	keys := []string{"address:" + address, "ip:" + ip}
+	if wait := f.limited(now, keys); wait > 0 {
+		faucetReply(w, http.StatusTooManyRequests, fmt.Sprintf("too many requests, try again in %s", wait.Round(time.Second)))
+		return
+	}
+
+	if err := f.send(r.Context(), address); err != nil {
+		ui.Error("Faucet: failed to send %s to %s: %v", f.amount, address, err)
+		faucetReply(w, http.StatusInternalServerError, "unable to send funds")
+		return
+	}
+	for _, key := range keys {
+		f.last[key] = now
+	}
+
+	ui.Info("Faucet: sent %s to %s", f.amount, address)
+	faucetReply(w, http.StatusOK, fmt.Sprintf("sent %s to %s", f.amount, address))
+}
+
+// limited returns how long to wait before funds can be sent again to any of
+// keys, or 0 if they can be sent now. f.mu must be held.
+func (f *faucet) limited(now time.Time, keys []string) time.Duration {
+	f.pruneLimits(now)
+	wait := time.Duration(0)
+	for _, key := range keys {
+		if last, ok := f.last[key]; ok && f.interval-now.Sub(last) > wait {
+			wait = f.interval - now.Sub(last)
+		}
+	}
+	return wait
+}
+
+// pruneLimits forgets the requests older than the interval, which don't
+// limit anything anymore. f.mu must be held.
+func (f *faucet) pruneLimits(now time.Time) {
+	for key, last := range f.last {
+		if now.Sub(last) >= f.interval {
+			delete(f.last, key)
+		}
+	}
+}
+
+// send transfers the faucet amount through the application CLI of the
+// running daemon.
+func (f *faucet) send(ctx context.Context, address string) error {
+	containerID, err := util.DaemonContainer(ctx, f.config)
+	if err != nil {
+		return err
+	}
+
+	var out bytes.Buffer
+	err = util.DockerExecCLIWithFD(ctx, containerID, f.project, strings.NewReader(f.password+"\n"), &out, &out,
+		"tx", "send",
+		"--from", f.account,
+		"--to", address,
+		"--amount", f.amount,
+		"--chain-id", f.chainID,
+	)
+	if err != nil {
+		return errors.Wrap(err, strings.TrimSpace(out.String()))
+	}
+	return nil
+}
+
+func faucetReply(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{
+		"message": message,
+	})
+}
@@ -0,0 +1,105 @@
+package node
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/blocklayerhq/chainkit/project"
+)
+
+// testClock is a clock which only moves when told to.
+type testClock struct {
+	t time.Time
+}
+
+func (c *testClock) now() time.Time {
+	return c.t
+}
+
+func (c *testClock) advance(d time.Duration) {
+	c.t = c.t.Add(d)
+}
+
+func newTestFaucet(clock *testClock) *faucet {
+	return &faucet{
+		project:  project.New("test"),
+		interval: time.Hour,
+		now:      clock.now,
+		last:     make(map[string]time.Time),
+	}
+}
+
+func TestFaucetLimits(t *testing.T) {
+	clock := &testClock{t: time.Date(2018, 11, 20, 10, 0, 0, 0, time.UTC)}
+	f := newTestFaucet(clock)
+	alice := []string{"address:alice", "ip:10.0.0.1"}
+	bob := []string{"address:bob", "ip:10.0.0.2"}
+	bobSameIP := []string{"address:bob", "ip:10.0.0.1"}
+
+	steps := []struct {
+		name    string
+		advance time.Duration
+		keys    []string
+		wait    time.Duration // 0 if allowed
+	}{
+		{name: "first request", keys: alice},
+		{name: "same address and IP", advance: 10 * time.Minute, keys: alice, wait: 50 * time.Minute},
+		{name: "another address and IP", keys: bob},
+		{name: "another address from the same IP", advance: 20 * time.Minute, keys: bobSameIP, wait: 40 * time.Minute},
+		{name: "just before the interval", advance: 30*time.Minute - time.Second, keys: alice, wait: time.Second},
+		{name: "after the interval", advance: time.Second, keys: alice},
+		{name: "again after being allowed", advance: time.Minute, keys: alice, wait: 59 * time.Minute},
+	}
+
+	for _, s := range steps {
+		clock.advance(s.advance)
+		now := f.now()
+		wait := f.limited(now, s.keys)
+		if wait != s.wait {
+			t.Fatalf("%s: got a wait of %s, expected %s", s.name, wait, s.wait)
+		}
+		if wait == 0 {
+			// Funds are sent.
+			for _, key := range s.keys {
+				f.last[key] = now
+			}
+		}
+	}
+
+	// Expired requests are forgotten.
+	clock.advance(2 * time.Hour)
+	if wait := f.limited(f.now(), nil); wait != 0 || len(f.last) != 0 {
+		t.Fatalf("got a wait of %s and %d limits after they expired", wait, len(f.last))
+	}
+}
+
+func TestFaucetTooManyRequests(t *testing.T) {
+	clock := &testClock{t: time.Date(2018, 11, 20, 10, 0, 0, 0, time.UTC)}
+	f := newTestFaucet(clock)
+	address := "cosmos1qqqsyqcyq5rqwzqfpg9scrgwpugpzysnrk363e"
+	f.last["address:"+address] = clock.now()
+	clock.advance(15 * time.Minute)
+
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(url.Values{"address": {address}}.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	f.ServeHTTP(w, r)
+
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("got status %d, expected %d", w.Code, http.StatusTooManyRequests)
+	}
+	var reply struct {
+		Message string `json:"message"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&reply); err != nil {
+		t.Fatal(err)
+	}
+	if expected := "too many requests, try again in 45m0s"; reply.Message != expected {
+		t.Fatalf("got %q, expected %q", reply.Message, expected)
+	}
+}
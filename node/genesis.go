@@ -1,15 +1,22 @@
 package node
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
+	"path"
+	"strings"
 
+	"github.com/blocklayerhq/chainkit/config"
+	"github.com/blocklayerhq/chainkit/genesis"
+	"github.com/blocklayerhq/chainkit/project"
 	"github.com/blocklayerhq/chainkit/ui"
 	"github.com/blocklayerhq/chainkit/util"
 	"github.com/manifoldco/promptui"
+	"github.com/pkg/errors"
 	"github.com/sergi/go-diff/diffmatchpatch"
 )
 
@@ -85,3 +92,247 @@ func spawnGenesisEditor(ctx context.Context, genesisPath string) error {
 	}
 	return nil
 }
+
+// patchGenesis applies the patches declared in the manifest, followed by
+// the patch files given on the command line, to the genesis file.
+func patchGenesis(config *config.Config, p *project.Project, files []string) error {
+	type patch struct {
+		name string
+		data []byte
+	}
+	patches := []patch{}
+
+	if p.Genesis != nil {
+		for i, v := range p.Genesis.Patches {
+			if file, ok := v.(string); ok {
+				// Patch files are relative to the project.
+				filePath := file
+				if !path.IsAbs(filePath) {
+					filePath = path.Join(config.RootDir, file)
+				}
+				data, err := ioutil.ReadFile(filePath)
+				if err != nil {
+					return err
+				}
+				patches = append(patches, patch{name: file, data: data})
+				continue
+			}
+			data, err := genesis.FromYAML(v)
+			if err != nil {
+				return err
+			}
+			patches = append(patches, patch{name: fmt.Sprintf("genesis.patches[%d]", i), data: data})
+		}
+	}
+
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		patches = append(patches, patch{name: file, data: data})
+	}
+
+	if len(patches) == 0 {
+		return nil
+	}
+
+	original, err := ioutil.ReadFile(config.GenesisPath())
+	if err != nil {
+		return err
+	}
+	doc, err := genesis.Normalize(original)
+	if err != nil {
+		return errors.Wrap(err, "unable to parse genesis file")
+	}
+	before := doc
+
+	for _, patch := range patches {
+		ui.Info("Applying genesis patch %s", ui.Emphasize(patch.name))
+		doc, err = genesis.Patch(doc, patch.data)
+		if err != nil {
+			return errors.Wrapf(err, "unable to apply %s", patch.name)
+		}
+	}
+
+	diff := genesis.Diff(before, doc)
+	if diff == "" {
+		ui.Info("Genesis patches didn't change the genesis file")
+		return nil
+	}
+	fmt.Print(diff)
+
+	return ioutil.WriteFile(config.GenesisPath(), doc, 0644)
+}
+
+// AddGenesisAccount adds a funded account to the genesis file.
+// The application daemon is used if it provides an `add-genesis-account`
+// command, otherwise the genesis file is edited directly.
+func AddGenesisAccount(ctx context.Context, config *config.Config, p *project.Project, address, coins string) error {
+	parsed, err := genesis.ParseCoins(coins)
+	if err != nil {
+		return err
+	}
+	if err := prepareGenesisEdit(ctx, config, p); err != nil {
+		return err
+	}
+
+	if daemonSupports(ctx, p, "add-genesis-account") {
+		if err := util.DockerRun(ctx, config, p, "add-genesis-account", address, coins); err != nil {
+			return errors.Wrap(err, "unable to add genesis account")
+		}
+		return fixFsPermissions(ctx, config, p)
+	}
+
+	return updateGenesis(config, func(doc []byte) ([]byte, error) {
+		return genesis.AddAccount(doc, address, parsed)
+	})
+}
+
+// AddGenesisValidator adds a validator to the genesis file.
+func AddGenesisValidator(ctx context.Context, config *config.Config, p *project.Project, pubKey string, power int64, name string) error {
+	if err := prepareGenesisEdit(ctx, config, p); err != nil {
+		return err
+	}
+	return updateGenesis(config, func(doc []byte) ([]byte, error) {
+		if genesis.UsesGenTxs(doc) {
+			return nil, errors.New("the validators of the application are created by genesis transactions (see `chainkit genesis gentx`)")
+		}
+		return genesis.AddValidator(doc, pubKey, power, name)
+	})
+}
+
+// GenTx signs a genesis transaction making the node a validator, with a
+// self delegation of amount (the default of the application if empty) from
+// the account of key. It returns the transaction, also written to the gentx
+// directory of the node.
+func GenTx(ctx context.Context, config *config.Config, p *project.Project, key, password, amount string) ([]byte, error) {
+	if err := prepareGenesisEdit(ctx, config, p); err != nil {
+		return nil, err
+	}
+	if !daemonSupports(ctx, p, "gentx") {
+		return nil, errors.New("the application doesn't create validators with genesis transactions (see `chainkit genesis add-validator`)")
+	}
+
+	args := []string{"gentx", "--name", key}
+	if amount != "" {
+		args = append(args, "--amount", amount)
+	}
+	var out bytes.Buffer
+	if err := util.DockerRunWithInput(ctx, config, p, strings.NewReader(password+"\n"), &out, os.Stderr, args...); err != nil {
+		return nil, errors.Wrap(err, "unable to sign the genesis transaction")
+	}
+	if err := fixFsPermissions(ctx, config, p); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+// AddGenTx adds a genesis transaction to the genesis file.
+func AddGenTx(ctx context.Context, config *config.Config, p *project.Project, tx []byte) error {
+	if err := prepareGenesisEdit(ctx, config, p); err != nil {
+		return err
+	}
+	return updateGenesis(config, func(doc []byte) ([]byte, error) {
+		return genesis.AddGenTx(doc, tx)
+	})
+}
+
+// prepareGenesisEdit makes sure the genesis file exists and can still be changed.
+func prepareGenesisEdit(ctx context.Context, config *config.Config, p *project.Project) error {
+	if _, err := os.Stat(config.BlockStorePath()); err == nil {
+		return errors.New("the genesis file cannot be changed: the chain has already produced blocks (if you need to reset: rm -rf ./state)")
+	}
+	return Initialize(ctx, config, p)
+}
+
+// updateGenesis applies fn to the genesis file and prints the resulting diff.
+func updateGenesis(config *config.Config, fn func([]byte) ([]byte, error)) error {
+	original, err := ioutil.ReadFile(config.GenesisPath())
+	if err != nil {
+		return err
+	}
+	before, err := genesis.Normalize(original)
+	if err != nil {
+		return errors.Wrap(err, "unable to parse genesis file")
+	}
+	after, err := fn(before)
+	if err != nil {
+		return err
+	}
+	fmt.Print(genesis.Diff(before, after))
+	return ioutil.WriteFile(config.GenesisPath(), after, 0644)
+}
+
+// daemonSupports checks whether the application daemon provides a command.
+func daemonSupports(ctx context.Context, p *project.Project, command string) bool {
+	return strings.Contains(daemonHelp(ctx, p), "  "+command+" ")
+}
+
+// daemonSupportsFlag checks whether a command of the application daemon
+// has a flag.
+func daemonSupportsFlag(ctx context.Context, p *project.Project, command, flag string) bool {
+	return strings.Contains(daemonHelp(ctx, p, command), " --"+flag+" ")
+}
+
+// daemonHelp returns the help of the application daemon, or of one of its
+// commands. It is empty if the daemon fails. It runs in a one-off container
+// so that the node can be running.
+func daemonHelp(ctx context.Context, p *project.Project, command ...string) string {
+	var help bytes.Buffer
+	args := append(command, "--help")
+	if err := util.DockerRunDaemonOneOff(ctx, p, &help, ioutil.Discard, args...); err != nil {
+		return ""
+	}
+	return help.String()
+}
+
+// verifyGenesis validates the genesis file and makes sure it wasn't changed
+// since the chain started producing blocks.
+func verifyGenesis(config *config.Config) error {
+	doc, err := ioutil.ReadFile(config.GenesisPath())
+	if err != nil {
+		return err
+	}
+	if err := genesis.Validate(doc); err != nil {
+		return errors.Wrap(err, "invalid genesis file")
+	}
+
+	hash := genesis.Hash(doc)
+	if _, err := os.Stat(config.BlockStorePath()); err == nil {
+		recorded, err := ioutil.ReadFile(config.GenesisHashPath())
+		if err == nil && strings.TrimSpace(string(recorded)) != hash {
+			return errors.New("the genesis file was changed after the chain started (if you need to reset: rm -rf ./state)")
+		}
+		if err == nil || !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	// The chain hasn't produced any block yet (or was started by an older
+	// version of chainkit): record the genesis it is being started with.
+	return ioutil.WriteFile(config.GenesisHashPath(), []byte(hash+"\n"), 0644)
+}
+
+// setChainID replaces the random chain ID generated by the daemon with the
+// project's network name.
+func setChainID(config *config.Config, p *project.Project) error {
+	doc, err := ioutil.ReadFile(config.GenesisPath())
+	if err != nil {
+		return err
+	}
+	doc, err = genesis.SetChainID(doc, p.NetworkName())
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(config.GenesisPath(), doc, 0644)
+}
+
+// genesisChainID returns the chain ID of the genesis file.
+func genesisChainID(config *config.Config) (string, error) {
+	doc, err := ioutil.ReadFile(config.GenesisPath())
+	if err != nil {
+		return "", err
+	}
+	return genesis.ChainID(doc)
+}
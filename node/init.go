@@ -13,20 +13,30 @@ import (
 	"strings"
 
 	"github.com/blocklayerhq/chainkit/config"
+	"github.com/blocklayerhq/chainkit/hooks"
 	"github.com/blocklayerhq/chainkit/project"
 	"github.com/blocklayerhq/chainkit/ui"
 	"github.com/blocklayerhq/chainkit/util"
 	"github.com/pkg/errors"
 )
 
-func initialize(ctx context.Context, config *config.Config, p *project.Project, editGenesis bool) error {
+// Initialize initializes the chain without starting the node.
+// It doesn't do anything if the chain is already initialized.
+func Initialize(ctx context.Context, config *config.Config, p *project.Project) error {
+	return initialize(ctx, config, p, hooks.New(config.RootDir, p), StartOpts{})
+}
+
+func initialize(ctx context.Context, config *config.Config, p *project.Project, h *hooks.Dispatcher, opts StartOpts) error {
 	_, err := os.Stat(config.GenesisPath())
 
 	// Skip initialization if already initialized.
 	if err == nil {
-		if editGenesis == true {
+		if opts.EditGenesis == true {
 			return errors.New("cannot use the option \"--edit-genesis\": the chain is already initialized")
 		}
+		if len(opts.GenesisPatches) > 0 {
+			return errors.New("cannot use the option \"--genesis-patch\": the chain is already initialized")
+		}
 		return nil
 	}
 
@@ -36,14 +46,20 @@ func initialize(ctx context.Context, config *config.Config, p *project.Project,
 	}
 
 	ui.Info("Generating configuration and genesis files")
-	if err := util.DockerRun(ctx, config, p, "init"); err != nil {
+	args := []string{"init"}
+	// Genesis transactions are signed for the chain ID: it can't be changed
+	// afterwards.
+	if daemonSupports(ctx, p, "gentx") {
+		args = append(args, "--chain-id", p.NetworkName())
+	}
+	if err := util.DockerRun(ctx, config, p, args...); err != nil {
 		//NOTE: some cosmos app (e.g. Gaia) take a --moniker option in the init command
 		// if the normal init fail, rerun with `--moniker $(hostname)`
 		hostname, err := os.Hostname()
 		if err != nil {
 			return err
 		}
-		if err := util.DockerRun(ctx, config, p, "init", "--moniker", hostname); err != nil {
+		if err := util.DockerRun(ctx, config, p, append(args, "--moniker", hostname)...); err != nil {
 			return err
 		}
 	}
@@ -52,13 +68,29 @@ func initialize(ctx context.Context, config *config.Config, p *project.Project,
 		return err
 	}
 
-	if editGenesis == true {
+	if err := setChainID(config, p); err != nil {
+		return errors.Wrap(err, "Cannot set the chain ID")
+	}
+
+	if err := patchGenesis(config, p, opts.GenesisPatches); err != nil {
+		return errors.Wrap(err, "Cannot patch the genesis file")
+	}
+
+	if opts.EditGenesis == true {
 		ui.Info("Spawning text editor to change the genesis file before the chain starts")
 		if err := spawnGenesisEditor(ctx, config.GenesisPath()); err != nil {
 			return errors.Wrap(err, "Cannot edit the genesis file")
 		}
 	}
 
+	err = h.Fire(ctx, hooks.PostInit, map[string]string{
+		"state_dir":    config.StateDir(),
+		"genesis_path": config.GenesisPath(),
+	})
+	if err != nil {
+		return err
+	}
+
 	if err := ui.Tree(config.StateDir(), []string{"ipfs"}); err != nil {
 		return errors.Wrap(err, "Cannot print source tree")
 	}
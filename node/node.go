@@ -5,10 +5,14 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/blocklayerhq/chainkit/config"
 	"github.com/blocklayerhq/chainkit/discovery"
+	"github.com/blocklayerhq/chainkit/hooks"
 	"github.com/blocklayerhq/chainkit/project"
 	"github.com/blocklayerhq/chainkit/ui"
 	"github.com/blocklayerhq/chainkit/util"
@@ -26,9 +30,15 @@ type Node struct {
 
 	server    *server
 	discovery *discovery.Server
+	hooks     *hooks.Dispatcher
+
+	// maintenanceMu serializes operations stopping and restarting the server.
+	maintenanceMu sync.Mutex
 }
 
-// New creates a new Node
+// New creates a new Node. discovery may be nil, in which case the node
+// doesn't take part in a network: it neither publishes, announces itself nor
+// discovers peers.
 func New(config *config.Config, discovery *discovery.Server) *Node {
 	return &Node{
 		config:    config,
@@ -37,6 +47,35 @@ func New(config *config.Config, discovery *discovery.Server) *Node {
 	}
 }
 
+// StartOpts contains a list of start options.
+type StartOpts struct {
+	// Genesis overrides the genesis file (e.g. when joining a network).
+	Genesis []byte
+	// Release is the release of the network being joined.
+	Release string
+	// EditGenesis spawns an editor to change the genesis file.
+	EditGenesis bool
+	// GenesisPatches is a list of JSON Patch or Merge Patch files to apply
+	// to the genesis file, after the patches declared in the manifest.
+	GenesisPatches []string
+	// FromExport is a genesis file exported from another chain, used to start
+	// a new chain from its state.
+	FromExport []byte
+	// Snapshot is a verified snapshot archive of the chain data to restore.
+	Snapshot string
+	// SnapshotInterval is how often the node publishes a snapshot of the
+	// chain data. Zero disables snapshots.
+	SnapshotInterval time.Duration
+	// Peers are dialed once the node is started, in addition to the peers
+	// found through discovery.
+	Peers []*discovery.PeerInfo
+	// NoExplorer disables the explorer.
+	NoExplorer bool
+	// Watch rebuilds the image and restarts the daemon whenever the Go
+	// sources of the project change.
+	Watch bool
+}
+
 // Stop stops the node and returns once fully stopped.
 func (n *Node) Stop() {
 	n.cancelCtx()
@@ -45,33 +84,67 @@ func (n *Node) Stop() {
 
 // Start starts the node. It will not return until it finishes
 // starting.
-func (n *Node) Start(ctx context.Context, p *project.Project, genesis []byte, editGenesis bool) error {
+func (n *Node) Start(ctx context.Context, p *project.Project, opts StartOpts) error {
 	n.parentCtx, n.cancelCtx = context.WithCancel(ctx)
 
 	n.doneCh = make(chan struct{})
 	defer close(n.doneCh)
 
-	if err := n.init(ctx, p, genesis, editGenesis); err != nil {
+	n.hooks = hooks.New(n.config.RootDir, p)
+
+	if err := n.init(ctx, p, opts); err != nil {
+		return err
+	}
+
+	// Never start or publish a broken genesis.
+	if err := verifyGenesis(n.config); err != nil {
+		return err
+	}
+
+	networkID := n.config.ChainID
+	release := opts.Release
+
+	// The chain ID from the genesis is also the name of the network.
+	chainID, err := genesisChainID(n.config)
+	if err != nil {
 		return err
 	}
 
-	chainID := n.config.ChainID
+	if n.config.PublishNetwork && n.discovery == nil {
+		return errors.New("cannot publish a network without discovery")
+	}
 
 	// Create a network.
 	if n.config.PublishNetwork {
 		ui.Info("Publishing network...")
-		var err error
-		chainID, err = n.createNetwork(n.parentCtx, p)
+		release, err = n.createNetwork(n.parentCtx, p)
 		if err != nil {
 			return err
 		}
-		ui.Success("Success! Published network %s as %s\n\nOther nodes can now join this network by running:\n  %s\n",
+		networkID, err = n.discovery.PublishPointer(n.parentCtx, chainID, release)
+		if err != nil {
+			ui.Error("Unable to publish a stable network ID, future releases will use a different ID: %v", err)
+			networkID = release
+		}
+		if err := n.discovery.Names.Add(chainID, networkID); err != nil {
+			ui.Error("Unable to register network name %q: %v", chainID, err)
+		}
+		ui.Success("Success! Published network %s as %s (%s)\n\nOther nodes can now join this network by running:\n  %s\n",
 			ui.Emphasize(p.Name),
 			ui.Emphasize(chainID),
+			networkID,
 			ui.Emphasize(fmt.Sprintf("chainkit join %s", chainID)),
 		)
 	}
 
+	membership := &discovery.Membership{
+		NetworkID: networkID,
+		Release:   release,
+	}
+	if err := membership.Save(n.config.MembershipPath()); err != nil {
+		return errors.Wrap(err, "unable to save network membership")
+	}
+
 	ui.Info("Starting node...")
 	if err := n.server.start(n.parentCtx, p); err != nil {
 		return err
@@ -81,12 +154,36 @@ func (n *Node) Start(ctx context.Context, p *project.Project, genesis []byte, ed
 	if err != nil {
 		return err
 	}
+	peer.NetworkID = networkID
+	peer.ChainID = chainID
+
+	err = n.hooks.Fire(n.parentCtx, hooks.NodeReady, map[string]string{
+		"chain_id":   chainID,
+		"network_id": networkID,
+		"node_id":    peer.NodeID,
+		"rpc_port":   strconv.Itoa(n.config.Ports.TendermintRPC),
+		"p2p_port":   strconv.Itoa(n.config.Ports.TendermintP2P),
+	})
+	if err != nil {
+		// Bring the server down before aborting.
+		n.cancelCtx()
+		n.server.wait()
+		return err
+	}
 
 	ui.Success("Success! The node is now up and running.")
 	ui.Success("  Node ID                   : %s", ui.Emphasize(peer.NodeID))
 	ui.Success("  Logs can be found in      : %s", ui.Emphasize(n.config.LogFile()))
 	ui.Success("  Application is live at    : %s", ui.Emphasize(fmt.Sprintf("http://localhost:%d/", n.config.Ports.TendermintRPC)))
-	ui.Success("  Cosmos Explorer is live at: %s", ui.Emphasize(fmt.Sprintf("http://localhost:%d/?rpc_port=%d", n.config.Ports.Explorer, n.config.Ports.TendermintRPC)))
+	if !opts.NoExplorer {
+		ui.Success("  Cosmos Explorer is live at: %s", ui.Emphasize(fmt.Sprintf("http://localhost:%d/?rpc_port=%d", n.config.Ports.Explorer, n.config.Ports.TendermintRPC)))
+	}
+
+	for _, peer := range opts.Peers {
+		if err := n.server.dialSeeds(n.parentCtx, peer); err != nil {
+			ui.Error("Failed to dial peer %s: %v", peer.NodeID, err)
+		}
+	}
 
 	g, gctx := errgroup.WithContext(n.parentCtx)
 
@@ -96,32 +193,63 @@ func (n *Node) Start(ctx context.Context, p *project.Project, genesis []byte, ed
 	})
 
 	// Start the explorer.
-	g.Go(func() error {
-		return startExplorer(gctx, n.config, p)
-	})
+	if !opts.NoExplorer {
+		g.Go(func() error {
+			return startExplorer(gctx, n.config, p)
+		})
+	}
 
-	// Announce
+	// Start the faucet.
 	g.Go(func() error {
-		return n.announce(gctx, chainID, peer)
+		return startFaucet(gctx, n.config, p, chainID)
 	})
 
-	// Discover Peers
+	// Apply scheduled upgrades
 	g.Go(func() error {
-		return n.discoverPeers(gctx, chainID)
+		return n.watchUpgrades(gctx, p)
 	})
 
+	// Rebuild on source changes
+	if opts.Watch {
+		g.Go(func() error {
+			return n.watchSources(gctx, p)
+		})
+	}
+
+	if n.discovery != nil {
+		// Announce
+		g.Go(func() error {
+			return n.announce(gctx, networkID, peer)
+		})
+
+		// Discover Peers
+		g.Go(func() error {
+			return n.discoverPeers(gctx, chainID, networkID)
+		})
+
+		// Watch for new releases
+		g.Go(func() error {
+			return n.watchReleases(gctx, networkID, release)
+		})
+
+		// Publish snapshots
+		g.Go(func() error {
+			return n.snapshots(gctx, p, opts.SnapshotInterval)
+		})
+	}
+
 	return g.Wait()
 }
 
 // init initializes the server if needed and updates the runtime config.
-func (n *Node) init(ctx context.Context, p *project.Project, genesis []byte, editGenesis bool) error {
+func (n *Node) init(ctx context.Context, p *project.Project, opts StartOpts) error {
 	moniker, err := os.Hostname()
 	if err != nil {
 		return errors.Wrap(err, "unable to determine hostname")
 	}
 
 	// Initialize if needed.
-	if err := initialize(ctx, n.config, p, editGenesis); err != nil {
+	if err := initialize(ctx, n.config, p, n.hooks, opts); err != nil {
 		return errors.Wrap(err, "initialization failed")
 	}
 
@@ -140,12 +268,25 @@ func (n *Node) init(ctx context.Context, p *project.Project, genesis []byte, edi
 		return err
 	}
 
-	if genesis == nil {
-		return nil
+	if opts.Genesis != nil {
+		if err := ioutil.WriteFile(n.config.GenesisPath(), opts.Genesis, 0644); err != nil {
+			return errors.Wrap(err, "unable to overwrite genesis file")
+		}
+	}
+
+	if opts.Snapshot != "" {
+		if err := restoreSnapshot(ctx, n.config, p, opts.Snapshot); err != nil {
+			return err
+		}
 	}
 
-	if err := ioutil.WriteFile(n.config.GenesisPath(), genesis, 0644); err != nil {
-		return errors.Wrap(err, "unable to overwrite genesis file")
+	if opts.FromExport != nil {
+		if _, err := os.Stat(n.config.BlockStorePath()); err == nil {
+			return errors.New("cannot use the option \"--from-export\": the chain has already started (run `chainkit reset --keep-keys` first)")
+		}
+		if err := importState(ctx, n.config, p, opts.FromExport); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -161,15 +302,15 @@ func (n *Node) createNetwork(ctx context.Context, p *project.Project) (string, e
 	}
 	f.Close()
 
-	chainID, err := n.discovery.Publish(ctx, n.config.ManifestPath(), n.config.GenesisPath(), f.Name())
+	networkID, err := n.discovery.Publish(ctx, n.config.ManifestPath(), n.config.GenesisPath(), f.Name())
 	if err != nil {
 		return "", errors.Wrap(err, "unable to create network")
 	}
 
-	return chainID, nil
+	return networkID, nil
 }
 
-func (n *Node) announce(ctx context.Context, chainID string, peer *discovery.PeerInfo) error {
+func (n *Node) announce(ctx context.Context, networkID string, peer *discovery.PeerInfo) error {
 	ui.Info("Registering this node with the network...")
 	for {
 		select {
@@ -178,7 +319,7 @@ func (n *Node) announce(ctx context.Context, chainID string, peer *discovery.Pee
 		default:
 		}
 
-		err := n.discovery.Announce(ctx, chainID, peer)
+		err := n.discovery.Announce(ctx, networkID, peer)
 		if err == nil {
 			ui.Info("Node successfully registered")
 			return nil
@@ -192,7 +333,7 @@ func (n *Node) announce(ctx context.Context, chainID string, peer *discovery.Pee
 	}
 }
 
-func (n *Node) discoverPeers(ctx context.Context, chainID string) error {
+func (n *Node) discoverPeers(ctx context.Context, chainID, networkID string) error {
 	ui.Info("Discovering network nodes...")
 
 	seenNodes := make(map[string]struct{})
@@ -205,7 +346,7 @@ func (n *Node) discoverPeers(ctx context.Context, chainID string) error {
 		default:
 		}
 
-		peerCh, err := n.discovery.Peers(ctx, chainID)
+		peerCh, err := n.discovery.Peers(ctx, networkID)
 		if err != nil {
 			return err
 		}
@@ -215,6 +356,18 @@ func (n *Node) discoverPeers(ctx context.Context, chainID string) error {
 				continue
 			}
 			ui.Info("Discovered node %s", ui.Emphasize(peer.NodeID))
+			err := n.hooks.Fire(ctx, hooks.PeerDiscovered, map[string]string{
+				"chain_id":      chainID,
+				"network_id":    networkID,
+				"peer_id":       peer.NodeID,
+				"peer_ips":      strings.Join(peer.IP, ","),
+				"peer_p2p_port": strconv.Itoa(peer.TendermintP2PPort),
+			})
+			if err != nil {
+				ui.Error("Not dialing peer %s: %v", peer.NodeID, err)
+				seenNodes[peer.NodeID] = struct{}{}
+				continue
+			}
 			if err := n.server.dialSeeds(ctx, peer); err != nil {
 				ui.Error("Failed to dial peer: %v", err)
 				continue
@@ -230,3 +383,11 @@ func (n *Node) discoverPeers(ctx context.Context, chainID string) error {
 		}
 	}
 }
+
+func (n *Node) watchReleases(ctx context.Context, networkID, release string) error {
+	for r := range n.discovery.WatchReleases(ctx, networkID, release, time.Minute) {
+		ui.Info("A new release of the network is available: %s", ui.Emphasize(r))
+		ui.Info("Run %s to upgrade this node", ui.Emphasize("chainkit upgrade"))
+	}
+	return nil
+}
@@ -6,7 +6,9 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/blocklayerhq/chainkit/config"
@@ -21,12 +23,25 @@ type server struct {
 	config *config.Config
 	errCh  chan error
 	rpc    *client.HTTP
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	doneCh chan struct{}
+	// starting is true until start returns. Exits of the daemon while
+	// starting are returned by start rather than reported to wait.
+	starting bool
+	// haltHeight is passed to the daemon as --halt-height when not zero.
+	// The daemon exiting cleanly is then reported to haltCh rather than
+	// to wait.
+	haltHeight int64
+	haltCh     chan struct{}
 }
 
 func newServer(config *config.Config) *server {
 	return &server{
 		config: config,
-		errCh:  make(chan error),
+		errCh:  make(chan error, 1),
+		haltCh: make(chan struct{}, 1),
 		rpc: client.NewHTTP(
 			fmt.Sprintf("http://localhost:%d", config.Ports.TendermintRPC),
 			fmt.Sprintf("http://localhost:%d/websocket", config.Ports.TendermintRPC),
@@ -50,39 +65,126 @@ func (s *server) waitReady(ctx context.Context) error {
 }
 
 // start starts the server and returns when it's up and running.
+// The server can be restarted after being stopped.
 func (s *server) start(ctx context.Context, p *project.Project) error {
 	logFile, err := os.OpenFile(s.config.LogFile(), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
 	if err != nil {
 		return errors.Wrap(err, "unable to open log file")
 	}
 
+	sctx, cancel := context.WithCancel(ctx)
+	doneCh := make(chan struct{})
+	exitCh := make(chan error, 1)
+
+	s.mu.Lock()
+	s.cancel = cancel
+	s.doneCh = doneCh
+	s.starting = true
+	args := []string{"start"}
+	if s.haltHeight > 0 {
+		args = append(args, "--halt-height", strconv.FormatInt(s.haltHeight, 10))
+	}
+	s.mu.Unlock()
+
 	// Spin the server on the background.
 	go func() {
-		defer close(s.errCh)
-		s.errCh <- util.DockerRunWithFD(ctx, s.config, p, os.Stdin, logFile, os.Stderr, "start")
+		defer close(doneCh)
+		defer logFile.Close()
+		err := util.DockerRunWithFD(sctx, s.config, p, os.Stdin, logFile, os.Stderr, args...)
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if s.starting {
+			exitCh <- err
+			return
+		}
+
+		if len(args) > 1 && err == nil && sctx.Err() == nil {
+			select {
+			case s.haltCh <- struct{}{}:
+			default:
+			}
+			return
+		}
+
+		// Don't report exits requested through stop().
+		if ctx.Err() != nil || sctx.Err() == nil {
+			s.errCh <- err
+		}
 	}()
 
 	// Wait for the server to be ready.
 	waitCh := make(chan error)
 	go func() {
 		defer close(waitCh)
-		waitCh <- s.waitReady(ctx)
+		waitCh <- s.waitReady(sctx)
 	}()
 
 	// Now we wait for the server to come up, or to error out.
 	select {
-	case err := <-s.errCh:
-		return err
+	case err := <-exitCh:
+		cancel()
+		return exitError(err)
 	case err := <-waitCh:
 		if err != nil {
 			return err
 		}
 	}
 
+	// From now on, exits are reported to wait, unless the daemon exited
+	// right before.
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.starting = false
+	select {
+	case err := <-exitCh:
+		return exitError(err)
+	default:
+	}
 	return nil
 }
 
-// wait waits until the server stops.
+// exitError returns the error of a daemon which exited while starting.
+func exitError(err error) error {
+	if err == nil {
+		return errors.New("the daemon exited unexpectedly")
+	}
+	return err
+}
+
+// setHaltHeight sets the height at which the daemon halts, or zero to never
+// halt. It applies to the next start.
+func (s *server) setHaltHeight(height int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.haltHeight = height
+}
+
+// halted returns true if the daemon exited at its halt height since the
+// last call.
+func (s *server) halted() bool {
+	select {
+	case <-s.haltCh:
+		return true
+	default:
+		return false
+	}
+}
+
+// stop stops the server and returns once it has exited.
+func (s *server) stop() {
+	s.mu.Lock()
+	cancel, doneCh := s.cancel, s.doneCh
+	s.mu.Unlock()
+
+	if cancel == nil {
+		return
+	}
+	cancel()
+	<-doneCh
+}
+
+// wait waits until the server stops, unless it was stopped on purpose.
 func (s *server) wait() error {
 	return <-s.errCh
 }
@@ -97,6 +199,7 @@ func (s *server) peerInfo(ctx context.Context) (*discovery.PeerInfo, error) {
 	return &discovery.PeerInfo{
 		NodeID:            string(status.NodeInfo.ID),
 		TendermintP2PPort: s.config.Ports.TendermintP2P,
+		TendermintRPCPort: s.config.Ports.TendermintRPC,
 	}, nil
 }
 
@@ -0,0 +1,288 @@
+package node
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/blocklayerhq/chainkit/config"
+	"github.com/blocklayerhq/chainkit/discovery"
+	"github.com/blocklayerhq/chainkit/genesis"
+	"github.com/blocklayerhq/chainkit/project"
+	"github.com/blocklayerhq/chainkit/ui"
+	"github.com/pkg/errors"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/rpc/client"
+	sm "github.com/tendermint/tendermint/state"
+)
+
+// snapshotExcludes lists the files of the data directory which are specific
+// to a node and must not be shared.
+var snapshotExcludes = []string{
+	"data/cs.wal",
+	"data/mempool.wal",
+	"data/priv_validator_state.json",
+}
+
+// snapshots periodically takes a snapshot of the chain data and publishes it.
+// The daemon is briefly stopped while taking the snapshot so that the data
+// is consistent. No snapshot is taken if no block was committed since the
+// last one.
+func (n *Node) snapshots(ctx context.Context, p *project.Project, interval time.Duration) error {
+	if interval <= 0 {
+		return nil
+	}
+	var lastHeight int64
+	for {
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		if _, err := os.Stat(n.config.BlockStorePath()); err != nil {
+			continue
+		}
+		status, err := n.server.rpc.Status()
+		if err != nil || status.SyncInfo.LatestBlockHeight <= lastHeight {
+			continue
+		}
+
+		ui.Info("Taking a snapshot of the chain data...")
+		n.maintenanceMu.Lock()
+		n.server.stop()
+		archive, err := archiveData(ctx, n.config, p)
+		startErr := n.server.start(n.parentCtx, p)
+		n.maintenanceMu.Unlock()
+		if startErr != nil {
+			return errors.Wrap(startErr, "unable to restart the node after taking a snapshot")
+		}
+		if err != nil {
+			ui.Error("Snapshot failed: %v", err)
+			continue
+		}
+
+		info, err := snapshotInfo(n.config, archive)
+		if err == nil {
+			err = n.discovery.PublishSnapshot(ctx, archive, info)
+		}
+		os.Remove(archive)
+		if err != nil {
+			ui.Error("Snapshot failed: %v", err)
+			continue
+		}
+		lastHeight = info.Height
+		ui.Success("Published a snapshot of block %d", info.Height)
+	}
+}
+
+// archiveData archives the data directory and returns the archive path.
+// The daemon must be stopped.
+func archiveData(ctx context.Context, config *config.Config, p *project.Project) (string, error) {
+	f, err := ioutil.TempFile(os.TempDir(), "chainkit-snapshot")
+	if err != nil {
+		return "", errors.Wrap(err, "unable to create temporary file")
+	}
+	defer f.Close()
+
+	args := []string{"tar", "czf", "-", "-C", containerPath(config, p, config.StateDir())}
+	for _, exclude := range snapshotExcludes {
+		args = append(args, "--exclude", exclude)
+	}
+	args = append(args, "data")
+
+	if err := runInContainerWithFD(ctx, config, p, nil, f, args...); err != nil {
+		os.Remove(f.Name())
+		return "", errors.Wrap(err, "unable to archive the chain data")
+	}
+	return f.Name(), nil
+}
+
+// snapshotInfo describes a snapshot archive of the chain.
+func snapshotInfo(config *config.Config, archive string) (*discovery.SnapshotInfo, error) {
+	doc, err := ioutil.ReadFile(config.GenesisPath())
+	if err != nil {
+		return nil, err
+	}
+	height, appHash, err := readSnapshotState(archive)
+	if err != nil {
+		return nil, err
+	}
+	return &discovery.SnapshotInfo{
+		Height:  height,
+		AppHash: hex.EncodeToString(appHash),
+		Genesis: genesis.Hash(doc),
+	}, nil
+}
+
+// VerifySnapshot checks a snapshot archive matches its description.
+// Once restored, the node also verifies the application hash against the
+// blocks of the network as it catches up.
+func VerifySnapshot(archive string, info *discovery.SnapshotInfo) error {
+	height, appHash, err := readSnapshotState(archive)
+	if err != nil {
+		return err
+	}
+	if height != info.Height {
+		return fmt.Errorf("the snapshot is at block %d, expected %d", height, info.Height)
+	}
+	if hex.EncodeToString(appHash) != info.AppHash {
+		return fmt.Errorf("the snapshot application hash %X doesn't match %s", appHash, info.AppHash)
+	}
+	return nil
+}
+
+// ConfirmSnapshot checks the application hash of a snapshot against the
+// chain: the header of the next block is fetched from the first of peers
+// which serves it. peers must not include the node publishing the snapshot.
+func ConfirmSnapshot(ctx context.Context, chainID string, info *discovery.SnapshotInfo, peers []*discovery.PeerInfo) error {
+	for _, peer := range peers {
+		if peer.TendermintRPCPort == 0 {
+			continue
+		}
+		for _, ip := range peer.IP {
+			remote := fmt.Sprintf("tcp://%s:%d", ip, peer.TendermintRPCPort)
+			appHash, err := blockAppHash(ctx, remote, chainID, info.Height+1)
+			if err != nil {
+				continue
+			}
+			if !strings.EqualFold(appHash, info.AppHash) {
+				return fmt.Errorf("node %s reports the application hash %s at block %d, the snapshot has %s", peer.NodeID, appHash, info.Height, info.AppHash)
+			}
+			return nil
+		}
+	}
+	return errors.New("no other node of the network could confirm the application hash of the snapshot")
+}
+
+// blockAppHash returns the hex encoded application hash recorded in the
+// header of a block, i.e. the one after the previous block, as served by the
+// RPC at remote.
+func blockAppHash(ctx context.Context, remote, chainID string, height int64) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	type result struct {
+		appHash string
+		err     error
+	}
+	resultCh := make(chan result, 1)
+	go func() {
+		commit, err := client.NewHTTP(remote, "/websocket").Commit(&height)
+		switch {
+		case err != nil:
+			resultCh <- result{err: err}
+		case commit.Header == nil || commit.Header.ChainID != chainID:
+			resultCh <- result{err: fmt.Errorf("%s doesn't serve the chain %s", remote, chainID)}
+		default:
+			resultCh <- result{appHash: hex.EncodeToString(commit.Header.AppHash)}
+		}
+	}()
+
+	select {
+	case r := <-resultCh:
+		return r.appHash, r.err
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+// readSnapshotState returns the last block height and application hash
+// recorded in the Tendermint state of a snapshot archive.
+func readSnapshotState(archive string) (int64, []byte, error) {
+	dir, err := ioutil.TempDir(os.TempDir(), "chainkit-snapshot-state")
+	if err != nil {
+		return 0, nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	if err := extractStateDB(archive, dir); err != nil {
+		return 0, nil, errors.Wrap(err, "invalid snapshot")
+	}
+
+	db, err := dbm.NewGoLevelDB("state", path.Join(dir, "data"))
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "invalid snapshot")
+	}
+	defer db.Close()
+
+	state := sm.LoadState(db)
+	if state.LastBlockHeight == 0 {
+		return 0, nil, errors.New("invalid snapshot: no block found")
+	}
+	return state.LastBlockHeight, state.AppHash, nil
+}
+
+// extractStateDB extracts the Tendermint state database of a snapshot archive.
+func extractStateDB(archive, dir string) error {
+	f, err := os.Open(archive)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return err
+	}
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		name := path.Clean(hdr.Name)
+		if hdr.Typeflag != tar.TypeReg || !strings.HasPrefix(name, "data/state.db/") {
+			continue
+		}
+		dst := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+			return err
+		}
+		out, err := os.Create(dst)
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(out, tr)
+		out.Close()
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// restoreSnapshot replaces the chain data with a snapshot archive.
+func restoreSnapshot(ctx context.Context, config *config.Config, p *project.Project, archive string) error {
+	if _, err := os.Stat(config.BlockStorePath()); err == nil {
+		return errors.New("cannot restore a snapshot: the chain has already started (run `chainkit reset --keep-keys` first)")
+	}
+
+	f, err := os.Open(archive)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	ui.Info("Restoring the chain data from a snapshot")
+	stateDir := containerPath(config, p, config.StateDir())
+	err = runInContainerWithFD(ctx, config, p, f, os.Stdout,
+		"sh", "-c", fmt.Sprintf("rm -rf %s/data && tar xzf - -C %s", stateDir, stateDir),
+	)
+	if err != nil {
+		return errors.Wrap(err, "unable to restore the snapshot")
+	}
+	return fixFsPermissions(ctx, config, p)
+}
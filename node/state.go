@@ -0,0 +1,154 @@
+package node
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/blocklayerhq/chainkit/config"
+	"github.com/blocklayerhq/chainkit/genesis"
+	"github.com/blocklayerhq/chainkit/project"
+	"github.com/blocklayerhq/chainkit/ui"
+	"github.com/blocklayerhq/chainkit/util"
+	"github.com/pkg/errors"
+)
+
+// ResetOpts contains a list of reset options.
+type ResetOpts struct {
+	// KeepKeys keeps the CLI keys as well as the node and validator keys.
+	KeepKeys bool
+	// KeepGenesis keeps the genesis file, restarting the same chain from scratch.
+	KeepGenesis bool
+}
+
+// Reset deletes the chain data. Unless asked to keep them, the keys and
+// the genesis file are deleted as well, and regenerated on the next start.
+func Reset(ctx context.Context, config *config.Config, p *project.Project, opts ResetOpts) error {
+	if _, err := os.Stat(config.GenesisPath()); err != nil {
+		return errors.New("the chain hasn't been initialized")
+	}
+	if opts.KeepGenesis && !opts.KeepKeys {
+		return errors.New("keeping the genesis file requires keeping the keys it refers to")
+	}
+
+	paths := []string{
+		config.DataDir(),
+		config.GenesisHashPath(),
+		config.UpgradePlanPath(),
+	}
+
+	if opts.KeepKeys {
+		// Reset the signing state of the validator along with the data.
+		if err := util.DockerRun(ctx, config, p, "unsafe-reset-all"); err != nil {
+			return errors.Wrap(err, "unable to reset the chain")
+		}
+		if !opts.KeepGenesis {
+			paths = append(paths, config.GenesisPath())
+		}
+	} else {
+		paths = append(paths, config.ConfigDir(), config.CLIDir())
+	}
+
+	args := []string{"rm", "-rf"}
+	for _, hostPath := range paths {
+		args = append(args, containerPath(config, p, hostPath))
+	}
+	if err := runInContainer(ctx, config, p, args...); err != nil {
+		return errors.Wrap(err, "unable to delete the chain data")
+	}
+	return nil
+}
+
+// Export exports the state of the chain as a genesis file. If height is
+// zero, the latest state is exported.
+func Export(ctx context.Context, config *config.Config, p *project.Project, height int64, w io.Writer) error {
+	if _, err := os.Stat(config.BlockStorePath()); err != nil {
+		return errors.New("the chain hasn't produced any block yet")
+	}
+
+	args := []string{"export"}
+	if height > 0 {
+		var help bytes.Buffer
+		if err := util.DockerRunWithFD(ctx, config, p, nil, &help, ioutil.Discard, "export", "--help"); err != nil || !strings.Contains(help.String(), "--height") {
+			return errors.New("the application can only export its latest state, run without --height")
+		}
+		args = append(args, "--height", strconv.FormatInt(height, 10))
+	}
+
+	var out bytes.Buffer
+	if err := util.DockerRunWithFD(ctx, config, p, nil, &out, os.Stderr, args...); err != nil {
+		return errors.Wrap(err, "export failed")
+	}
+
+	doc, err := genesis.Normalize(out.Bytes())
+	if err != nil {
+		return errors.Wrap(err, "the application exported an invalid genesis file")
+	}
+	_, err = w.Write(doc)
+	return err
+}
+
+// importState resets the chain and restarts it from an exported genesis.
+func importState(ctx context.Context, config *config.Config, p *project.Project, exported []byte) error {
+	current, err := ioutil.ReadFile(config.GenesisPath())
+	if err != nil {
+		return err
+	}
+	doc, err := genesis.FromExport(current, exported)
+	if err != nil {
+		return err
+	}
+	if err := genesis.Validate(doc); err != nil {
+		return errors.Wrap(err, "invalid exported genesis file")
+	}
+
+	if _, err := os.Stat(config.BlockStorePath()); err == nil {
+		opts := ResetOpts{
+			KeepKeys:    true,
+			KeepGenesis: true,
+		}
+		if err := Reset(ctx, config, p, opts); err != nil {
+			return err
+		}
+	}
+
+	ui.Info("Importing the exported state")
+	if err := ioutil.WriteFile(config.GenesisPath(), doc, 0644); err != nil {
+		return errors.Wrap(err, "unable to write the exported genesis")
+	}
+	return nil
+}
+
+// runInContainer runs a command within the project's image, with the chain
+// state mounted.
+func runInContainer(ctx context.Context, config *config.Config, p *project.Project, args ...string) error {
+	return runInContainerWithFD(ctx, config, p, os.Stdin, os.Stdout, args...)
+}
+
+// runInContainerWithFD is like runInContainer but accepts stdin/stdout.
+func runInContainerWithFD(ctx context.Context, config *config.Config, p *project.Project, stdin io.Reader, stdout io.Writer, args ...string) error {
+	cmd := []string{
+		"run", "--rm", "-i",
+		"-v", config.StateDir() + ":" + path.Join("/", "root", "."+p.Binaries.Daemon),
+		"-v", config.CLIDir() + ":" + path.Join("/", "root", "."+p.Binaries.CLI),
+		"-l", "chainkit.project=" + p.Name,
+		p.Image + ":latest",
+	}
+	cmd = append(cmd, args...)
+	return util.RunWithFD(ctx, stdin, stdout, os.Stderr, "docker", cmd...)
+}
+
+// containerPath returns the location of a state file within the container.
+func containerPath(config *config.Config, p *project.Project, hostPath string) string {
+	rel, err := filepath.Rel(config.StateDir(), hostPath)
+	if err != nil {
+		return hostPath
+	}
+	return path.Join("/", "root", "."+p.Binaries.Daemon, rel)
+}
@@ -0,0 +1,258 @@
+package node
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/blocklayerhq/chainkit/client"
+	"github.com/blocklayerhq/chainkit/config"
+	"github.com/blocklayerhq/chainkit/project"
+	"github.com/blocklayerhq/chainkit/ui"
+	"github.com/blocklayerhq/chainkit/util"
+	"github.com/pkg/errors"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"gopkg.in/yaml.v2"
+)
+
+// UpgradePlan describes an upgrade of the node scheduled at a given height.
+type UpgradePlan struct {
+	// Height is the block at which the daemon is stopped and upgraded.
+	Height int64 `yaml:"height"`
+	// Image is the new application image.
+	Image string `yaml:"image"`
+	// Migrate is an optional shell command run within the new image, with
+	// the chain state mounted, before restarting the daemon.
+	Migrate string `yaml:"migrate,omitempty"`
+	// Export restarts the chain from an export of its state, taken with the
+	// previous image.
+	Export bool `yaml:"export,omitempty"`
+}
+
+// ScheduleUpgrade records an upgrade plan. A running node applies it as soon
+// as it reaches the plan's height, otherwise it is applied on the next start.
+func ScheduleUpgrade(config *config.Config, plan *UpgradePlan) error {
+	if plan.Height <= 0 {
+		return fmt.Errorf("invalid upgrade height %d", plan.Height)
+	}
+	if plan.Image == "" {
+		return errors.New("no image specified for the upgrade")
+	}
+	if _, err := os.Stat(config.StateDir()); err != nil {
+		return errors.New("the node hasn't been initialized yet")
+	}
+	data, err := yaml.Marshal(plan)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(config.UpgradePlanPath(), data, 0644)
+}
+
+// CancelUpgrade removes the scheduled upgrade, if any.
+func CancelUpgrade(config *config.Config) error {
+	err := os.Remove(config.UpgradePlanPath())
+	if os.IsNotExist(err) {
+		return errors.New("no upgrade is scheduled")
+	}
+	return err
+}
+
+// LoadUpgradePlan returns the scheduled upgrade, or nil if there is none.
+func LoadUpgradePlan(config *config.Config) (*UpgradePlan, error) {
+	data, err := ioutil.ReadFile(config.UpgradePlanPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	plan := &UpgradePlan{}
+	if err := yaml.Unmarshal(data, plan); err != nil {
+		return nil, errors.Wrapf(err, "unable to parse %q", config.UpgradePlanPath())
+	}
+	return plan, nil
+}
+
+// watchUpgrades waits for the chain to reach the height of the scheduled
+// upgrade, if any, and applies it.
+//
+// Daemons supporting --halt-height are restarted with it, so that every
+// node stops right after committing the block of the upgrade. Otherwise,
+// the daemon is stopped as soon as the node sees the block committed, which
+// may be after the next one was.
+func (n *Node) watchUpgrades(ctx context.Context, p *project.Project) error {
+	haltSupported := daemonSupportsFlag(ctx, p, "start", "halt-height")
+
+	// Blocks are followed through events, the status of the node being
+	// polled in case they are missed.
+	var blocks <-chan interface{}
+	c := client.NewWithRemote(fmt.Sprintf("tcp://localhost:%d", n.config.Ports.TendermintRPC), p.AddressPrefix())
+	defer c.Close()
+	followBlocks := func() {
+		if haltSupported || blocks != nil {
+			return
+		}
+		var err error
+		if blocks, err = c.Subscribe(ctx, "tm.event = 'NewBlock'"); err != nil {
+			ui.Error("Unable to follow new blocks, polling: %v", err)
+		}
+	}
+	followBlocks()
+
+	var (
+		plan       *UpgradePlan
+		haltHeight int64
+		lastErr    string
+	)
+	for {
+		var height int64
+		select {
+		case ev, ok := <-blocks:
+			if !ok {
+				blocks = nil
+				continue
+			}
+			if haltSupported {
+				continue
+			}
+			if b, ok := ev.(tmtypes.EventDataNewBlock); ok && b.Block != nil {
+				height = b.Block.Height
+			}
+		case <-time.After(time.Second):
+			var err error
+			plan, err = LoadUpgradePlan(n.config)
+			if err != nil {
+				if err.Error() != lastErr {
+					ui.Error("Ignoring upgrade plan: %v", err)
+					lastErr = err.Error()
+				}
+				plan = nil
+			} else {
+				lastErr = ""
+			}
+
+			if haltSupported {
+				target := int64(0)
+				if plan != nil {
+					target = plan.Height
+				}
+				if target != haltHeight {
+					if err := n.restartWithHaltHeight(p, target); err != nil {
+						return err
+					}
+					haltHeight = target
+				}
+				if plan == nil || !n.server.halted() {
+					continue
+				}
+				height = plan.Height
+			} else if status, err := n.server.rpc.Status(); err == nil {
+				height = status.SyncInfo.LatestBlockHeight
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		if plan == nil || height < plan.Height {
+			continue
+		}
+		if err := n.upgrade(ctx, p, plan); err != nil {
+			return errors.Wrap(err, "upgrade failed")
+		}
+
+		// The new image may not support --halt-height the same way.
+		plan, haltHeight = nil, 0
+		haltSupported = daemonSupportsFlag(ctx, p, "start", "halt-height")
+		followBlocks()
+	}
+}
+
+// restartWithHaltHeight restarts the daemon so that it halts at height, or
+// never if height is zero.
+func (n *Node) restartWithHaltHeight(p *project.Project, height int64) error {
+	n.maintenanceMu.Lock()
+	defer n.maintenanceMu.Unlock()
+
+	if height > 0 {
+		ui.Info("Restarting node to halt at block %d for the upgrade...", height)
+	} else {
+		ui.Info("Upgrade cancelled, restarting node...")
+	}
+	n.server.stop()
+	n.server.halted()
+	n.server.setHaltHeight(height)
+	if err := n.server.start(n.parentCtx, p); err != nil {
+		return errors.Wrap(err, "unable to restart the node")
+	}
+	return nil
+}
+
+// upgrade stops the daemon, swaps its image and restarts it on the same state.
+func (n *Node) upgrade(ctx context.Context, p *project.Project, plan *UpgradePlan) error {
+	n.maintenanceMu.Lock()
+	defer n.maintenanceMu.Unlock()
+
+	ui.Info("Reached block %d, upgrading to %s...", plan.Height, ui.Emphasize(plan.Image))
+	n.server.stop()
+
+	// Keep the previous image around to be able to roll back.
+	current := p.Image + ":latest"
+	previous := fmt.Sprintf("%s:height-%d", p.Image, plan.Height)
+	if err := util.Run(ctx, "docker", "tag", current, previous); err != nil {
+		return errors.Wrap(err, "unable to tag the previous image")
+	}
+
+	var exported []byte
+	if plan.Export {
+		ui.Info("Exporting the chain state...")
+		buf := bytes.NewBuffer(nil)
+		if err := Export(ctx, n.config, p, 0, buf); err != nil {
+			return err
+		}
+		exported = buf.Bytes()
+	}
+
+	if err := util.Run(ctx, "docker", "tag", plan.Image, current); err != nil {
+		return errors.Wrapf(err, "unable to switch to image %s", plan.Image)
+	}
+
+	if plan.Export {
+		if err := importState(ctx, n.config, p, exported); err != nil {
+			return err
+		}
+	}
+
+	if plan.Migrate != "" {
+		ui.Info("Migrating the chain state...")
+		if err := migrateState(ctx, n.config, p, plan.Migrate); err != nil {
+			return err
+		}
+	}
+
+	if err := os.Remove(n.config.UpgradePlanPath()); err != nil {
+		return err
+	}
+	if err := verifyGenesis(n.config); err != nil {
+		return err
+	}
+
+	ui.Info("Restarting node...")
+	n.server.setHaltHeight(0)
+	if err := n.server.start(n.parentCtx, p); err != nil {
+		return errors.Wrapf(err, "the new image failed to start (the previous image is available as %s)", previous)
+	}
+	ui.Success("Upgrade complete, the node is running %s", ui.Emphasize(plan.Image))
+	return nil
+}
+
+// migrateState runs a shell command within the project's image, with the
+// chain state mounted.
+func migrateState(ctx context.Context, config *config.Config, p *project.Project, command string) error {
+	if err := runInContainer(ctx, config, p, "sh", "-c", command); err != nil {
+		return errors.Wrap(err, "migration failed")
+	}
+	return fixFsPermissions(ctx, config, p)
+}
@@ -0,0 +1,115 @@
+package node
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/blocklayerhq/chainkit/builder"
+	"github.com/blocklayerhq/chainkit/project"
+	"github.com/blocklayerhq/chainkit/ui"
+	"github.com/blocklayerhq/chainkit/util"
+	"github.com/pkg/errors"
+)
+
+// watchInterval is how often the sources are checked for changes. A rebuild
+// waits for the sources to be stable for one interval.
+const watchInterval = time.Second
+
+// watchSources rebuilds the image whenever the sources of the project change
+// and restarts the daemon on the new build, keeping its state. The sources
+// are the ones the builder hashes to decide whether to build. Build failures
+// are reported without stopping the running daemon.
+func (n *Node) watchSources(ctx context.Context, p *project.Project) error {
+	last, err := builder.SourcesHash(n.config.RootDir)
+	if err != nil {
+		return errors.Wrap(err, "unable to watch the sources")
+	}
+	ui.Info("Watching %s for changes", ui.Emphasize(n.config.RootDir))
+
+	pending := false
+	for {
+		select {
+		case <-time.After(watchInterval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		current, err := builder.SourcesHash(n.config.RootDir)
+		if err != nil {
+			ui.Error("Unable to watch the sources: %v", err)
+			continue
+		}
+		if current != last {
+			last = current
+			pending = true
+			continue
+		}
+		if !pending {
+			continue
+		}
+		pending = false
+
+		if err := n.rebuild(ctx, p); err != nil {
+			return err
+		}
+		// Sources generated by the pre-build hooks don't trigger another
+		// rebuild.
+		if current, err := builder.SourcesHash(n.config.RootDir); err == nil {
+			last = current
+		}
+	}
+}
+
+// rebuild builds the image and restarts the daemon if the image changed. If
+// the new build fails to start, the daemon is restarted on the previous one.
+func (n *Node) rebuild(ctx context.Context, p *project.Project) error {
+	ui.Info("Sources changed, rebuilding %s...", ui.Emphasize(p.Name))
+	previous := imageID(ctx, p)
+	b := builder.New(n.config.RootDir, p.Image)
+	if err := b.Build(ctx, builder.BuildOpts{Hooks: n.hooks}); err != nil {
+		ui.Error("Build failed, the node keeps running the previous build: %v", err)
+		return nil
+	}
+	if imageID(ctx, p) == previous {
+		return nil
+	}
+
+	n.maintenanceMu.Lock()
+	defer n.maintenanceMu.Unlock()
+
+	ui.Info("Restarting node...")
+	n.server.stop()
+	err := n.server.start(n.parentCtx, p)
+	if err == nil {
+		ui.Success("The node is running the new build")
+		return nil
+	}
+
+	ui.Error("The new build failed to start: %v", err)
+	ui.Error("Logs can be found in: %s", n.config.LogFile())
+	if previous == "" {
+		return errors.Wrap(err, "the new build failed to start")
+	}
+	ui.Info("Restarting node on the previous build...")
+	n.server.stop()
+	if err := util.Run(ctx, "docker", "tag", previous, p.Image+":latest"); err != nil {
+		return errors.Wrap(err, "unable to restore the previous image")
+	}
+	if err := n.server.start(n.parentCtx, p); err != nil {
+		return errors.Wrap(err, "the previous build failed to start")
+	}
+	return nil
+}
+
+// imageID returns the ID of the latest image of the project, or an empty
+// string if unknown.
+func imageID(ctx context.Context, p *project.Project) string {
+	buf := bytes.NewBuffer(nil)
+	if err := util.RunWithFD(ctx, nil, buf, ioutil.Discard, "docker", "image", "inspect", "--format", "{{ .Id }}", p.Image+":latest"); err != nil {
+		return ""
+	}
+	return strings.TrimSpace(buf.String())
+}
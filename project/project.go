@@ -2,26 +2,138 @@ package project
 
 import (
 	"fmt"
+	"go/token"
 	"io"
 	"os"
 	"path"
+	"regexp"
+	"strings"
+	"time"
 
+	"github.com/blocklayerhq/chainkit/genesis"
 	"github.com/pkg/errors"
 	"gopkg.in/yaml.v2"
 )
 
 const manifestFile = "chainkit.yml"
 
+const (
+	// DefaultBech32Prefix is the bech32 prefix of the Cosmos SDK.
+	DefaultBech32Prefix = "cosmos"
+	// DefaultDenom is the denomination of the coins of projects which don't
+	// set one.
+	DefaultDenom = "mycoin"
+)
+
 type binaries struct {
 	CLI    string
 	Daemon string
 }
 
+// Hook is a command executed when a lifecycle event is emitted.
+// Exactly one of Run (executed on the host) or Container (executed
+// within the application image) must be set.
+type Hook struct {
+	Run       string `yaml:"run,omitempty"`
+	Container string `yaml:"container,omitempty"`
+}
+
+// GenesisConfig customizes the genesis file generated when the chain
+// is initialized.
+type GenesisConfig struct {
+	// Patches are applied in order. A patch is either the path of a JSON file
+	// (relative to the project), an inline JSON Merge Patch (object) or an
+	// inline JSON Patch (list of operations).
+	Patches []interface{} `yaml:",omitempty"`
+}
+
+// FaucetConfig configures the faucet served by the nodes.
+type FaucetConfig struct {
+	// Account is the name of the key sending the funds (defaults to "faucet").
+	// It must be funded in the genesis file.
+	Account string `yaml:",omitempty"`
+	// Amount is sent on each request (e.g. "10mycoin", defaults to 10 coins
+	// of the denomination of the project).
+	Amount string `yaml:",omitempty"`
+	// Interval is the minimum delay between two requests for the same
+	// address or from the same IP (defaults to "1h").
+	Interval string `yaml:",omitempty"`
+}
+
+// ScaffoldConfig records how the application was scaffolded, so that
+// changes to its template can be merged into it later.
+type ScaffoldConfig struct {
+	// Template is the built-in template, directory or git URL the
+	// application was created from.
+	Template string
+	// Version of the template: the chainkit version for built-in templates,
+	// the commit for git templates.
+	Version    string            `yaml:",omitempty"`
+	GoPkg      string            `yaml:"go_pkg,omitempty"`
+	SDKVersion string            `yaml:"sdk_version,omitempty"`
+	Vars       map[string]string `yaml:",omitempty"`
+}
+
 // Project represents a project
 type Project struct {
-	Name     string
-	Image    string
+	Name    string
+	Image   string
+	ChainID string `yaml:"chain_id,omitempty"`
+	// Bech32Prefix is the prefix of the account addresses (e.g. cosmos for
+	// cosmos1...). Defaults to the DefaultBech32Prefix.
+	Bech32Prefix string `yaml:"bech32_prefix,omitempty"`
+	// Denom is the denomination of the coins of the application. Defaults
+	// to the DefaultDenom.
+	Denom    string `yaml:",omitempty"`
 	Binaries *binaries
+	Hooks    map[string][]*Hook `yaml:",omitempty"`
+	Genesis  *GenesisConfig     `yaml:",omitempty"`
+	Faucet   *FaucetConfig      `yaml:",omitempty"`
+	Scaffold *ScaffoldConfig    `yaml:",omitempty"`
+}
+
+// nameRegexp matches names which are both valid Go identifiers and valid
+// Docker image names: lowercase, with single underscores as separators.
+var nameRegexp = regexp.MustCompile(`^[a-z][a-z0-9]*(_[a-z0-9]+)*$`)
+
+// bech32PrefixRegexp matches the human-readable parts of bech32 addresses
+// the Cosmos SDK accepts.
+var bech32PrefixRegexp = regexp.MustCompile(`^[a-z]{1,20}$`)
+
+// maxNameLength keeps the default chain ID (<name>-testnet) within its
+// 50 characters limit.
+const maxNameLength = 42
+
+// ValidateName checks the name of a project, used as the name of the
+// binaries, the Docker image and Go identifiers.
+func ValidateName(name string) error {
+	switch {
+	case name == "":
+		return fmt.Errorf("the name can't be empty")
+	case !nameRegexp.MatchString(name):
+		return fmt.Errorf("invalid name %q: use lowercase letters and digits, starting with a letter (single underscores may separate words)", name)
+	case token.Lookup(name).IsKeyword():
+		return fmt.Errorf("invalid name %q: it is a Go keyword", name)
+	case len(name) > maxNameLength:
+		return fmt.Errorf("invalid name %q: it must not exceed %d characters", name, maxNameLength)
+	}
+	return nil
+}
+
+// ValidateBech32Prefix checks the prefix of the account addresses.
+func ValidateBech32Prefix(prefix string) error {
+	if !bech32PrefixRegexp.MatchString(prefix) {
+		return fmt.Errorf("invalid bech32 prefix %q: use 1 to 20 lowercase letters", prefix)
+	}
+	return nil
+}
+
+// ValidateDenom checks the denomination of the coins.
+func ValidateDenom(denom string) error {
+	if !genesis.IsDenom(denom) {
+		return fmt.Errorf("invalid denom %q: use 3 to 16 lowercase letters and digits, starting with a letter", denom)
+	}
+	return nil
 }
 
 // New will create a new project in the given directory.
@@ -37,6 +149,51 @@ func New(name string) *Project {
 	return p
 }
 
+// NetworkName returns the chain ID used in the genesis file, which is also
+// the name of the network when published.
+func (p *Project) NetworkName() string {
+	if p.ChainID != "" {
+		return p.ChainID
+	}
+	return p.Name + "-testnet"
+}
+
+// AddressPrefix returns the bech32 prefix of the account addresses.
+func (p *Project) AddressPrefix() string {
+	if p.Bech32Prefix != "" {
+		return p.Bech32Prefix
+	}
+	return DefaultBech32Prefix
+}
+
+// CoinDenom returns the denomination of the coins.
+func (p *Project) CoinDenom() string {
+	if p.Denom != "" {
+		return p.Denom
+	}
+	return DefaultDenom
+}
+
+// IsAddress returns true if s is an account address of the project, with
+// its bech32 prefix.
+func (p *Project) IsAddress(s string) bool {
+	return genesis.IsAddress(s) && strings.HasPrefix(s, p.AddressPrefix()+"1")
+}
+
+// Coins returns a list of coins (e.g. "10mycoin,5stake"), plain amounts
+// (e.g. "10") being in the denomination of the project.
+func (p *Project) Coins(s string) string {
+	coins := strings.Split(s, ",")
+	for i, c := range coins {
+		c = strings.TrimSpace(c)
+		if c != "" && strings.Trim(c, "0123456789") == "" {
+			c += p.CoinDenom()
+		}
+		coins[i] = c
+	}
+	return strings.Join(coins, ",")
+}
+
 // Save serializes the project data on disk
 func (p *Project) Save(path string) error {
 	ybuf, err := yaml.Marshal(p)
@@ -70,6 +227,39 @@ func (p *Project) Validate() error {
 		return errorOut("binaries.cli")
 	case p.Binaries.Daemon == "":
 		return errorOut("binaries.daemon")
+	case len(p.NetworkName()) > 50:
+		return fmt.Errorf("chain_id %q is too long (max: 50 characters)", p.NetworkName())
+	}
+
+	if p.Bech32Prefix != "" {
+		if err := ValidateBech32Prefix(p.Bech32Prefix); err != nil {
+			return fmt.Errorf("bech32_prefix: %v", err)
+		}
+	}
+	if p.Denom != "" {
+		if err := ValidateDenom(p.Denom); err != nil {
+			return fmt.Errorf("denom: %v", err)
+		}
+	}
+
+	if p.Faucet != nil {
+		if p.Faucet.Interval != "" {
+			interval, err := time.ParseDuration(p.Faucet.Interval)
+			if err != nil {
+				return fmt.Errorf("faucet.interval: %v", err)
+			}
+			if interval <= 0 {
+				return fmt.Errorf("faucet.interval: must be positive, got %s", p.Faucet.Interval)
+			}
+		}
+	}
+
+	for event, hooks := range p.Hooks {
+		for i, h := range hooks {
+			if h == nil || (h.Run == "") == (h.Container == "") {
+				return fmt.Errorf("hooks.%s[%d]: exactly one of \"run\" or \"container\" must be set", event, i)
+			}
+		}
 	}
 
 	return nil
@@ -0,0 +1,32 @@
+package project
+
+import "testing"
+
+func TestValidateFaucetInterval(t *testing.T) {
+	tests := []struct {
+		interval string
+		valid    bool
+	}{
+		{"", true},
+		{"1h", true},
+		{"90s", true},
+		{"1ns", true},
+		{"0", false},
+		{"0s", false},
+		{"-1h", false},
+		{"1 hour", false},
+		{"10", false},
+	}
+
+	for _, tt := range tests {
+		p := New("test")
+		p.Faucet = &FaucetConfig{Interval: tt.interval}
+		err := p.Validate()
+		if tt.valid && err != nil {
+			t.Errorf("%q: unexpected error: %v", tt.interval, err)
+		}
+		if !tt.valid && err == nil {
+			t.Errorf("%q: expected an error", tt.interval)
+		}
+	}
+}
@@ -0,0 +1,222 @@
+package scaffold
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/sergi/go-diff/diffmatchpatch"
+)
+
+// diffContext is the number of unchanged lines shown around changes.
+const diffContext = 3
+
+// hunk replaces the lines [start, end) of a document with lines.
+type hunk struct {
+	start, end int
+	lines      []string
+}
+
+// splitLines splits a document in lines, keeping the line endings.
+func splitLines(doc []byte) []string {
+	lines := strings.SplitAfter(string(doc), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// diffLines returns the hunks turning a into b, in order.
+func diffLines(a, b []byte) []hunk {
+	dmp := diffmatchpatch.New()
+	ra, rb, lines := dmp.DiffLinesToRunes(string(a), string(b))
+
+	hunks := []hunk{}
+	var h *hunk
+	pos := 0
+	for _, d := range dmp.DiffMainRunes(ra, rb, false) {
+		n := len([]rune(d.Text))
+		if d.Type == diffmatchpatch.DiffEqual {
+			h = nil
+			pos += n
+			continue
+		}
+		if h == nil {
+			hunks = append(hunks, hunk{start: pos, end: pos})
+			h = &hunks[len(hunks)-1]
+		}
+		switch d.Type {
+		case diffmatchpatch.DiffDelete:
+			h.end += n
+			pos += n
+		case diffmatchpatch.DiffInsert:
+			for _, r := range d.Text {
+				h.lines = append(h.lines, lines[r])
+			}
+		}
+	}
+	return hunks
+}
+
+// apply returns the lines [start, end) of base with hunks applied.
+func apply(base []string, start, end int, hunks []hunk) []string {
+	out := []string{}
+	for _, h := range hunks {
+		out = append(out, base[start:h.start]...)
+		out = append(out, h.lines...)
+		start = h.end
+	}
+	return append(out, base[start:end]...)
+}
+
+// merge3 merges the changes made to base in ours and in theirs. Changes to
+// the same lines (or to adjacent lines) conflict unless they are
+// identical: both versions are kept, surrounded by conflict markers
+// labelled oursLabel and theirsLabel. merge3 returns whether there were
+// conflicts.
+func merge3(base, ours, theirs []byte, oursLabel, theirsLabel string) ([]byte, bool) {
+	lines := splitLines(base)
+	a := diffLines(base, ours)
+	b := diffLines(base, theirs)
+
+	var out bytes.Buffer
+	conflicts := false
+	pos := 0
+	for len(a) > 0 || len(b) > 0 {
+		// Group the hunks of both sides touching the first one.
+		var ga, gb []hunk
+		var start, end int
+		if len(b) == 0 || (len(a) > 0 && a[0].start <= b[0].start) {
+			start, end = a[0].start, a[0].end
+		} else {
+			start, end = b[0].start, b[0].end
+		}
+	group:
+		for {
+			var h hunk
+			switch {
+			case len(a) > 0 && a[0].start <= end:
+				h, a = a[0], a[1:]
+				ga = append(ga, h)
+			case len(b) > 0 && b[0].start <= end:
+				h, b = b[0], b[1:]
+				gb = append(gb, h)
+			default:
+				break group
+			}
+			if h.end > end {
+				end = h.end
+			}
+		}
+
+		writeLines(&out, lines[pos:start])
+		oa := apply(lines, start, end, ga)
+		ob := apply(lines, start, end, gb)
+		switch {
+		case len(gb) == 0:
+			writeLines(&out, oa)
+		case len(ga) == 0 || strings.Join(oa, "") == strings.Join(ob, ""):
+			writeLines(&out, ob)
+		default:
+			conflicts = true
+			fmt.Fprintf(&out, "<<<<<<< %s\n", oursLabel)
+			writeLines(&out, terminate(oa))
+			fmt.Fprintf(&out, "=======\n")
+			writeLines(&out, terminate(ob))
+			fmt.Fprintf(&out, ">>>>>>> %s\n", theirsLabel)
+		}
+		pos = end
+	}
+	writeLines(&out, lines[pos:])
+	return out.Bytes(), conflicts
+}
+
+// terminate makes sure the last line ends with a newline.
+func terminate(lines []string) []string {
+	if n := len(lines); n > 0 && !strings.HasSuffix(lines[n-1], "\n") {
+		lines = append(lines[:n-1:n-1], lines[n-1]+"\n")
+	}
+	return lines
+}
+
+func writeLines(out *bytes.Buffer, lines []string) {
+	for _, l := range lines {
+		out.WriteString(l)
+	}
+}
+
+// unifiedDiff returns the unified diff between a and b, or an empty string
+// if they are identical.
+func unifiedDiff(name string, a, b []byte) string {
+	hunks := diffLines(a, b)
+	if len(hunks) == 0 {
+		return ""
+	}
+	lines := splitLines(a)
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "--- %s\n+++ %s\n", diffName("a/"+name, a), diffName("b/"+name, b))
+	// offset is the difference between line numbers of b and of a.
+	offset := 0
+	for len(hunks) > 0 {
+		// Hunks closer than twice the context share a section.
+		n := 1
+		for n < len(hunks) && hunks[n].start-hunks[n-1].end <= 2*diffContext {
+			n++
+		}
+		group := hunks[:n]
+		hunks = hunks[n:]
+
+		from := group[0].start - diffContext
+		if from < 0 {
+			from = 0
+		}
+		to := group[n-1].end + diffContext
+		if to > len(lines) {
+			to = len(lines)
+		}
+		added := 0
+		for _, h := range group {
+			added += len(h.lines) - (h.end - h.start)
+		}
+		fmt.Fprintf(&out, "@@ -%s +%s @@\n", diffRange(from, to-from), diffRange(from+offset, to-from+added))
+
+		pos := from
+		for _, h := range group {
+			prefixLines(&out, " ", lines[pos:h.start])
+			prefixLines(&out, "-", lines[h.start:h.end])
+			prefixLines(&out, "+", h.lines)
+			pos = h.end
+		}
+		prefixLines(&out, " ", lines[pos:to])
+		offset += added
+	}
+	return out.String()
+}
+
+// diffName returns the name of a file in a diff: /dev/null if missing.
+func diffName(name string, data []byte) string {
+	if data == nil {
+		return "/dev/null"
+	}
+	return name
+}
+
+// diffRange formats the range of n lines starting after line start. Empty
+// ranges are numbered after the line preceding them.
+func diffRange(start, n int) string {
+	if n == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	return fmt.Sprintf("%d,%d", start+1, n)
+}
+
+func prefixLines(out *bytes.Buffer, prefix string, lines []string) {
+	for _, l := range lines {
+		out.WriteString(prefix)
+		out.WriteString(l)
+		if !strings.HasSuffix(l, "\n") {
+			out.WriteString("\n\\ No newline at end of file\n")
+		}
+	}
+}
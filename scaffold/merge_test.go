@@ -0,0 +1,139 @@
+package scaffold
+
+import "testing"
+
+func TestMerge3(t *testing.T) {
+	const base = "a\nb\nc\nd\ne\n"
+	tests := []struct {
+		name      string
+		base      string
+		ours      string
+		theirs    string
+		expected  string
+		conflicts bool
+	}{
+		{
+			name:     "unchanged",
+			base:     base,
+			ours:     base,
+			theirs:   base,
+			expected: base,
+		},
+		{
+			name:     "changed in ours",
+			base:     base,
+			ours:     "a\nB\nc\nd\ne\n",
+			theirs:   base,
+			expected: "a\nB\nc\nd\ne\n",
+		},
+		{
+			name:     "changed in theirs",
+			base:     base,
+			ours:     base,
+			theirs:   "a\nb\nc\nD\ne\n",
+			expected: "a\nb\nc\nD\ne\n",
+		},
+		{
+			name:     "distinct lines",
+			base:     base,
+			ours:     "a\nB\nc\nd\ne\n",
+			theirs:   "a\nb\nc\nD\ne\n",
+			expected: "a\nB\nc\nD\ne\n",
+		},
+		{
+			name:     "identical changes",
+			base:     base,
+			ours:     "a\nB\nc\nd\ne\n",
+			theirs:   "a\nB\nc\nd\ne\n",
+			expected: "a\nB\nc\nd\ne\n",
+		},
+		{
+			name:     "deletion and distinct change",
+			base:     base,
+			ours:     "a\nc\nd\ne\n",
+			theirs:   "a\nb\nc\nD\ne\n",
+			expected: "a\nc\nD\ne\n",
+		},
+		{
+			name:      "same line",
+			base:      base,
+			ours:      "a\nB\nc\nd\ne\n",
+			theirs:    "a\nX\nc\nd\ne\n",
+			expected:  "a\n<<<<<<< ours\nB\n=======\nX\n>>>>>>> theirs\nc\nd\ne\n",
+			conflicts: true,
+		},
+		{
+			name:      "adjacent lines",
+			base:      base,
+			ours:      "a\nB\nc\nd\ne\n",
+			theirs:    "a\nb\nC\nd\ne\n",
+			expected:  "a\n<<<<<<< ours\nB\nc\n=======\nb\nC\n>>>>>>> theirs\nd\ne\n",
+			conflicts: true,
+		},
+		{
+			name:      "insertions at the same place",
+			base:      base,
+			ours:      "a\nx\nb\nc\nd\ne\n",
+			theirs:    "a\ny\nb\nc\nd\ne\n",
+			expected:  "a\n<<<<<<< ours\nx\n=======\ny\n>>>>>>> theirs\nb\nc\nd\ne\n",
+			conflicts: true,
+		},
+		{
+			name:      "deleted in ours, changed in theirs",
+			base:      base,
+			ours:      "a\nc\nd\ne\n",
+			theirs:    "a\nX\nc\nd\ne\n",
+			expected:  "a\n<<<<<<< ours\n=======\nX\n>>>>>>> theirs\nc\nd\ne\n",
+			conflicts: true,
+		},
+		{
+			name:      "appended by both",
+			base:      base,
+			ours:      base + "f\n",
+			theirs:    base + "g\n",
+			expected:  base + "<<<<<<< ours\nf\n=======\ng\n>>>>>>> theirs\n",
+			conflicts: true,
+		},
+		{
+			name:     "appended identically by both",
+			base:     base,
+			ours:     base + "f\n",
+			theirs:   base + "f\n",
+			expected: base + "f\n",
+		},
+		{
+			name:      "no newline at end of file",
+			base:      "a\nb",
+			ours:      "a\nB",
+			theirs:    "a\nC",
+			expected:  "a\n<<<<<<< ours\nB\n=======\nC\n>>>>>>> theirs\n",
+			conflicts: true,
+		},
+		{
+			name:     "no newline at end of file, distinct lines",
+			base:     "a\nb\nc",
+			ours:     "A\nb\nc",
+			theirs:   "a\nb\nC",
+			expected: "A\nb\nC",
+		},
+		{
+			name:     "empty base",
+			base:     "",
+			ours:     "x\n",
+			theirs:   "",
+			expected: "x\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, conflicts := merge3([]byte(tt.base), []byte(tt.ours), []byte(tt.theirs), "ours", "theirs")
+			if string(out) != tt.expected {
+				t.Errorf("got:\n%s\nexpected:\n%s", out, tt.expected)
+			}
+			if conflicts != tt.conflicts {
+				t.Errorf("got conflicts = %v, expected %v", conflicts, tt.conflicts)
+			}
+		})
+	}
+}
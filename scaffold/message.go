@@ -0,0 +1,442 @@
+package scaffold
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/blocklayerhq/chainkit/httpfs"
+	"github.com/blocklayerhq/chainkit/project"
+	"github.com/blocklayerhq/chainkit/templates"
+)
+
+// messageGenerator is the directory of the templates of the message
+// generator within the assets.
+const messageGenerator = "/generators/message"
+
+// fieldKinds maps the types of message fields to Go types.
+var fieldKinds = map[string]string{
+	"string":  "string",
+	"bool":    "bool",
+	"int":     "int64",
+	"uint":    "uint64",
+	"coins":   "sdk.Coins",
+	"address": "sdk.AccAddress",
+}
+
+var (
+	messageNameRe = regexp.MustCompile(`^(Msg)?[A-Z][A-Za-z0-9]*$`)
+	fieldNameRe   = regexp.MustCompile(`^[a-z][A-Za-z0-9_]*$`)
+)
+
+// Message is a message rendered by the message generator.
+type Message struct {
+	// Module is the name of the module of the message.
+	Module string
+	// Name is the name of the message type (e.g. MsgBuyName).
+	Name string
+	// Short is the name without the Msg prefix (e.g. BuyName).
+	Short string
+	// Var is the short name in lower camel case (e.g. buyName).
+	Var string
+	// Type is the type of the message returned by Type() (e.g. buy_name).
+	Type string
+	// Command is the name of the CLI command (e.g. buy-name).
+	Command string
+	Fields  []*Field
+}
+
+// Field is a field of a message.
+type Field struct {
+	// Name is the name of the field in the message struct (e.g. MaxPrice).
+	Name string
+	// Arg is the name of the field as an argument (e.g. maxPrice).
+	Arg string
+	// JSON is the name of the field in JSON (e.g. max_price).
+	JSON string
+	// Kind is the type of the field: string, bool, int, uint, coins or
+	// address.
+	Kind string
+	// Type is the Go type of the field.
+	Type string
+}
+
+// Uses returns true if a field of the message is of one of kinds.
+func (m *Message) Uses(kinds ...string) bool {
+	for _, f := range m.Fields {
+		for _, k := range kinds {
+			if f.Kind == k {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Example returns an example value of the field, in Go. Coins are in the
+// given denomination.
+func (f *Field) Example(denom string) string {
+	switch f.Kind {
+	case "bool":
+		return "true"
+	case "int", "uint":
+		return "1"
+	case "coins":
+		return fmt.Sprintf("sdk.Coins{sdk.NewInt64Coin(%q, 10)}", denom)
+	case "address":
+		return fmt.Sprintf("sdk.AccAddress([]byte(%q))", f.Arg)
+	}
+	return fmt.Sprintf("%q", f.Arg)
+}
+
+// NewMessage parses the name of a message and its fields, given as
+// name:type.
+func NewMessage(module, name string, fields []string) (*Message, error) {
+	if !messageNameRe.MatchString(name) {
+		return nil, fmt.Errorf("invalid message name %q: expected CamelCase (e.g. MsgBuyName)", name)
+	}
+	short := strings.TrimPrefix(name, "Msg")
+	if short == "" {
+		return nil, fmt.Errorf("invalid message name %q", name)
+	}
+	words := splitWords(short)
+	m := &Message{
+		Module:  module,
+		Name:    "Msg" + short,
+		Short:   short,
+		Var:     lowerCamel(words),
+		Type:    strings.Join(words, "_"),
+		Command: strings.Join(words, "-"),
+	}
+
+	seen := map[string]bool{"Sender": true}
+	for _, field := range fields {
+		parts := strings.SplitN(field, ":", 2)
+		if len(parts) != 2 || !fieldNameRe.MatchString(parts[0]) {
+			return nil, fmt.Errorf("invalid field %q: expected name:type", field)
+		}
+		typ, ok := fieldKinds[parts[1]]
+		if !ok {
+			return nil, fmt.Errorf("invalid field %q: unknown type %q (string, bool, int, uint, coins, address)", field, parts[1])
+		}
+		words := splitWords(parts[0])
+		f := &Field{
+			Name: upperCamel(words),
+			Arg:  lowerCamel(words),
+			JSON: strings.Join(words, "_"),
+			Kind: parts[1],
+			Type: typ,
+		}
+		if token.Lookup(f.Arg).IsKeyword() {
+			return nil, fmt.Errorf("invalid field %q: %s is a Go keyword", field, f.Arg)
+		}
+		if seen[f.Name] {
+			return nil, fmt.Errorf("invalid field %q: %s is already a field of the message", field, f.Name)
+		}
+		seen[f.Name] = true
+		m.Fields = append(m.Fields, f)
+	}
+
+	return m, nil
+}
+
+// AddMessage generates a message in the module x/<m.Module> of the
+// application at rootDir: the message type, its handler, its CLI command
+// and its REST route. It returns the files it created or changed.
+//
+// As with modules, generating a message again does nothing, and files
+// changed since they were generated are not overwritten.
+func AddMessage(rootDir string, p *project.Project, m *Message) ([]string, error) {
+	goPkg, err := modulePath(rootDir)
+	if err != nil {
+		return nil, err
+	}
+
+	moduleDir := filepath.Join("x", m.Module)
+	if _, err := os.Stat(filepath.Join(rootDir, moduleDir)); err != nil {
+		return nil, fmt.Errorf("module %s not found in %s", m.Module, moduleDir)
+	}
+
+	ctx := &Context{
+		Name:         p.Name,
+		RootDir:      rootDir,
+		GoPkg:        goPkg,
+		SDKVersion:   sdkVersion(p),
+		Bech32Prefix: p.AddressPrefix(),
+		Denom:        p.CoinDenom(),
+		Vars:         map[string]string{},
+		Message:      m,
+	}
+
+	changes := map[string][]byte{}
+	conflicts := []string{}
+
+	fs := &subFS{fs: templates.Assets, dir: messageGenerator}
+	err = httpfs.Walk(fs, "/", func(src string, fi os.FileInfo, err error) error {
+		if err != nil || fi.IsDir() {
+			return err
+		}
+		dst, data, err := renderFile(ctx, fs, src, fi)
+		if err != nil {
+			return err
+		}
+		existing, err := ioutil.ReadFile(filepath.Join(rootDir, dst))
+		switch {
+		case os.IsNotExist(err):
+			changes[dst] = data
+		case err != nil:
+			return err
+		case !bytes.Equal(existing, data):
+			conflicts = append(conflicts, strings.TrimPrefix(dst, "/"))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(conflicts) > 0 {
+		return nil, fmt.Errorf("not overwriting files changed since they were generated: %s", strings.Join(conflicts, ", "))
+	}
+
+	patches := []struct {
+		file  string
+		patch func(path string, m *Message, goPkg string) ([]byte, error)
+	}{
+		{filepath.Join(moduleDir, "codec.go"), registerMessageInCodec},
+		{filepath.Join(moduleDir, "handler.go"), registerMessageInHandler},
+		{filepath.Join(moduleDir, "client", "rest", "rest.go"), registerMessageInREST},
+		{filepath.Join("cmd", p.Binaries.CLI, "main.go"), registerMessageInCLI},
+	}
+	for _, patch := range patches {
+		data, err := patch.patch(filepath.Join(rootDir, patch.file), m, goPkg)
+		if err != nil {
+			return nil, err
+		}
+		if data != nil {
+			changes[patch.file] = data
+		}
+	}
+
+	return writeChanges(rootDir, changes)
+}
+
+// registerMessageInCodec returns codec.go with the message registered, or
+// nil if it already is.
+func registerMessageInCodec(path string, m *Message, goPkg string) ([]byte, error) {
+	f, err := parseGoFile(path)
+	if err != nil {
+		return nil, err
+	}
+	fn := f.funcDecl("RegisterCodec")
+	if fn == nil || len(fn.Type.Params.List) != 1 || len(fn.Type.Params.List[0].Names) != 1 {
+		return nil, f.notFound("RegisterCodec")
+	}
+	if f.usesIdent(fn, m.Name) {
+		return nil, nil
+	}
+
+	cdc := fn.Type.Params.List[0].Names[0].Name
+	f.insert(fn.Body.Rbrace, fmt.Sprintf("%s.RegisterConcrete(%s{}, \"%s/%s\", nil)\n", cdc, m.Name, m.Module, m.Short))
+	return f.patched()
+}
+
+// registerMessageInHandler returns handler.go with a case for the message
+// and a stub handling it, or nil if the message is already handled.
+func registerMessageInHandler(path string, m *Message, goPkg string) ([]byte, error) {
+	f, err := parseGoFile(path)
+	if err != nil {
+		return nil, err
+	}
+	fn := f.funcDecl("NewHandler")
+	if fn == nil || len(fn.Type.Params.List) != 1 || len(fn.Type.Params.List[0].Names) != 1 {
+		return nil, f.notFound("NewHandler")
+	}
+	if f.usesIdent(fn, m.Name) {
+		return nil, nil
+	}
+	keeper := fn.Type.Params.List[0].Names[0].Name
+
+	var (
+		lit *ast.FuncLit
+		sw  *ast.TypeSwitchStmt
+	)
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			if lit == nil {
+				lit = n
+			}
+		case *ast.TypeSwitchStmt:
+			if sw == nil {
+				sw = n
+			}
+		}
+		return sw == nil
+	})
+	if lit == nil || sw == nil || len(lit.Type.Params.List) != 2 {
+		return nil, f.notFound("the type switch on messages")
+	}
+	ctx := lit.Type.Params.List[0].Names[0].Name
+	msg := lit.Type.Params.List[1].Names[0].Name
+	if assign, ok := sw.Assign.(*ast.AssignStmt); ok && len(assign.Lhs) == 1 {
+		msg = f.text(assign.Lhs[0])
+	}
+
+	// Add the case before the default case, if any.
+	pos := sw.Body.Rbrace
+	for _, stmt := range sw.Body.List {
+		if clause, ok := stmt.(*ast.CaseClause); ok && clause.List == nil {
+			pos = clause.Pos()
+		}
+	}
+	f.insert(pos, fmt.Sprintf("case %s:\nreturn handle%s(%s, %s, %s)\n", m.Name, m.Name, ctx, keeper, msg))
+
+	f.insert(f.file.End(), fmt.Sprintf(`
+
+func handle%[1]s(ctx sdk.Context, k Keeper, msg %[1]s) sdk.Result {
+	// TODO: implement %[1]s.
+	return sdk.Result{}
+}
+`, m.Name))
+	return f.patched()
+}
+
+// registerMessageInREST returns client/rest/rest.go with the route of the
+// message registered, or nil if it already is.
+func registerMessageInREST(path string, m *Message, goPkg string) ([]byte, error) {
+	f, err := parseGoFile(path)
+	if err != nil {
+		return nil, err
+	}
+	fn := f.funcDecl("RegisterRoutes")
+	if fn == nil {
+		return nil, f.notFound("RegisterRoutes")
+	}
+	handlerFn := m.Var + "HandlerFn"
+	if f.usesIdent(fn, handlerFn) {
+		return nil, nil
+	}
+
+	// RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec, kb keys.Keybase)
+	params := paramNames(fn)
+	if len(params) != 4 {
+		return nil, f.notFound("RegisterRoutes(cliCtx, r, cdc, kb)")
+	}
+	f.insert(fn.Body.Rbrace, fmt.Sprintf("%s.HandleFunc(\"/%s/%s\", %s(%s, %s, %s)).Methods(\"POST\")\n",
+		params[1], m.Module, m.Command, handlerFn, params[2], params[3], params[0]))
+	return f.patched()
+}
+
+// registerMessageInCLI returns the main.go of the CLI with the command of
+// the message added to the tx commands of the module, or nil if it already
+// is.
+func registerMessageInCLI(path string, m *Message, goPkg string) ([]byte, error) {
+	f, err := parseGoFile(path)
+	if err != nil {
+		return nil, err
+	}
+	main := f.funcDecl("main")
+	if main == nil {
+		return nil, f.notFound("main")
+	}
+	cmdPkg := f.importName(goPkg + "/x/" + m.Module + "/client/cli")
+	if cmdPkg == "" {
+		return nil, f.notFound("the import of the CLI commands of " + m.Module)
+	}
+	txCmd := m.Short + "TxCmd"
+	if f.usesIdent(main, txCmd) {
+		return nil, nil
+	}
+
+	// <module>TxCmd.AddCommand(client.PostCommands(...)...)
+	var post *ast.CallExpr
+	findCall(main.Body, "AddCommand", func(c *ast.CallExpr) bool {
+		sel, ok := c.Fun.(*ast.SelectorExpr)
+		if !ok || f.text(sel.X) != m.Module+"TxCmd" || len(c.Args) != 1 {
+			return false
+		}
+		post, ok = c.Args[0].(*ast.CallExpr)
+		return ok && callName(post) == "PostCommands"
+	})
+	if post == nil {
+		return nil, f.notFound(fmt.Sprintf("the tx commands of %s (%sTxCmd)", m.Module, m.Module))
+	}
+	cdc := "cdc"
+	if len(post.Args) > 0 {
+		if call, ok := post.Args[0].(*ast.CallExpr); ok && len(call.Args) == 1 {
+			cdc = f.text(call.Args[0])
+		}
+	}
+	f.appendArg(post, fmt.Sprintf("%s.%s(%s)", cmdPkg, txCmd, cdc))
+	return f.patched()
+}
+
+// usesIdent returns true if the identifier name appears within node.
+func (f *goFile) usesIdent(node ast.Node, name string) bool {
+	found := false
+	ast.Inspect(node, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok && id.Name == name {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// paramNames returns the names of the parameters of a function.
+func paramNames(fn *ast.FuncDecl) []string {
+	names := []string{}
+	for _, field := range fn.Type.Params.List {
+		for _, name := range field.Names {
+			names = append(names, name.Name)
+		}
+	}
+	return names
+}
+
+// splitWords splits a CamelCase or snake_case name into lowercase words.
+func splitWords(s string) []string {
+	words := []string{}
+	runes := []rune(s)
+	start := 0
+	for i := 0; i <= len(runes); i++ {
+		switch {
+		case i == len(runes) || runes[i] == '_':
+		case i > start && unicode.IsUpper(runes[i]) &&
+			(!unicode.IsUpper(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))):
+		default:
+			continue
+		}
+		if i > start {
+			words = append(words, strings.ToLower(string(runes[start:i])))
+		}
+		start = i
+		if i < len(runes) && runes[i] == '_' {
+			start = i + 1
+		}
+	}
+	return words
+}
+
+func upperCamel(words []string) string {
+	s := ""
+	for _, w := range words {
+		s += strings.Title(w)
+	}
+	return s
+}
+
+func lowerCamel(words []string) string {
+	s := upperCamel(words)
+	if s == "" {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
@@ -0,0 +1,273 @@
+package scaffold
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestSplitWords(t *testing.T) {
+	tests := []struct {
+		in       string
+		expected []string
+	}{
+		{"BuyName", []string{"buy", "name"}},
+		{"buyName", []string{"buy", "name"}},
+		{"buy_name", []string{"buy", "name"}},
+		{"max_Price", []string{"max", "price"}},
+		{"Set", []string{"set"}},
+		{"SetHTTPURL", []string{"set", "httpurl"}},
+		{"HTTPServer", []string{"http", "server"}},
+		{"GetID", []string{"get", "id"}},
+		{"Vote2Proposal", []string{"vote2", "proposal"}},
+		{"a__b_", []string{"a", "b"}},
+		{"", []string{}},
+	}
+	for _, tt := range tests {
+		if words := splitWords(tt.in); !reflect.DeepEqual(words, tt.expected) {
+			t.Errorf("%q: got %q, expected %q", tt.in, words, tt.expected)
+		}
+	}
+}
+
+func TestNewMessage(t *testing.T) {
+	tests := []struct {
+		name     string
+		msg      string
+		fields   []string
+		expected *Message // nil if the message must be rejected
+	}{
+		{
+			name: "with the Msg prefix",
+			msg:  "MsgBuyName",
+			fields: []string{
+				"name:string",
+				"max_price:coins",
+				"buyer:address",
+				"isFinal:bool",
+				"count:int",
+				"limit:uint",
+			},
+			expected: &Message{
+				Module:  "names",
+				Name:    "MsgBuyName",
+				Short:   "BuyName",
+				Var:     "buyName",
+				Type:    "buy_name",
+				Command: "buy-name",
+				Fields: []*Field{
+					{Name: "Name", Arg: "name", JSON: "name", Kind: "string", Type: "string"},
+					{Name: "MaxPrice", Arg: "maxPrice", JSON: "max_price", Kind: "coins", Type: "sdk.Coins"},
+					{Name: "Buyer", Arg: "buyer", JSON: "buyer", Kind: "address", Type: "sdk.AccAddress"},
+					{Name: "IsFinal", Arg: "isFinal", JSON: "is_final", Kind: "bool", Type: "bool"},
+					{Name: "Count", Arg: "count", JSON: "count", Kind: "int", Type: "int64"},
+					{Name: "Limit", Arg: "limit", JSON: "limit", Kind: "uint", Type: "uint64"},
+				},
+			},
+		},
+		{
+			name: "without the Msg prefix",
+			msg:  "SetName",
+			expected: &Message{
+				Module:  "names",
+				Name:    "MsgSetName",
+				Short:   "SetName",
+				Var:     "setName",
+				Type:    "set_name",
+				Command: "set-name",
+			},
+		},
+		{
+			name: "acronyms",
+			msg:  "MsgSetHTTPServer",
+			expected: &Message{
+				Module:  "names",
+				Name:    "MsgSetHTTPServer",
+				Short:   "SetHTTPServer",
+				Var:     "setHttpServer",
+				Type:    "set_http_server",
+				Command: "set-http-server",
+			},
+		},
+		{name: "lower case", msg: "buyName"},
+		{name: "snake case", msg: "Buy_name"},
+		{name: "Msg alone", msg: "Msg"},
+		{name: "empty", msg: ""},
+		{name: "missing type", msg: "MsgBuyName", fields: []string{"name"}},
+		{name: "unknown type", msg: "MsgBuyName", fields: []string{"name:float"}},
+		{name: "capitalized field", msg: "MsgBuyName", fields: []string{"Name:string"}},
+		{name: "keyword", msg: "MsgBuyName", fields: []string{"type:string"}},
+		{name: "duplicate field", msg: "MsgBuyName", fields: []string{"max_price:coins", "maxPrice:coins"}},
+		{name: "sender field", msg: "MsgBuyName", fields: []string{"sender:address"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := NewMessage("names", tt.msg, tt.fields)
+			if tt.expected == nil {
+				if err == nil {
+					t.Fatalf("expected an error, got %+v", m)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(m, tt.expected) {
+				t.Fatalf("got %+v, expected %+v", m, tt.expected)
+			}
+		})
+	}
+}
+
+const (
+	codecFixture = `package names
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers the messages of the module.
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgSet{}, "names/Set", nil)
+}
+`
+	handlerFixture = `package names
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewHandler returns the handler of the messages of the module.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		switch msg := msg.(type) {
+		case MsgSet:
+			return handleMsgSet(ctx, k, msg)
+		default:
+			errMsg := fmt.Sprintf("Unrecognized names Msg type: %v", msg.Type())
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgSet(ctx sdk.Context, k Keeper, msg MsgSet) sdk.Result {
+	return sdk.Result{}
+}
+`
+)
+
+func TestRegisterMessage(t *testing.T) {
+	dir, err := ioutil.TempDir("", "chainkit-message")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	m, err := NewMessage("names", "MsgBuyName", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		fixture  string
+		patch    func(path string, m *Message, goPkg string) ([]byte, error)
+		expected string
+	}{
+		{
+			name:    "codec",
+			fixture: codecFixture,
+			patch:   registerMessageInCodec,
+			expected: `package names
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers the messages of the module.
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgSet{}, "names/Set", nil)
+	cdc.RegisterConcrete(MsgBuyName{}, "names/BuyName", nil)
+}
+`,
+		},
+		{
+			name:    "handler",
+			fixture: handlerFixture,
+			patch:   registerMessageInHandler,
+			expected: `package names
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewHandler returns the handler of the messages of the module.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		switch msg := msg.(type) {
+		case MsgSet:
+			return handleMsgSet(ctx, k, msg)
+		case MsgBuyName:
+			return handleMsgBuyName(ctx, k, msg)
+		default:
+			errMsg := fmt.Sprintf("Unrecognized names Msg type: %v", msg.Type())
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgSet(ctx sdk.Context, k Keeper, msg MsgSet) sdk.Result {
+	return sdk.Result{}
+}
+
+func handleMsgBuyName(ctx sdk.Context, k Keeper, msg MsgBuyName) sdk.Result {
+	// TODO: implement MsgBuyName.
+	return sdk.Result{}
+}
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(dir, tt.name+".go")
+			if err := ioutil.WriteFile(path, []byte(tt.fixture), 0644); err != nil {
+				t.Fatal(err)
+			}
+			out, err := tt.patch(path, m, "github.com/example/names")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(out) != tt.expected {
+				t.Fatalf("got:\n%s\nexpected:\n%s", out, tt.expected)
+			}
+
+			// Patching again does nothing.
+			if err := ioutil.WriteFile(path, out, 0644); err != nil {
+				t.Fatal(err)
+			}
+			if out, err := tt.patch(path, m, "github.com/example/names"); err != nil || out != nil {
+				t.Fatalf("got %q, %v when already registered", out, err)
+			}
+		})
+	}
+
+	// Sources not generated by chainkit are reported.
+	path := filepath.Join(dir, "other.go")
+	if err := ioutil.WriteFile(path, []byte("package names\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := registerMessageInCodec(path, m, "github.com/example/names"); err == nil {
+		t.Error("expected an error without RegisterCodec")
+	}
+	if _, err := registerMessageInHandler(path, m, "github.com/example/names"); err == nil {
+		t.Error("expected an error without NewHandler")
+	}
+}
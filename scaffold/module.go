@@ -0,0 +1,460 @@
+package scaffold
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/blocklayerhq/chainkit/httpfs"
+	"github.com/blocklayerhq/chainkit/project"
+	"github.com/blocklayerhq/chainkit/templates"
+	"github.com/pkg/errors"
+)
+
+// moduleTemplate is the built-in template whose x/ directory is the
+// skeleton of generated modules.
+const moduleTemplate = "module"
+
+// ModuleOriginalsDir is the directory of an application where the files of
+// the generated modules are kept as they were generated. Unlike OriginalsDir,
+// it isn't touched by Update.
+const ModuleOriginalsDir = ".scaffold-modules"
+
+var moduleNameRe = regexp.MustCompile(`^[a-z][a-z0-9]*$`)
+
+// AddModule generates a module under x/<name> in the application at
+// rootDir, and registers it in app.go and in the CLI. It returns the files
+// it created or changed.
+//
+// Existing files are never overwritten and a module is only registered
+// once, so generating a module again only restores its missing files. It
+// fails without changing anything if x/<name> holds files which weren't
+// generated by chainkit.
+func AddModule(rootDir string, p *project.Project, name string) ([]string, error) {
+	if !moduleNameRe.MatchString(name) || token.Lookup(name).IsKeyword() {
+		return nil, fmt.Errorf("invalid module name %q: expected lowercase letters and digits", name)
+	}
+
+	goPkg, err := modulePath(rootDir)
+	if err != nil {
+		return nil, err
+	}
+
+	appFile := "app.go"
+	cliFile := filepath.Join("cmd", p.Binaries.CLI, "main.go")
+	if err := checkModuleSupport(rootDir, cliFile, p); err != nil {
+		return nil, err
+	}
+	app, err := parseGoFile(filepath.Join(rootDir, appFile))
+	if err != nil {
+		return nil, err
+	}
+	registered := app.imports(goPkg + "/x/" + name)
+
+	ctx := &Context{
+		Name:         p.Name,
+		RootDir:      rootDir,
+		GoPkg:        goPkg,
+		SDKVersion:   sdkVersion(p),
+		Bech32Prefix: p.AddressPrefix(),
+		Denom:        p.CoinDenom(),
+		Vars:         map[string]string{"module": name},
+	}
+
+	changes := map[string][]byte{}
+	originals := map[string][]byte{}
+	conflicts := []string{}
+
+	fs := &subFS{fs: templates.Assets, dir: "/" + moduleTemplate}
+	err = httpfs.Walk(fs, "/x", func(src string, fi os.FileInfo, err error) error {
+		if err != nil || fi.IsDir() {
+			return err
+		}
+		dst, data, err := renderFile(ctx, fs, src, fi)
+		if err != nil {
+			return err
+		}
+		existing, err := ioutil.ReadFile(filepath.Join(rootDir, dst))
+		switch {
+		case os.IsNotExist(err):
+			changes[dst] = data
+			originals[dst] = data
+		case err != nil:
+			return err
+		case bytes.Equal(existing, data):
+			originals[dst] = data
+		case !registered && !isModuleOriginal(rootDir, dst):
+			// Not generated by chainkit: the module would be mixed with
+			// another package.
+			conflicts = append(conflicts, strings.TrimPrefix(dst, "/"))
+		}
+		// Other existing files were generated before and are kept as is,
+		// whether they were changed since or not.
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(conflicts) > 0 {
+		return nil, fmt.Errorf("not overwriting files which weren't generated by chainkit: %s", strings.Join(conflicts, ", "))
+	}
+
+	data, err := registerModuleInApp(filepath.Join(rootDir, appFile), goPkg, name)
+	if err != nil {
+		return nil, err
+	}
+	if data != nil {
+		changes[appFile] = data
+	}
+
+	data, err = registerModuleInCLI(filepath.Join(rootDir, cliFile), goPkg, name)
+	if err != nil {
+		return nil, err
+	}
+	if data != nil {
+		changes[cliFile] = data
+	}
+
+	// Applications with a REST server register their routes in the
+	// registerRoutes function of the CLI.
+	restFile, err := findFunc(filepath.Join(rootDir, "cmd", p.Binaries.CLI), "registerRoutes")
+	if err != nil {
+		return nil, err
+	}
+	if restFile != "" {
+		data, err = registerModuleInREST(restFile, goPkg, name)
+		if err != nil {
+			return nil, err
+		}
+		if data != nil {
+			rel, err := filepath.Rel(rootDir, restFile)
+			if err != nil {
+				return nil, err
+			}
+			changes[rel] = data
+		}
+	}
+
+	paths, err := writeChanges(rootDir, changes)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := writeChanges(filepath.Join(rootDir, ModuleOriginalsDir), originals); err != nil {
+		return nil, errors.Wrap(err, "unable to save the original files of the module")
+	}
+	return paths, nil
+}
+
+// isModuleOriginal returns true if the file of a module was generated by
+// chainkit, i.e. it is kept in ModuleOriginalsDir.
+func isModuleOriginal(rootDir, path string) bool {
+	_, err := os.Stat(filepath.Join(rootDir, ModuleOriginalsDir, path))
+	return err == nil
+}
+
+// checkModuleSupport fails if modules can't be registered in the CLI of the
+// application, whose query and transaction commands they extend.
+func checkModuleSupport(rootDir, cliFile string, p *project.Project) error {
+	f, err := parseGoFile(filepath.Join(rootDir, cliFile))
+	if err != nil {
+		return err
+	}
+	if main := f.funcDecl("main"); main != nil {
+		if _, stmt := findCall(main.Body, "AddCommand", isQueryAndTxRegistration); stmt != nil {
+			return nil
+		}
+	}
+
+	template := "this template"
+	if p.Scaffold != nil && p.Scaffold.Template != "" {
+		template = fmt.Sprintf("the %s template", p.Scaffold.Template)
+	}
+	return fmt.Errorf("modules can't be generated in applications of %s: their CLI has no transaction commands (%s doesn't register txCmd), use the bank, rest or staking template instead",
+		template, cliFile)
+}
+
+// isQueryAndTxRegistration matches the call registering the query and
+// transaction commands of the CLI.
+func isQueryAndTxRegistration(c *ast.CallExpr) bool {
+	return hasIdentArg(c, "queryCmd") && hasIdentArg(c, "txCmd")
+}
+
+// writeChanges writes files relative to rootDir. It returns their sorted
+// paths.
+func writeChanges(rootDir string, changes map[string][]byte) ([]string, error) {
+	paths := []string{}
+	for p, data := range changes {
+		dst := filepath.Join(rootDir, p)
+		if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+			return nil, err
+		}
+		if err := ioutil.WriteFile(dst, data, 0644); err != nil {
+			return nil, errors.Wrap(err, "unable to write to destination")
+		}
+		paths = append(paths, strings.TrimPrefix(p, "/"))
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// modulePath returns the Go module path of the application at rootDir.
+func modulePath(rootDir string) (string, error) {
+	f, err := os.Open(filepath.Join(rootDir, "go.mod"))
+	if os.IsNotExist(err) {
+		return "", fmt.Errorf("go.mod not found in %s: only Go module applications are supported", rootDir)
+	}
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && fields[0] == "module" {
+			return strings.Trim(fields[1], `"`), nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return "", fmt.Errorf("no module path in %s", f.Name())
+}
+
+// registerModuleInApp returns app.go with the module registered, or nil if
+// it already is.
+func registerModuleInApp(path, goPkg, name string) ([]byte, error) {
+	f, err := parseGoFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	importPath := goPkg + "/x/" + name
+	if f.imports(importPath) {
+		return nil, nil
+	}
+	if err := f.checkImportName(name); err != nil {
+		return nil, err
+	}
+	sdk := f.importName("github.com/cosmos/cosmos-sdk/types")
+	if sdk == "" {
+		return nil, f.notFound("the import of the SDK types")
+	}
+
+	// Add the store key and the keeper to the application.
+	fields := f.appStruct()
+	if fields == nil {
+		return nil, f.notFound("the application struct")
+	}
+	title := strings.Title(name)
+	f.insert(fields.Closing, fmt.Sprintf("\nkey%s *%s.KVStoreKey\n%sKeeper %s.Keeper\n", title, sdk, name, name))
+
+	// Create them, and route the messages and queries of the module.
+	var (
+		mount *ast.CallExpr
+		stmt  ast.Stmt
+	)
+	for _, decl := range f.file.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Body != nil {
+			if mount, stmt = findCall(fn.Body, "MountStoresIAVL", nil); mount != nil {
+				break
+			}
+		}
+	}
+	if mount == nil {
+		return nil, f.notFound("the call to MountStoresIAVL")
+	}
+	sel, ok := mount.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return nil, f.notFound("the call to MountStoresIAVL")
+	}
+	app := f.text(sel.X)
+	f.insert(stmt.Pos(), fmt.Sprintf(`%[1]s.key%[2]s = %[3]s.NewKVStoreKey(%[4]q)
+%[1]s.%[4]sKeeper = %[4]s.NewKeeper(%[1]s.key%[2]s, %[1]s.cdc)
+
+%[1]s.Router().
+	AddRoute(%[4]s.RouterKey, %[4]s.NewHandler(%[1]s.%[4]sKeeper))
+%[1]s.QueryRouter().
+	AddRoute(%[4]s.RouterKey, %[4]s.NewQuerier(%[1]s.%[4]sKeeper))
+
+`, app, title, sdk, name))
+	f.appendArg(mount, fmt.Sprintf("%s.key%s", app, title))
+
+	// Register its messages.
+	ret := f.lastReturn("MakeCodec")
+	if ret == nil {
+		return nil, f.notFound("MakeCodec")
+	}
+	f.insert(ret.Pos(), fmt.Sprintf("%s.RegisterCodec(%s)\n", name, f.text(ret.Results[0])))
+
+	if err := f.addImport("", importPath); err != nil {
+		return nil, err
+	}
+	return f.patched()
+}
+
+// registerModuleInCLI returns the main.go of the CLI with the commands of
+// the module registered, or nil if they already are.
+func registerModuleInCLI(path, goPkg, name string) ([]byte, error) {
+	f, err := parseGoFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	importPath := goPkg + "/x/" + name + "/client/cli"
+	if f.imports(importPath) {
+		return nil, nil
+	}
+	cmdPkg := name + "cmd"
+	if err := f.checkImportName(cmdPkg); err != nil {
+		return nil, err
+	}
+	client := f.importName("github.com/cosmos/cosmos-sdk/client")
+	if client == "" {
+		return nil, f.notFound("the import of the SDK client")
+	}
+
+	main := f.funcDecl("main")
+	if main == nil {
+		return nil, f.notFound("main")
+	}
+	_, stmt := findCall(main.Body, "AddCommand", isQueryAndTxRegistration)
+	if stmt == nil {
+		return nil, f.notFound("the registration of queryCmd and txCmd")
+	}
+	f.insert(stmt.Pos(), fmt.Sprintf(`%[1]sQueryCmd := &cobra.Command{
+	Use:   %[1]q,
+	Short: "Querying commands for the %[1]s module",
+}
+%[1]sQueryCmd.AddCommand(%[2]s.GetCommands(
+	%[3]s.GetCmd(cdc),
+)...)
+queryCmd.AddCommand(%[1]sQueryCmd)
+
+%[1]sTxCmd := &cobra.Command{
+	Use:   %[1]q,
+	Short: "Transactions commands for the %[1]s module",
+}
+%[1]sTxCmd.AddCommand(%[2]s.PostCommands(
+	%[3]s.SetTxCmd(cdc),
+)...)
+txCmd.AddCommand(%[1]sTxCmd)
+
+`, name, client, cmdPkg))
+
+	if err := f.addImport(cmdPkg, importPath); err != nil {
+		return nil, err
+	}
+	return f.patched()
+}
+
+// registerModuleInREST returns the file declaring the registerRoutes
+// function of the CLI with the routes of the module registered, or nil if
+// they already are.
+func registerModuleInREST(path, goPkg, name string) ([]byte, error) {
+	f, err := parseGoFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	importPath := goPkg + "/x/" + name + "/client/rest"
+	if f.imports(importPath) {
+		return nil, nil
+	}
+	restPkg := name + "rest"
+	if err := f.checkImportName(restPkg); err != nil {
+		return nil, err
+	}
+
+	// registerRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec, kb keybase.Keybase)
+	fn := f.funcDecl("registerRoutes")
+	params := paramNames(fn)
+	if len(params) != 4 {
+		return nil, f.notFound("registerRoutes(cliCtx, r, cdc, kb)")
+	}
+	f.insert(fn.Body.Rbrace, fmt.Sprintf("%s.RegisterRoutes(%s)\n", restPkg, strings.Join(params, ", ")))
+
+	if err := f.addImport(restPkg, importPath); err != nil {
+		return nil, err
+	}
+	return f.patched()
+}
+
+// findFunc returns the Go file of dir declaring the function name, or "".
+func findFunc(dir, name string) (string, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return "", err
+	}
+	for _, p := range paths {
+		f, err := parseGoFile(p)
+		if err != nil {
+			return "", err
+		}
+		if f.funcDecl(name) != nil {
+			return p, nil
+		}
+	}
+	return "", nil
+}
+
+// appStruct returns the fields of the application struct, which embeds the
+// BaseApp.
+func (f *goFile) appStruct() *ast.FieldList {
+	var fields *ast.FieldList
+	ast.Inspect(f.file, func(n ast.Node) bool {
+		st, ok := n.(*ast.StructType)
+		if !ok || fields != nil {
+			return fields == nil
+		}
+		for _, field := range st.Fields.List {
+			star, ok := field.Type.(*ast.StarExpr)
+			if !ok {
+				continue
+			}
+			if sel, ok := star.X.(*ast.SelectorExpr); ok && sel.Sel.Name == "BaseApp" && len(field.Names) == 0 {
+				fields = st.Fields
+			}
+		}
+		return true
+	})
+	return fields
+}
+
+// lastReturn returns the final return statement of the function name, if
+// it returns a single value.
+func (f *goFile) lastReturn(name string) *ast.ReturnStmt {
+	fn := f.funcDecl(name)
+	if fn == nil || len(fn.Body.List) == 0 {
+		return nil
+	}
+	ret, ok := fn.Body.List[len(fn.Body.List)-1].(*ast.ReturnStmt)
+	if !ok || len(ret.Results) != 1 {
+		return nil
+	}
+	return ret
+}
+
+// checkImportName fails if name is already used by an import.
+func (f *goFile) checkImportName(name string) error {
+	for _, spec := range f.file.Imports {
+		p := strings.Trim(spec.Path.Value, `"`)
+		if f.importName(p) == name {
+			return fmt.Errorf("%s already imports %s as %s", f.path, p, name)
+		}
+	}
+	return nil
+}
+
+// text returns the source of a node.
+func (f *goFile) text(n ast.Node) string {
+	return string(f.src[f.fset.Position(n.Pos()).Offset:f.fset.Position(n.End()).Offset])
+}
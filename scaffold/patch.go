@@ -0,0 +1,201 @@
+package scaffold
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"sort"
+	"strconv"
+)
+
+// goFile is a Go source file being patched. Edits are insertions of text
+// at offsets of the original source, applied all at once.
+type goFile struct {
+	path  string
+	src   []byte
+	fset  *token.FileSet
+	file  *ast.File
+	edits []edit
+}
+
+type edit struct {
+	offset int
+	text   string
+}
+
+func parseGoFile(path string) (*goFile, error) {
+	src, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	return &goFile{
+		path: path,
+		src:  src,
+		fset: fset,
+		file: file,
+	}, nil
+}
+
+// insert inserts text at pos.
+func (f *goFile) insert(pos token.Pos, text string) {
+	f.edits = append(f.edits, edit{
+		offset: f.fset.Position(pos).Offset,
+		text:   text,
+	})
+}
+
+// imports returns true if the file imports importPath.
+func (f *goFile) imports(importPath string) bool {
+	for _, spec := range f.file.Imports {
+		if p, err := strconv.Unquote(spec.Path.Value); err == nil && p == importPath {
+			return true
+		}
+	}
+	return false
+}
+
+// importName returns the name the file imports importPath with, or "".
+func (f *goFile) importName(importPath string) string {
+	for _, spec := range f.file.Imports {
+		p, err := strconv.Unquote(spec.Path.Value)
+		if err != nil || p != importPath {
+			continue
+		}
+		if spec.Name != nil {
+			return spec.Name.Name
+		}
+		return lastElem(p)
+	}
+	return ""
+}
+
+// addImport imports importPath, with name if not empty.
+func (f *goFile) addImport(name, importPath string) error {
+	for _, decl := range f.file.Decls {
+		d, ok := decl.(*ast.GenDecl)
+		if !ok || d.Tok != token.IMPORT || !d.Rparen.IsValid() {
+			continue
+		}
+		f.insert(d.Rparen, fmt.Sprintf("%s %q\n", name, importPath))
+		return nil
+	}
+	return f.notFound("the imports")
+}
+
+// appendArg appends an argument to a call.
+func (f *goFile) appendArg(call *ast.CallExpr, arg string) {
+	// Keep multi-line calls multi-line.
+	before := bytes.TrimRight(f.src[:f.fset.Position(call.Rparen).Offset], " \t\n")
+	switch {
+	case len(call.Args) == 0:
+		f.insert(call.Rparen, arg)
+	case bytes.HasSuffix(before, []byte(",")):
+		f.insert(call.Rparen, arg+",\n")
+	default:
+		f.insert(call.Rparen, ", "+arg)
+	}
+}
+
+// notFound is the error returned when the file doesn't have the expected
+// layout.
+func (f *goFile) notFound(what string) error {
+	return fmt.Errorf("unable to find %s in %s", what, f.path)
+}
+
+// patched returns the source of the file with the edits applied.
+func (f *goFile) patched() ([]byte, error) {
+	edits := append([]edit{}, f.edits...)
+	sort.SliceStable(edits, func(i, j int) bool {
+		return edits[i].offset < edits[j].offset
+	})
+
+	var buf bytes.Buffer
+	last := 0
+	for _, e := range edits {
+		buf.Write(f.src[last:e.offset])
+		buf.WriteString(e.text)
+		last = e.offset
+	}
+	buf.Write(f.src[last:])
+
+	out, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("unable to patch %s: %v", f.path, err)
+	}
+	return out, nil
+}
+
+// findCall returns the first call to a function or method named name, for
+// which match (if not nil) returns true, within the statements of body. It
+// also returns the statement of body the call belongs to.
+func findCall(body *ast.BlockStmt, name string, match func(*ast.CallExpr) bool) (*ast.CallExpr, ast.Stmt) {
+	for _, stmt := range body.List {
+		var call *ast.CallExpr
+		ast.Inspect(stmt, func(n ast.Node) bool {
+			c, ok := n.(*ast.CallExpr)
+			if call != nil || !ok {
+				return call == nil
+			}
+			if callName(c) == name && (match == nil || match(c)) {
+				call = c
+				return false
+			}
+			return true
+		})
+		if call != nil {
+			return call, stmt
+		}
+	}
+	return nil, nil
+}
+
+// callName returns the name of the function or method called.
+func callName(c *ast.CallExpr) string {
+	switch fun := c.Fun.(type) {
+	case *ast.Ident:
+		return fun.Name
+	case *ast.SelectorExpr:
+		return fun.Sel.Name
+	}
+	return ""
+}
+
+// hasIdentArg returns true if one of the arguments of the call is the
+// identifier name.
+func hasIdentArg(c *ast.CallExpr, name string) bool {
+	for _, arg := range c.Args {
+		if id, ok := arg.(*ast.Ident); ok && id.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// funcDecl returns the function declared with name.
+func (f *goFile) funcDecl(name string) *ast.FuncDecl {
+	for _, decl := range f.file.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil && fn.Name.Name == name {
+			return fn
+		}
+	}
+	return nil
+}
+
+// lastElem returns the last element of an import path.
+func lastElem(importPath string) string {
+	for i := len(importPath) - 1; i >= 0; i-- {
+		if importPath[i] == '/' {
+			return importPath[i+1:]
+		}
+	}
+	return importPath
+}
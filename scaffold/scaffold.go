@@ -0,0 +1,332 @@
+// Package scaffold creates applications from the chainkit templates.
+package scaffold
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/blocklayerhq/chainkit/httpfs"
+	"github.com/blocklayerhq/chainkit/project"
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultSDKVersion is the version of the Cosmos SDK applications use
+	// unless specified.
+	DefaultSDKVersion = "v0.26.0"
+
+	// legacySDKVersion has the bech32 prefixes of the Cosmos SDK built-in.
+	legacySDKVersion = "v0.25.0"
+)
+
+// SDKVersions are the versions of the Cosmos SDK the built-in templates
+// support, newest first.
+var SDKVersions = []string{DefaultSDKVersion, legacySDKVersion}
+
+// Context is the data the templates are rendered with.
+type Context struct {
+	Name    string
+	RootDir string
+	GoPkg   string
+	// SDKVersion is the version of the Cosmos SDK (e.g. v0.26.0).
+	SDKVersion string
+	// Bech32Prefix is the prefix of the account addresses (e.g. cosmos).
+	// Validator and consensus addresses append valoper and valcons to it.
+	Bech32Prefix string
+	// Denom is the denomination of the coins of the application.
+	Denom string
+	// Vars are the values of the variables of the template.
+	Vars map[string]string
+	// Message is the message rendered by the message generator.
+	Message *Message
+}
+
+// Options configures the creation of an application.
+type Options struct {
+	// GoPkg is the Go module path of the application.
+	GoPkg string
+	// Template is the template the application is created from. Defaults to
+	// the DefaultTemplate.
+	Template *Template
+	// Vars are the values of the variables of the template. Variables left
+	// out get their default value.
+	Vars map[string]string
+	// SDKVersion is one of the SDKVersions. Defaults to the
+	// DefaultSDKVersion.
+	SDKVersion string
+	// Bech32Prefix defaults to the bech32 prefix of the project.
+	Bech32Prefix string
+	// Denom defaults to the denomination of the project.
+	Denom string
+}
+
+// setDefaults fills in the options left out from the project and
+// validates them.
+func (opts *Options) setDefaults(p *project.Project) error {
+	if opts.SDKVersion == "" {
+		opts.SDKVersion = DefaultSDKVersion
+	}
+	if opts.Bech32Prefix == "" {
+		opts.Bech32Prefix = p.AddressPrefix()
+	}
+	if opts.Denom == "" {
+		opts.Denom = p.CoinDenom()
+	}
+
+	if err := ValidateSDKVersion(opts.SDKVersion); err != nil {
+		return err
+	}
+	if err := ValidateBech32Prefix(opts.Bech32Prefix, opts.SDKVersion); err != nil {
+		return err
+	}
+	return project.ValidateDenom(opts.Denom)
+}
+
+// ValidateSDKVersion checks the templates support a version of the Cosmos
+// SDK.
+func ValidateSDKVersion(version string) error {
+	for _, v := range SDKVersions {
+		if v == version {
+			return nil
+		}
+	}
+	return fmt.Errorf("unsupported Cosmos SDK version %q (supported: %s)", version, strings.Join(SDKVersions, ", "))
+}
+
+// ValidateBech32Prefix checks a bech32 prefix can be used with a version of
+// the Cosmos SDK.
+func ValidateBech32Prefix(prefix, sdkVersion string) error {
+	if err := project.ValidateBech32Prefix(prefix); err != nil {
+		return err
+	}
+	if sdkVersion == legacySDKVersion && prefix != project.DefaultBech32Prefix {
+		return fmt.Errorf("the Cosmos SDK %s only supports the %q bech32 prefix", legacySDKVersion, project.DefaultBech32Prefix)
+	}
+	return nil
+}
+
+// SupportsBech32Prefix returns true if the bech32 prefix can be changed with
+// a version of the Cosmos SDK.
+func SupportsBech32Prefix(sdkVersion string) bool {
+	return sdkVersion != legacySDKVersion
+}
+
+// sdkVersion returns the version of the Cosmos SDK of an application.
+// Applications created before it was recorded use the SDK v0.25.0.
+func sdkVersion(p *project.Project) string {
+	if p.Scaffold == nil || p.Scaffold.SDKVersion == "" {
+		return legacySDKVersion
+	}
+	return p.Scaffold.SDKVersion
+}
+
+// Create creates the application of a project in rootDir, which must not
+// exist.
+func Create(rootDir string, p *project.Project, opts Options) error {
+	// Make sure the destination path doesn't exist.
+	if _, err := os.Stat(rootDir); !os.IsNotExist(err) {
+		return fmt.Errorf("destination path %q already exists", rootDir)
+	}
+
+	t := opts.Template
+	if t == nil {
+		var err error
+		t, err = Load(context.Background(), DefaultTemplate)
+		if err != nil {
+			return err
+		}
+		defer t.Close()
+	}
+
+	if err := opts.setDefaults(p); err != nil {
+		return err
+	}
+	if err := t.ValidateSDKVersion(opts.SDKVersion); err != nil {
+		return err
+	}
+	vars, err := resolveVars(t, opts.Vars)
+	if err != nil {
+		return err
+	}
+
+	ctx := &Context{
+		Name:         p.Name,
+		RootDir:      rootDir,
+		GoPkg:        opts.GoPkg,
+		SDKVersion:   opts.SDKVersion,
+		Bech32Prefix: opts.Bech32Prefix,
+		Denom:        opts.Denom,
+		Vars:         vars,
+	}
+	files, err := render(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range files {
+		if err := f.write(rootDir); err != nil {
+			return err
+		}
+	}
+	if err := saveOriginals(rootDir, files); err != nil {
+		return err
+	}
+
+	// Save the project manifest on disk
+	p.Bech32Prefix = opts.Bech32Prefix
+	p.Denom = opts.Denom
+	p.Scaffold = &project.ScaffoldConfig{
+		Template:   t.Source,
+		Version:    t.Version,
+		GoPkg:      opts.GoPkg,
+		SDKVersion: opts.SDKVersion,
+		Vars:       vars,
+	}
+	if err := p.Save(path.Join(rootDir, "chainkit.yml")); err != nil {
+		return errors.Wrap(err, "Failed to create chainkit.yml")
+	}
+	return nil
+}
+
+// resolveVars returns the values of the variables of a template.
+func resolveVars(t *Template, values map[string]string) (map[string]string, error) {
+	vars := map[string]string{}
+	for _, v := range t.Variables() {
+		vars[v.Name] = v.Default
+	}
+	for k, v := range values {
+		if _, ok := vars[k]; !ok {
+			return nil, fmt.Errorf("template %q has no variable %q", t.Source, k)
+		}
+		vars[k] = v
+	}
+	return vars, nil
+}
+
+// file is a file or directory rendered from a template.
+type file struct {
+	// path is relative to the root of the application, starting with "/".
+	path string
+	data []byte
+	mode os.FileMode
+}
+
+// render renders the files of a template, parents first. Files of a
+// template replace the files of its base.
+func render(ctx *Context, t *Template) ([]*file, error) {
+	files := []*file{}
+	index := map[string]int{}
+	for _, fs := range t.layers() {
+		err := httpfs.Walk(fs, "/", func(src string, fi os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			switch {
+			case src == "/"+manifestFile:
+				return nil
+			case fi.IsDir() && fi.Name() == ".git":
+				return filepath.SkipDir
+			}
+
+			dst, data, err := renderFile(ctx, fs, src, fi)
+			if err != nil {
+				return err
+			}
+			if !fi.IsDir() && data == nil {
+				data = []byte{}
+			}
+			f := &file{path: dst, data: data, mode: fi.Mode()}
+			if i, ok := index[dst]; ok {
+				files[i] = f
+				return nil
+			}
+			index[dst] = len(files)
+			files = append(files, f)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+// write writes the file within rootDir.
+func (f *file) write(rootDir string) error {
+	dstPath := path.Join(rootDir, f.path)
+	if f.mode.IsDir() {
+		return os.MkdirAll(dstPath, f.mode.Perm())
+	}
+	if err := ioutil.WriteFile(dstPath, f.data, f.mode.Perm()); err != nil {
+		return errors.Wrap(err, "unable to write to destination")
+	}
+	return nil
+}
+
+// renderFile renders a template file. It returns the path of the file
+// within the application and its contents (nil for directories).
+func renderFile(ctx *Context, fs http.FileSystem, src string, fi os.FileInfo) (string, []byte, error) {
+	// Templatize the file name.
+	parsedSrc, err := templatize(ctx, src, src)
+	if err != nil {
+		return "", nil, err
+	}
+
+	dst := string(parsedSrc)
+	if fi.IsDir() {
+		return dst, nil, nil
+	}
+
+	data, err := httpfs.ReadFile(fs, src)
+	if err != nil {
+		return "", nil, errors.Wrap(err, "unable to read template file")
+	}
+
+	// Handle templates
+	if filepath.Ext(dst) == ".tmpl" {
+		// Parse template
+		data, err = templatize(ctx, dst, string(data))
+		if err != nil {
+			return "", nil, errors.Wrap(err, "unable to templetaize")
+		}
+
+		// Remove .tpl from the file path
+		dst = strings.TrimSuffix(dst, ".tmpl")
+
+		// Variables may change the length of identifiers.
+		if filepath.Ext(dst) == ".go" {
+			data, err = format.Source(data)
+			if err != nil {
+				return "", nil, errors.Wrapf(err, "unable to format %s", dst)
+			}
+		}
+	}
+
+	return dst, data, nil
+}
+
+// funcs are the functions available to the templates.
+var funcs = template.FuncMap{
+	"title": strings.Title,
+}
+
+func templatize(ctx *Context, name, input string) ([]byte, error) {
+	t, err := template.New(name).Funcs(funcs).Parse(input)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, ctx); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,313 @@
+package scaffold
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/blocklayerhq/chainkit/httpfs"
+	"github.com/blocklayerhq/chainkit/templates"
+	"github.com/blocklayerhq/chainkit/util"
+	"github.com/blocklayerhq/chainkit/version"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// DefaultTemplate is the built-in template used when none is specified.
+	DefaultTemplate = "bank"
+
+	// manifestFile describes a template. It is not extracted.
+	manifestFile = "template.yml"
+
+	// maxBaseDepth bounds the chain of base templates.
+	maxBaseDepth = 8
+)
+
+// Variable is a value a template can be customized with. Templates access
+// it as {{ .Vars.<name> }}.
+type Variable struct {
+	Name string `yaml:"name"`
+	// Prompt is asked to the user when the value isn't provided.
+	Prompt string `yaml:"prompt,omitempty"`
+	// Default is used when the value isn't provided nor prompted for.
+	Default string `yaml:"default,omitempty"`
+}
+
+// Manifest describes a template.
+type Manifest struct {
+	Description string `yaml:"description,omitempty"`
+	// Base is the template this one is layered on: files of the template
+	// replace the files of the base with the same path. It is the name of
+	// a built-in template, a git URL or a directory relative to the
+	// template.
+	Base string `yaml:"base,omitempty"`
+	// SDKVersions are the versions of the Cosmos SDK the template supports,
+	// newest first. Defaults to the ones of the base, or to all the
+	// SDKVersions.
+	SDKVersions []string    `yaml:"sdk_versions,omitempty"`
+	Variables   []*Variable `yaml:"variables,omitempty"`
+}
+
+// Template is a source of application files: a built-in template, a local
+// directory or a git repository.
+type Template struct {
+	// Source is the name, absolute directory or URL the template was loaded
+	// from.
+	Source   string
+	Manifest *Manifest
+	// Version identifies the revision of the template: the chainkit version
+	// for built-in templates, the commit for git templates. It is empty for
+	// directories.
+	Version string
+
+	fs      http.FileSystem
+	dir     string
+	base    *Template
+	cleanup func()
+}
+
+// Builtins returns the names of the built-in templates: the directories of
+// the assets with a manifest.
+func Builtins() ([]string, error) {
+	dh, err := templates.Assets.Open("/")
+	if err != nil {
+		return nil, err
+	}
+	defer dh.Close()
+	fis, err := dh.Readdir(-1)
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, fi := range fis {
+		if fi.IsDir() && isBuiltin(fi.Name()) {
+			names = append(names, fi.Name())
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// Load loads a template. source is the name of a built-in template, the
+// path of a local directory or a git URL (optionally followed by
+// #<branch or tag>). The template must be closed after use.
+func Load(ctx context.Context, source string) (*Template, error) {
+	return load(ctx, source, "", 0)
+}
+
+func load(ctx context.Context, source, relDir string, depth int) (*Template, error) {
+	if depth > maxBaseDepth {
+		return nil, fmt.Errorf("too many base templates, is there a cycle?")
+	}
+
+	t := &Template{
+		Source:  source,
+		cleanup: func() {},
+	}
+
+	switch {
+	case isGitURL(source):
+		dir, err := ioutil.TempDir("", "chainkit-template")
+		if err != nil {
+			return nil, err
+		}
+		t.cleanup = func() { os.RemoveAll(dir) }
+		if err := gitClone(ctx, source, dir); err != nil {
+			t.cleanup()
+			return nil, err
+		}
+		commit, err := gitHead(ctx, dir)
+		if err != nil {
+			t.cleanup()
+			return nil, err
+		}
+		t.dir = dir
+		t.fs = http.Dir(dir)
+		t.Version = commit
+	case !strings.ContainsAny(source, `/\.`) && isBuiltin(source):
+		t.fs = &subFS{fs: templates.Assets, dir: "/" + source}
+		t.Version = version.Version
+	case isDir(filepath.Join(relDir, source)):
+		dir, err := filepath.Abs(filepath.Join(relDir, source))
+		if err != nil {
+			return nil, err
+		}
+		t.Source = dir
+		t.dir = dir
+		t.fs = http.Dir(dir)
+	default:
+		return nil, fmt.Errorf("unknown template %q: not a built-in template, a directory nor a git URL", source)
+	}
+
+	manifest, err := readManifest(t.fs)
+	if err != nil {
+		t.Close()
+		return nil, errors.Wrapf(err, "template %q", source)
+	}
+	t.Manifest = manifest
+
+	if manifest.Base != "" {
+		t.base, err = load(ctx, manifest.Base, t.dir, depth+1)
+		if err != nil {
+			t.Close()
+			return nil, errors.Wrapf(err, "base of template %q", source)
+		}
+	}
+
+	return t, nil
+}
+
+// Close removes the files fetched to load the template.
+func (t *Template) Close() error {
+	if t.base != nil {
+		t.base.Close()
+	}
+	t.cleanup()
+	return nil
+}
+
+// Variables returns the variables of the template and of its bases.
+func (t *Template) Variables() []*Variable {
+	vars := []*Variable{}
+	if t.base != nil {
+		vars = t.base.Variables()
+	}
+	for _, v := range t.Manifest.Variables {
+		overridden := false
+		for i, bv := range vars {
+			if bv.Name == v.Name {
+				vars[i] = v
+				overridden = true
+			}
+		}
+		if !overridden {
+			vars = append(vars, v)
+		}
+	}
+	return vars
+}
+
+// SDKVersions returns the versions of the Cosmos SDK the template supports,
+// newest first.
+func (t *Template) SDKVersions() []string {
+	if len(t.Manifest.SDKVersions) > 0 {
+		return t.Manifest.SDKVersions
+	}
+	if t.base != nil {
+		return t.base.SDKVersions()
+	}
+	return SDKVersions
+}
+
+// ValidateSDKVersion checks the template supports a version of the Cosmos
+// SDK.
+func (t *Template) ValidateSDKVersion(version string) error {
+	if err := ValidateSDKVersion(version); err != nil {
+		return err
+	}
+	versions := t.SDKVersions()
+	for _, v := range versions {
+		if v == version {
+			return nil
+		}
+	}
+	return fmt.Errorf("template %q doesn't support the Cosmos SDK %s (supported: %s)", t.Source, version, strings.Join(versions, ", "))
+}
+
+// layers returns the file systems of the template, starting with the
+// deepest base.
+func (t *Template) layers() []http.FileSystem {
+	if t.base == nil {
+		return []http.FileSystem{t.fs}
+	}
+	return append(t.base.layers(), t.fs)
+}
+
+func readManifest(fs http.FileSystem) (*Manifest, error) {
+	m := &Manifest{}
+	data, err := httpfs.ReadFile(fs, "/"+manifestFile)
+	if os.IsNotExist(err) {
+		return m, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(data, m); err != nil {
+		return nil, errors.Wrapf(err, "unable to parse %s", manifestFile)
+	}
+	for _, v := range m.Variables {
+		if v.Name == "" {
+			return nil, fmt.Errorf("%s: variables must have a name", manifestFile)
+		}
+	}
+	return m, nil
+}
+
+func isGitURL(source string) bool {
+	source = strings.SplitN(source, "#", 2)[0]
+	return strings.Contains(source, "://") ||
+		strings.HasPrefix(source, "git@") ||
+		strings.HasSuffix(source, ".git")
+}
+
+func isDir(p string) bool {
+	fi, err := os.Stat(p)
+	return err == nil && fi.IsDir()
+}
+
+func isBuiltin(name string) bool {
+	fi, err := stat(templates.Assets, path.Join("/", name, manifestFile))
+	return err == nil && !fi.IsDir()
+}
+
+func stat(fs http.FileSystem, name string) (os.FileInfo, error) {
+	fh, err := fs.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer fh.Close()
+	return fh.Stat()
+}
+
+// gitClone clones url (optionally followed by #<ref>) into dir.
+func gitClone(ctx context.Context, url, dir string) error {
+	args := []string{"clone", "--quiet", "--depth", "1"}
+	if parts := strings.SplitN(url, "#", 2); len(parts) == 2 {
+		url = parts[0]
+		args = append(args, "--branch", parts[1])
+	}
+	args = append(args, url, dir)
+
+	if err := util.RunWithFD(ctx, nil, ioutil.Discard, os.Stderr, "git", args...); err != nil {
+		return errors.Wrapf(err, "unable to clone %s", url)
+	}
+	return nil
+}
+
+// gitHead returns the commit checked out in dir.
+func gitHead(ctx context.Context, dir string) (string, error) {
+	var out bytes.Buffer
+	if err := util.RunWithFD(ctx, nil, &out, os.Stderr, "git", "-C", dir, "rev-parse", "HEAD"); err != nil {
+		return "", errors.Wrap(err, "unable to read the commit of the template")
+	}
+	return strings.TrimSpace(out.String()), nil
+}
+
+// subFS is the file system rooted at dir within fs.
+type subFS struct {
+	fs  http.FileSystem
+	dir string
+}
+
+func (s *subFS) Open(name string) (http.File, error) {
+	return s.fs.Open(path.Join(s.dir, name))
+}
@@ -0,0 +1,322 @@
+package scaffold
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/blocklayerhq/chainkit/project"
+	"github.com/pkg/errors"
+)
+
+// OriginalsDir is the directory of an application where the files rendered
+// from its template are kept, as the base of the merges performed by
+// Update. Being hidden, it is ignored by the go tool.
+const OriginalsDir = ".scaffold"
+
+// Status is the outcome of the update of a file.
+type Status string
+
+// The possible outcomes of the update of a file.
+const (
+	// Added files are new in the template.
+	Added Status = "added"
+	// Updated files were unchanged in the application and are replaced by
+	// the new version of the template.
+	Updated Status = "updated"
+	// Merged files were changed both in the application and in the template,
+	// without conflicts.
+	Merged Status = "merged"
+	// Removed files were removed from the template and unchanged in the
+	// application.
+	Removed Status = "removed"
+	// Conflict files were changed both in the application and in the
+	// template, on the same lines. They contain conflict markers to resolve.
+	Conflict Status = "conflict"
+	// Kept files were changed (or deleted) in the application and removed
+	// (or changed) in the template. They are left as is.
+	Kept Status = "kept"
+)
+
+// Change is the update of a file of the application.
+type Change struct {
+	// Path is relative to the root of the application.
+	Path   string
+	Status Status
+	// Diff is the unified diff of the file in the application.
+	Diff string
+}
+
+// UpdateOptions configures the update of an application.
+type UpdateOptions struct {
+	// Template replaces the template recorded in the project, e.g. to move
+	// to another branch of a git template.
+	Template *Template
+	// DryRun computes the changes without writing them.
+	DryRun bool
+}
+
+// Update merges the changes made to the template of an application, since
+// it was created or last updated, into its files. It returns the changes,
+// sorted by path.
+//
+// Each file is merged from three versions: the original one rendered when
+// the application was created (kept in OriginalsDir), the one of the
+// application and the one rendered from the template today. Changes of the
+// application are preserved.
+func Update(rootDir string, p *project.Project, opts UpdateOptions) ([]*Change, error) {
+	cfg := p.Scaffold
+	if cfg == nil {
+		cfg = &project.ScaffoldConfig{}
+	}
+
+	t := opts.Template
+	if t == nil {
+		if cfg.Template == "" {
+			return nil, fmt.Errorf("the template of the application isn't recorded in chainkit.yml, please specify it")
+		}
+		var err error
+		t, err = Load(context.Background(), cfg.Template)
+		if err != nil {
+			return nil, err
+		}
+		defer t.Close()
+	}
+
+	// Variables the template doesn't declare (anymore) are dropped.
+	values := map[string]string{}
+	for _, v := range t.Variables() {
+		if value, ok := cfg.Vars[v.Name]; ok {
+			values[v.Name] = value
+		}
+	}
+	vars, err := resolveVars(t, values)
+	if err != nil {
+		return nil, err
+	}
+	goPkg := cfg.GoPkg
+	if goPkg == "" {
+		if goPkg, err = modulePath(rootDir); err != nil {
+			return nil, err
+		}
+	}
+
+	// The bech32 prefix and the denom come from the project, so that changes
+	// to chainkit.yml are rendered.
+	settings := Options{SDKVersion: sdkVersion(p)}
+	if err := settings.setDefaults(p); err != nil {
+		return nil, err
+	}
+	if err := t.ValidateSDKVersion(settings.SDKVersion); err != nil {
+		return nil, err
+	}
+
+	ctx := &Context{
+		Name:         p.Name,
+		RootDir:      rootDir,
+		GoPkg:        goPkg,
+		SDKVersion:   settings.SDKVersion,
+		Bech32Prefix: settings.Bech32Prefix,
+		Denom:        settings.Denom,
+		Vars:         vars,
+	}
+	files, err := render(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+
+	rendered := map[string]*file{}
+	for _, f := range files {
+		if !f.mode.IsDir() {
+			rendered[f.path] = f
+		}
+	}
+	originals, err := readOriginals(rootDir)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := []string{}
+	for name := range rendered {
+		paths = append(paths, name)
+	}
+	for name := range originals {
+		if _, ok := rendered[name]; !ok {
+			paths = append(paths, name)
+		}
+	}
+	sort.Strings(paths)
+
+	changes := []*Change{}
+	for _, name := range paths {
+		var data []byte
+		mode := os.FileMode(0644)
+		if f, ok := rendered[name]; ok {
+			data, mode = f.data, f.mode.Perm()
+		}
+		c, data, err := updateFile(rootDir, name, originals[name], data)
+		if err != nil {
+			return nil, err
+		}
+		if c == nil {
+			continue
+		}
+		changes = append(changes, c)
+		if opts.DryRun || c.Status == Kept {
+			continue
+		}
+
+		dst := filepath.Join(rootDir, name)
+		if data == nil {
+			if err := os.Remove(dst); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+			return nil, err
+		}
+		if err := ioutil.WriteFile(dst, data, mode); err != nil {
+			return nil, errors.Wrap(err, "unable to write to destination")
+		}
+	}
+
+	if opts.DryRun {
+		return changes, nil
+	}
+
+	// The new version of the template is the base of the next update.
+	if err := os.RemoveAll(filepath.Join(rootDir, OriginalsDir)); err != nil {
+		return nil, err
+	}
+	if err := saveOriginals(rootDir, files); err != nil {
+		return nil, err
+	}
+	p.Scaffold = &project.ScaffoldConfig{
+		Template:   t.Source,
+		Version:    t.Version,
+		GoPkg:      goPkg,
+		SDKVersion: settings.SDKVersion,
+		Vars:       vars,
+	}
+	if err := p.Save(path.Join(rootDir, "chainkit.yml")); err != nil {
+		return nil, errors.Wrap(err, "unable to update chainkit.yml")
+	}
+	return changes, nil
+}
+
+// updateFile merges the changes of the template into a file of the
+// application. original and rendered are nil if the file wasn't, or isn't
+// anymore, part of the template. It returns the change and the new contents
+// of the file (nil to remove it), or a nil change if there is nothing to do.
+func updateFile(rootDir, name string, original, rendered []byte) (*Change, []byte, error) {
+	current, err := ioutil.ReadFile(filepath.Join(rootDir, name))
+	switch {
+	case os.IsNotExist(err):
+		current = nil
+	case err != nil:
+		return nil, nil, err
+	case current == nil:
+		// Tell empty files from missing ones.
+		current = []byte{}
+	}
+
+	name = strings.TrimPrefix(name, "/")
+	change := func(status Status, data []byte) (*Change, []byte, error) {
+		return &Change{
+			Path:   name,
+			Status: status,
+			Diff:   unifiedDiff(name, current, data),
+		}, data, nil
+	}
+
+	switch {
+	case equal(rendered, original) || equal(rendered, current):
+		// Unchanged in the template, or already up to date.
+		return nil, nil, nil
+	case current == nil && original == nil:
+		return change(Added, rendered)
+	case current == nil:
+		// Deleted in the application.
+		return &Change{Path: name, Status: Kept}, nil, nil
+	case equal(current, original) && rendered == nil:
+		return change(Removed, nil)
+	case equal(current, original):
+		return change(Updated, rendered)
+	case rendered == nil:
+		// Removed from the template but changed in the application.
+		return &Change{Path: name, Status: Kept}, nil, nil
+	}
+
+	data, conflicts := merge3(original, current, rendered, "application", "template")
+	if conflicts {
+		return change(Conflict, data)
+	}
+	return change(Merged, data)
+}
+
+// equal returns true if a and b are both missing (nil) or have the same
+// contents.
+func equal(a, b []byte) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return string(a) == string(b)
+}
+
+// saveOriginals keeps the files rendered from the template within
+// OriginalsDir.
+func saveOriginals(rootDir string, files []*file) error {
+	dir := filepath.Join(rootDir, OriginalsDir)
+	for _, f := range files {
+		if f.mode.IsDir() {
+			continue
+		}
+		dst := filepath.Join(dir, f.path)
+		if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(dst, f.data, 0644); err != nil {
+			return errors.Wrap(err, "unable to save the original files of the template")
+		}
+	}
+	return nil
+}
+
+// readOriginals returns the files rendered from the template when the
+// application was created or last updated, by path. It is empty for
+// applications created before they were kept.
+func readOriginals(rootDir string) (map[string][]byte, error) {
+	originals := map[string][]byte{}
+	dir := filepath.Join(rootDir, OriginalsDir)
+	err := filepath.Walk(dir, func(p string, fi os.FileInfo, err error) error {
+		if os.IsNotExist(err) && p == dir {
+			return filepath.SkipDir
+		}
+		if err != nil || fi.IsDir() {
+			return err
+		}
+		data, err := ioutil.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		if data == nil {
+			data = []byte{}
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		originals["/"+filepath.ToSlash(rel)] = data
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read the original files of the template")
+	}
+	return originals, nil
+}
@@ -21,171 +21,611 @@ var Assets = func() http.FileSystem {
 	fs := vfsgen۰FS{
 		"/": &vfsgen۰DirInfo{
 			name:    "/",
-			modTime: time.Date(2018, 12, 7, 0, 11, 56, 718735786, time.UTC),
+			modTime: time.Date(2026, 10, 19, 10, 48, 32, 865453511, time.UTC),
 		},
-		"/.gitignore": &vfsgen۰CompressedFileInfo{
-			name:             ".gitignore",
-			modTime:          time.Date(2018, 12, 7, 0, 11, 56, 718932869, time.UTC),
-			uncompressedSize: 220,
+		"/bank": &vfsgen۰DirInfo{
+			name:    "bank",
+			modTime: time.Date(2026, 10, 19, 10, 3, 17, 187087021, time.UTC),
+		},
+		"/bank/app.go.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "app.go.tmpl",
+			modTime:          time.Date(2026, 10, 19, 10, 3, 17, 187087021, time.UTC),
+			uncompressedSize: 3249,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\x8c\xc1\x4a\x04\x31\x10\x44\xef\xfd\x15\x05\x7b\x0b\x6b\xf6\x1f\x44\x0f\x82\xe0\x41\x3f\x60\x33\x33\x3d\x99\x86\x76\x3a\x24\x1d\xd7\xb9\xf8\xed\x12\xdd\x4b\xf1\x8a\x2a\xde\x09\x8f\xb2\xa7\x2a\xdc\xb0\x5a\x45\xa9\x96\x6b\xfa\x6c\x48\xfb\x82\xa2\x3d\xcb\xde\x28\x44\xfe\xe6\xff\xfc\xa1\x10\x17\x55\x0a\xb1\xd9\xc0\x43\x65\x22\x3a\xe1\x83\x9b\x63\x1a\xa6\xe3\x8c\xa9\x8b\x2e\xb8\x89\x6f\xb8\x66\x83\x8f\xed\x61\xbe\x52\x88\x03\xc7\xfd\xad\x7b\xe9\x0e\x5b\xe1\x1b\x23\x1b\x66\xfb\xe2\x9a\x32\xc3\xcd\xf4\x8c\x56\x78\x96\x55\xe6\xa4\x7a\xe0\xb6\xf1\x8e\xde\xf8\xae\x7c\x15\xe7\x97\xa7\x67\x0a\xd1\xfa\x9f\xec\xdd\x93\x33\x56\xd3\x85\x2b\x5d\xda\x68\x74\x51\xcb\xf4\x1b\x00\x00\xff\xff\xde\x27\xf8\x7f\xdc\x00\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x56\x41\x73\xdb\x36\x13\x3d\x03\xbf\x62\x3f\x1e\x32\xa4\x87\x1f\x75\x57\x47\x07\xc5\x49\x13\x37\xb6\xda\xb1\x5b\x5f\x32\x99\x06\x04\x57\x32\x2b\x12\x60\x00\xa8\x92\x46\xa3\xff\xde\x59\x10\xa4\x48\x87\x71\xec\x83\x45\x02\x0f\x6f\x77\xdf\x3e\x80\x68\x84\xdc\x8a\x0d\x82\x68\x1a\xce\xcb\xba\xd1\xc6\x41\xcc\x59\x84\x4a\xea\xa2\x54\x9b\xd9\x3f\x56\xab\x88\x73\x96\x8b\x1a\xa2\x4d\xe9\x9e\x76\x79\x26\x75\x3d\x93\xda\xd6\xda\x86\x9f\xff\xdb\x62\x3b\xcb\x85\x45\xd1\x34\x11\x67\x2f\xe3\xa4\x2e\x50\x46\x9c\xd9\x62\xfb\x13\x46\x77\x6c\xd0\xfe\x94\xef\x30\x13\x3b\xf7\xf4\x0a\x58\x2e\xd4\x36\xe2\x4c\xe4\xb2\x1c\x05\x76\xa8\x0a\x34\x75\xa9\xdc\xf0\x91\x60\x7d\x06\xb2\x56\xaf\x58\x52\x95\x39\x85\xac\x6b\x92\x8c\x15\x79\xfd\xda\x35\x45\xfe\x2c\xfd\x17\xb0\x95\xde\x44\x9c\xb9\xda\xa7\xf6\x8a\x00\xa1\x84\x84\x73\xa9\x95\xf5\xdd\x15\x4d\xb3\x12\x35\xc2\x02\xa2\xd3\x09\x32\xff\x7c\x3e\x47\x3c\xe1\x7c\x36\x83\xbb\xe3\xb2\x69\x60\x5d\x1e\x6a\xe4\xb4\x38\x0c\x58\x67\x76\xd2\xc1\x89\xb3\xab\x5c\xd4\xd9\x5b\x61\x71\xd9\x34\x9c\xc9\x42\xc2\x95\xef\x69\x76\x4d\xff\x39\x67\x5b\x3c\xde\x89\x52\x41\xff\x77\x65\x8b\x6d\xf6\xe9\xf1\xc1\x69\x83\x9f\xf0\xe8\x11\x4b\x29\xf5\x4e\xb9\x17\x10\xbf\x22\x5e\xeb\xaa\x42\xe9\x4a\xad\xbe\x43\x70\x26\x5a\x86\x4f\x88\x0d\x9a\xc0\x43\x56\xc8\x02\x75\x3b\xc1\xd9\x7a\xc8\x13\xd0\x1e\x37\x0a\xd0\xa1\xc9\x25\x23\x4a\x00\xa0\xb1\x2c\x00\xce\x5e\xa4\x15\xee\x87\x3a\xad\x77\x4a\xf6\x63\x71\xa5\x37\x1b\x34\x50\xe9\x4d\x76\xeb\x1f\x53\x28\x72\x28\xf2\x3a\x7b\xf7\x36\x81\xab\x76\xe1\xa9\x95\x6e\xbe\x80\x3b\xb1\x45\x2f\x5d\x9c\x70\x96\xd3\xdc\x7c\x01\xa4\xf1\x0a\xf7\x41\xe6\x38\xb4\x2c\x85\xaa\x27\x4c\xdb\x22\xde\xe1\x5a\xec\x2a\xf7\xe7\xe1\x1d\x52\x17\x4c\x2c\x0b\x99\x24\x9c\xb3\x7f\x85\xa1\x6d\x0d\x0b\x78\xe3\x23\x9e\x38\x63\x81\x6e\x0e\x14\x26\xe5\x8c\x52\x98\xfb\x1a\x65\x21\x53\xce\x59\xd7\xbb\x79\xdf\x3b\x20\xdd\x57\xb8\xbf\x48\x1f\x47\xb5\x28\x55\x94\xd0\xfa\x4b\x23\xe7\x3f\x86\x0b\x29\x7b\xf4\x48\xf3\xf9\x14\x7a\x8d\xe8\xd1\x67\xce\x99\x68\x9a\x6c\xdc\xe6\x45\x5b\xf5\x0a\xf7\xa3\x2e\xc7\x9c\x79\xb0\x2f\xa3\x7d\xbc\xa4\x46\xa1\xfd\xaa\x3f\x8c\x76\xda\x6b\xd0\x4f\x24\x21\xca\x94\x49\x2e\xb1\x26\x9c\x12\x77\xe1\x20\x04\x1b\x61\x92\x96\x75\x60\x26\x6a\xa9\xda\x76\x3d\x1d\x70\x8c\xea\xeb\xd2\xb9\xd7\x3b\x87\x26\x4e\x32\xce\xd8\xb2\x28\xfc\x6b\x1c\x11\x43\x94\xf6\x44\x1f\x85\x2a\xaa\xc0\x72\x89\x94\x74\x1c\x0f\xe8\x6e\x54\xe9\xae\x9f\x44\xa9\x02\xaa\xbc\xbc\x87\x0c\x1f\xd0\x2d\x95\xc3\x9e\xaa\x13\x77\x38\xf6\x3c\xc9\x14\x7e\xa0\x58\x1f\xfa\x8e\xc0\xbe\xa5\xf6\x66\xf9\x78\xdb\x75\x27\x98\xeb\x07\x1d\x9a\x90\x31\x34\x08\x8d\x81\xf9\xc2\x47\xbd\xd5\xa2\xb8\x15\x0e\xad\x7b\x44\x63\x4b\xad\xe2\x01\x71\xc2\x59\xb9\x06\x42\xff\x6f\x01\xaa\xac\xe8\xb4\x62\xb2\x56\xd9\xfb\x43\xe9\x62\x34\x26\x7b\x6f\x8c\x36\x71\x92\xb4\xf6\x32\xe8\x76\x46\x11\x6f\xd8\xd6\x1f\x50\xa1\x2d\xed\x83\x13\x0e\x87\x47\xe0\x68\xfc\x72\x12\x86\xec\x2d\x7c\xfe\xe2\x9d\x32\xb0\x16\x7c\xa5\x2f\xe7\x3c\x0a\xc2\xd9\xe8\x2b\x85\xf0\xe7\x04\x65\x1c\x4e\x81\x04\x06\x1d\x89\xa5\x3b\xf8\x0d\x71\xad\x95\xc3\x83\x4b\xc1\xe0\x37\xa0\xef\x50\x76\x8f\xdf\x76\x68\x2f\xed\x4c\xba\x61\xdb\x68\x65\xb1\x1f\xa7\xa4\x2c\x25\xf9\xdb\xc3\xef\x2b\x92\xcc\xe0\xb7\x6c\xd9\x34\xbe\xa0\xb7\x47\x87\x96\x73\xb6\x19\x16\x33\x5f\x80\xc2\x7d\x3c\x2c\x30\x19\x09\x2e\x0b\x99\xfd\xa5\x6a\x61\xec\x93\xa8\x88\x36\xee\x03\xa4\x30\xa4\x9a\x14\xbf\x11\xaa\x94\xa4\x7c\x50\x7c\xad\x0d\xfc\x9d\x82\x90\xfe\xe4\x33\x42\x6d\x70\x44\xd2\x9d\xdd\xd6\xaf\x16\x52\x76\x03\xab\x5d\x9d\xfb\x5d\xf4\x9d\x1b\xb3\x0f\xe8\x56\x78\x70\x23\x20\x69\x99\x04\x53\x8d\xd1\x64\xf8\x76\x80\x30\x29\xbc\x11\x52\x3e\xb3\xc3\xa4\xb4\xa7\x73\xf0\xc8\xfb\x03\xdd\x92\x3a\x51\x97\xaa\x78\x14\x55\x59\x08\xa7\x8d\x05\xf4\x73\x16\xdc\x13\x82\x97\x09\xf4\xda\xbf\x88\xa6\xa9\x4a\x29\x68\xab\x00\x69\x20\xba\xaa\x61\x5d\x56\x98\x4d\x18\xe3\x85\x30\x71\x02\x31\xb9\x2b\xbb\x17\xfb\x3b\xb4\x56\x6c\x30\x85\xcf\x5f\xc2\x9d\x20\x0b\xbd\xec\xf1\x29\xed\x08\x6d\x12\x92\x94\x2c\x16\x1a\xbb\xc2\x7d\x30\x5a\xec\xcc\x0e\xd3\xd6\x52\x1f\x51\x14\x68\x4e\xe7\x64\xc2\x28\x43\x93\x50\x7b\x82\x8e\x76\x3e\xe1\xff\xd3\xd9\x1f\xe1\x13\x0d\xb8\x71\x68\x84\xeb\x70\xb6\xed\x02\xd5\x1f\x93\x2b\x86\x1f\xf0\x04\x72\xad\xdb\x4d\x5c\xae\x21\xb0\xa4\xa0\xb7\x94\x0c\x79\x23\xbe\x7a\x1e\x36\xf9\x85\xa6\x69\xc5\x28\xfb\x8e\xd1\xb6\x06\x42\x55\xc4\x93\xd3\x29\x5c\x85\x30\x64\x9e\x33\x67\x9d\x27\xd6\xa2\xb2\xc8\xd9\x39\x1c\x70\x9e\x34\x85\x67\xdb\xe4\xee\xb2\x49\x6e\x54\x81\xca\x8d\x82\xa4\x10\x45\x29\x44\x00\xd1\xe4\x4e\x09\x81\x54\x59\xa5\x34\xe6\xc9\x49\x41\xce\x66\x33\xb8\xf4\x1e\x84\x41\x50\xda\x41\x2d\x94\xd8\x60\x01\xf9\xf1\xb9\xc1\xb2\xe1\xc1\x16\x42\x77\xb4\xc1\xc3\xfd\x95\x63\x78\x7f\x19\xdc\x43\x46\x37\x3a\x4a\x8f\x2e\x13\x74\x5d\x59\x40\x3b\xb1\xc2\x3d\xdd\x56\xbc\xfc\xf7\xb8\x29\xad\x43\xe3\xc1\xfe\xfa\xd1\xde\xa2\x26\x27\x6c\x31\x3d\xde\xd2\xf6\x33\xe6\xd8\x38\x1d\xa6\x42\x31\xb2\x90\xfc\xcc\xff\x1b\x00\x98\x25\xa0\x1e\xb1\x0c\x00\x00"),
 		},
-		"/Dockerfile.tmpl": &vfsgen۰CompressedFileInfo{
-			name:             "Dockerfile.tmpl",
-			modTime:          time.Date(2018, 11, 4, 21, 45, 11, 314037283, time.UTC),
-			uncompressedSize: 1140,
+		"/bank/cmd": &vfsgen۰DirInfo{
+			name:    "cmd",
+			modTime: time.Date(2026, 10, 19, 10, 3, 6, 643526280, time.UTC),
+		},
+		"/bank/cmd/{{ .Name }}cli": &vfsgen۰DirInfo{
+			name:    "{{ .Name }}cli",
+			modTime: time.Date(2026, 10, 19, 10, 3, 6, 647074863, time.UTC),
+		},
+		"/bank/cmd/{{ .Name }}cli/main.go.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "main.go.tmpl",
+			modTime:          time.Date(2026, 10, 19, 10, 38, 5, 409284900, time.UTC),
+			uncompressedSize: 1512,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x53\xcd\x6e\xdb\x3c\x10\xbc\xeb\x29\x06\xfe\x80\xe4\x44\xf1\x43\x8f\x29\x72\x48\x9c\x1f\x04\x6d\xe2\xc0\x41\x51\x14\x4d\x51\xd0\xe4\x4a\x22\x4c\x2d\x05\x92\x72\x22\x24\x7e\xf7\x42\x3f\xb0\x53\xc4\x97\x22\x37\x61\xb9\x3b\x9a\x99\xdd\xb9\x5a\x2e\x6e\x51\x7a\xa7\xb8\x3c\x51\xae\xb1\x4c\x38\x7b\xc0\xaa\xb5\xce\x08\xe2\x4d\x96\xfd\x87\x07\x4a\x78\xf2\x61\x6d\xb9\x84\xb1\x81\x74\xf2\xa1\x43\xe1\x03\x52\x45\x63\x6b\xf6\x7d\xb1\xfc\x72\x71\xb3\x84\x2c\xbd\x8c\x41\xcb\x97\x17\xe4\xd7\xfe\x7e\x5d\x62\xbb\x9d\x30\xda\x66\xec\x05\xf1\xc6\x06\xcf\x35\x71\xca\x96\xdf\xee\xa0\x9a\x35\x94\x31\x10\x82\xbd\xd0\x4a\x57\x04\xdd\x06\x87\xd2\x26\x1c\x1d\xe1\x31\x03\x30\x56\xaa\x94\x9a\x78\x22\x65\x50\x4f\x79\x69\x53\xd5\xae\xda\x48\x41\x7b\x4e\xc4\x29\xd7\xbe\x96\xa3\x12\x69\xa8\x91\xb5\x8a\x89\x82\xb4\x1c\x93\x72\x2e\x8f\x15\x5e\x11\xab\x9e\xcc\xdc\x37\x1d\x3c\xbb\x6e\x10\x60\xa8\x21\x36\xc4\xba\x43\xad\xd8\x16\x14\x53\xcc\xe6\x8b\xfb\x1f\xb8\xf6\xcd\xba\xcc\x93\xaf\xdd\xf4\xe9\xbc\x5e\x23\x97\x3d\xc6\x15\x25\x5d\xed\x67\x2d\xc5\x41\x8b\xa1\x06\xc4\xb1\x0d\x04\xb1\x81\x10\x1b\x62\xe3\x83\xe8\x7f\xd6\x4f\x9d\x0f\x06\xbc\x9b\x2a\x2c\x1b\x8c\xad\x10\xb5\x7a\x36\xd4\xa4\x0a\x9f\x20\x6a\xcb\xbb\xef\xd4\x35\x04\x03\x41\xcf\xa4\x27\x53\x62\x05\xa1\x71\x3c\xbf\x5e\xfc\xbe\xbc\x3b\x3b\xff\x7a\x79\x71\xfa\x3f\x4a\x3f\xf9\xdc\x13\x70\xa6\x70\xaa\x8c\x98\x89\x08\xf1\x34\xc3\xdb\xbd\xc8\x97\xad\xcc\xf3\x1c\xaf\xaf\x48\xa1\xa5\x63\x3c\x7e\xee\x39\x9e\x19\x83\xe8\xdb\xa0\x09\x85\x75\x34\x79\x91\x4f\xba\x47\x05\x8a\x0d\x26\x63\x07\x01\x23\x9d\x7f\xe0\x21\xa6\xc7\xe1\x50\xee\x54\x4d\xd8\x6e\x0d\x72\xa9\xeb\x7d\xa9\xaf\xec\xf6\xff\x41\x6c\xed\xec\x3b\x74\xed\xec\xb0\x49\xcb\xca\xc1\xd6\xaa\xa4\x6c\x48\xc3\x18\x83\x13\x32\x25\xf5\xef\x37\xa3\x4e\x68\x25\x34\x85\x64\x0b\xab\x55\x9a\x16\xb7\x3f\xdd\xb6\x31\x2a\xd1\xbb\xa6\x5d\x2e\x82\xf7\x69\x7f\x7b\x1b\x0a\x58\x59\x56\xc1\x52\x44\x11\x7c\xbd\x8f\xd2\x90\xba\xc1\x72\x21\xfa\x97\xd3\x5d\xf5\x60\xb8\xe4\x01\x1b\x65\x1b\x83\x5c\x59\xfe\xab\xfa\x21\xcc\xde\xbe\x43\xa8\x93\x85\xcb\x96\xc7\x28\x29\xaa\x3d\x63\xd5\xc1\x50\xa1\x5a\x97\xb2\xf9\xed\x05\x7e\xce\xde\xf2\x98\xfd\xca\xfe\x04\x00\x00\xff\xff\x2a\x35\xc0\x9e\x74\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x53\xc1\x6e\xe3\x36\x10\x3d\x73\xbe\x62\x4a\x14\x85\x04\xb8\x34\x8a\xde\x0c\xf8\xe0\x38\x46\x52\x20\x49\x53\x38\xdd\xcb\x62\x0f\x34\x39\x76\x08\x49\xa4\x42\x52\x81\x02\x43\xff\xbe\xa0\x2c\x07\x72\xd6\x9b\xf5\x49\x84\xf8\xde\x9b\x37\x8f\x33\xb5\x54\x85\xdc\x11\x56\xd2\x58\x00\x53\xd5\xce\x47\xcc\x80\x71\x17\x38\x00\x93\x75\x8d\x7c\xbf\x47\x71\xe3\x1e\x8b\x1d\x76\x1d\x07\xc6\x77\x26\x3e\x37\x1b\xa1\x5c\x35\x55\x2e\x54\x2e\x0c\x9f\x3f\x83\x2e\xa6\xaa\x34\x64\xe3\x85\xb0\x69\x41\x6f\xe1\x52\xac\xaf\xd5\xa5\xd0\xd8\x72\x60\xb2\x89\xcf\xaa\xd2\xf8\x39\xa3\x9d\x26\xdc\x91\xa8\x4a\xc3\x81\x6d\xa4\x2d\x2e\x61\x26\xdc\x29\x73\xcc\x08\xf5\xf6\xaf\xbf\xa7\xca\x6d\xbc\xfc\x70\x13\xc9\x6a\xf2\x95\xb1\x71\x7c\x2c\xcd\x26\x24\x35\x0e\x39\x80\x72\x36\x44\x0c\xd1\x79\x5a\x28\x85\x73\xe4\x52\x29\x0e\xf0\x2a\x7d\x7a\x1e\xef\x5c\x5c\x56\x1a\xe7\xf8\x47\x5f\x40\x2c\x5d\x55\x49\xab\xf7\xc0\xd8\xff\x81\x66\x88\x87\x67\x7b\x90\x15\x61\xd7\x25\xd5\x09\x30\xb6\x7e\x76\x3e\xce\x4e\xae\x70\xd9\xfb\xe7\x13\x60\x1d\xb0\x6b\xda\xca\xa6\x8c\xcb\xbb\x7f\x6e\x5d\x45\x38\x47\x17\xc4\xaa\xad\xa5\xd5\x2b\xfb\x9a\xf1\xdf\x6f\xff\xbd\x5f\x4d\xc5\x07\xe5\x3c\x19\xde\x36\x56\xf5\x53\x94\xe5\xb8\xef\x07\x47\xac\x29\x2e\x9d\xdd\x9a\x5d\x96\x03\x3b\xd8\x5c\x59\xb9\x29\x69\x30\xbb\x76\x3e\x1a\xbb\xc3\x39\x6e\x65\x19\x08\x98\xd2\x0a\x67\x73\x4c\xdc\x7b\x59\xd0\xd2\x69\x52\x59\x0e\xef\xed\x8a\x85\xd6\x03\x37\x3b\xc4\x2e\x0e\x05\x96\x95\xce\xf2\x1c\x98\xaf\xd5\x08\x13\xb2\x81\x97\x24\x5e\x1a\xf2\x6f\x29\xb2\xd9\x27\x99\x21\xf2\x1e\x97\xd2\x60\x8b\xd2\xc8\x40\x61\x86\x5f\xbf\x85\xe8\x8d\xdd\xed\xf9\x0b\xef\x46\x31\x22\xf2\xff\x12\x3a\xf5\x10\x9a\x8d\x1a\xaa\x26\x72\x37\xaa\x38\x76\x0d\xac\xf7\x78\x55\x3a\x55\x1c\xff\xe5\x93\xe1\xef\x17\x59\x1a\x2d\xa3\xf3\xe3\x9b\x1c\x58\x6c\x4f\x9a\x3a\xea\x4e\x50\x69\x95\x9f\xaf\x33\xa4\x73\x67\x2c\x5d\x79\x92\xc5\xe7\xb0\x1b\x8a\xef\xea\xc0\x8e\x8b\x23\x6e\x28\x2e\x94\x72\x8d\x4d\xa3\x96\x1d\x67\xb1\x2f\x3b\xc1\x1f\x41\xd7\xa4\x9c\x26\x9f\x25\x57\xbd\x73\x21\x44\x4a\x3e\xb6\xbf\x8a\x9d\xc7\xf6\x64\x3c\x9f\xbc\xb4\x41\xaa\x68\x9c\x0d\xe7\x92\x8d\xed\xf9\x3e\x1e\x5d\x38\x69\x64\xd8\x63\xb1\x26\xab\x9f\x12\xa7\xf7\x36\xb2\x76\x66\xae\x80\xbd\x27\x95\x2c\xf5\xa5\xd2\xe1\x63\xa2\x49\xe5\xa7\x0a\x05\xbd\x85\x63\x9f\x61\x78\x46\x60\xd4\x92\x6a\xa2\xf3\x69\xc6\x55\x69\xc4\xa3\xa7\x5a\x7a\xba\x97\xc6\x26\x6f\x83\xd4\x04\xf9\xc3\x9a\x4f\xf0\x74\x15\x73\x60\xe4\x7b\xe6\x51\x45\xac\xfa\x03\xa5\xe5\x32\x5b\x4c\xb7\xbf\xcd\xd1\x9a\x32\xad\x1f\xab\xa5\x35\x2a\x23\xef\x73\x60\x1d\x74\xf0\x7d\x00\xf2\x7c\xa3\x91\xe8\x05\x00\x00"),
+		},
+		"/bank/template.yml": &vfsgen۰FileInfo{
+			name:    "template.yml",
+			modTime: time.Date(2026, 10, 19, 10, 3, 17, 190532358, time.UTC),
+			content: []byte("\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x41\x63\x63\x6f\x75\x6e\x74\x73\x20\x61\x6e\x64\x20\x74\x6f\x6b\x65\x6e\x20\x74\x72\x61\x6e\x73\x66\x65\x72\x73\x20\x28\x74\x68\x65\x20\x64\x65\x66\x61\x75\x6c\x74\x29\x0a\x62\x61\x73\x65\x3a\x20\x62\x61\x72\x65\x0a"),
+		},
+		"/bare": &vfsgen۰DirInfo{
+			name:    "bare",
+			modTime: time.Date(2026, 10, 19, 11, 1, 13, 735849500, time.UTC),
 		},
-		"/Gopkg.lock": &vfsgen۰CompressedFileInfo{
-			name:             "Gopkg.lock",
-			modTime:          time.Date(2018, 11, 4, 21, 45, 11, 314479185, time.UTC),
-			uncompressedSize: 18602,
+		"/bare/.dockerignore": &vfsgen۰CompressedFileInfo{
+			name:             ".dockerignore",
+			modTime:          time.Date(2026, 10, 19, 11, 17, 7, 356557116, time.UTC),
+			uncompressedSize: 146,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x7b\xcb\x72\x63\x3b\x8e\xed\xfc\x7c\x85\xe3\xdc\x41\x4f\x3a\x53\x04\x48\x90\x44\xdf\xe8\xbf\xe8\x3b\xea\xe8\xe8\xc0\x8b\xb6\xca\x7a\x95\xb4\x95\x27\xb3\xbe\xfe\xc6\x96\xd3\x69\xc9\xb2\xd2\x2e\xd7\xc4\x16\xd7\xe6\x7e\x80\x24\x80\x05\x10\xfc\x3f\x77\xff\xf5\xb0\x3c\xdc\x8d\xe5\x2a\xee\x96\x87\x3b\x39\x4e\xdb\xfb\xd8\xc4\x5e\xa6\xf0\x7f\xbf\xf3\xed\xdd\x66\x3b\xdd\x85\x2f\xa7\xff\x7b\x67\x0f\xb2\xb9\x8f\xc3\xdd\x5a\x7e\xdc\x69\xdc\x1d\x37\xbe\xdd\xc4\x9d\xfe\xb8\x9b\x1e\xe2\x6e\x13\xdf\xa7\xbb\x7f\xf3\xd8\xdd\xc5\xe6\x70\xdc\xc7\xbf\x7d\xfd\xe3\x8f\x3f\xfe\xfb\xbf\x77\xfb\xed\xdf\xc2\xa6\xc3\xff\xfc\xcf\x1f\x77\x77\xba\x97\x8d\x3d\xdc\xfd\xe7\xdd\x9f\x6b\x39\x4c\xb1\xff\xf3\x8f\xbb\x3b\x5f\xde\xc7\x61\x9a\x31\xf8\x8f\xc4\xd2\x46\x2b\xa1\x55\x35\x5b\x1a\x50\xbc\x33\xd6\x0a\xc9\x7a\x1b\x54\xd9\xc8\xc6\xa8\x3d\xb8\x75\xcf\x29\x58\x32\x95\x24\xa1\x15\xeb\xf0\x91\xe6\xe7\x6d\x64\x1d\xf3\xd3\xee\x97\xd3\xc3\x51\xbf\xda\x76\xbd\x50\xd9\x4f\xf1\xb8\x59\xdc\x6f\xbf\xe8\x72\x97\x79\xee\xb6\x13\x7b\x94\x59\x98\xff\xbc\xfb\xef\x3f\xbf\xfe\x39\x7f\xdd\x6e\x7f\xdc\xc4\x76\x37\xcd\xd8\x9f\xff\xef\xbf\xe6\x5e\xfb\xf8\xb6\x3c\x2c\xb7\x9b\x19\x91\x44\x5c\x5b\x48\x62\xf2\x0e\xd6\x47\x94\x9e\x73\x6b\xb5\xb5\x62\x43\xc6\xe8\x41\x29\x57\xfb\xf3\x9f\x16\xdb\xab\x0c\x89\x70\xa0\x84\x22\xd8\xa3\x27\x29\xe1\x89\x3b\x38\xb5\x24\xce\xa0\x48\x8d\x81\x73\x49\x05\xa9\x5a\xd4\x86\xe1\x49\x6a\xe2\x48\x7e\x4b\xec\xd8\xee\x37\x6d\xb1\x8b\xfd\xe3\xe1\x4a\xe4\xbf\x1f\x65\x33\x2d\x57\xf1\x11\xc9\xb3\xb4\x06\xce\x8c\xdc\xf2\xc0\x22\xd2\x90\x3c\xb5\x5e\xbb\x96\xda\x1c\xdc\x87\x85\x0c\xbd\x96\xfc\x5c\x4a\xc8\x25\x0b\x72\xcd\xa5\x83\xe4\x44\x26\xc5\x13\x41\xf4\xa2\x86\xa2\x0e\x55\x6b\x82\x08\xc4\xf0\x8c\x65\x74\xaf\x89\x3c\x40\x5c\x91\x41\x6f\x4e\xee\x7d\x6c\xa6\xfd\x8f\xc5\x61\x17\xf2\x18\x72\xf8\xf1\xb9\xd9\x2d\x22\x6a\x58\x7a\xe9\x16\x34\x3c\x03\x23\x8f\xe6\x10\x25\xa4\x15\xcf\x2d\xb1\x41\xb1\xd3\x58\x7f\x8b\xfd\xf3\x6d\xdf\xd2\x57\xf8\x9a\xfe\xf9\x29\xb7\xe4\x61\xa3\x66\xec\x25\x63\x2a\x8e\xda\x5b\x07\x8f\xa6\x58\xa3\xa5\xdc\xa9\x97\x40\x37\x33\x6b\x81\xa3\x24\x88\xde\x25\x3a\x29\xf8\x40\x6d\xb7\x06\x63\xb2\xc3\x71\x39\xc5\xfc\xc3\xaf\x06\x42\x27\x0b\xfb\xc8\x60\xd4\x16\xd4\xb2\x23\x80\x58\x10\x97\x01\xb9\x56\x2d\x16\xec\x99\x1b\x56\x2b\x0a\xac\xfe\x86\xdc\xe7\x32\xe6\x5e\x3d\x80\xda\x3c\x8c\x8c\x34\xeb\x36\x5b\x87\x81\xd5\x22\x01\x20\x66\x8b\x34\x12\xa7\x4c\xd9\x00\x08\xc7\xf0\xd1\x72\xf2\xe6\x90\xc4\xf0\x03\x32\x1e\xa7\xe5\xea\x5a\xcc\xb0\x87\x8c\x1f\x91\xd3\x8b\x59\x6f\xda\x6b\x82\x5a\x3d\x25\xaf\x4a\x3a\x6b\x54\xd6\xec\x0d\xbc\xa6\xde\xbd\x78\xf9\xdd\xfc\x7a\x7c\x8b\xd5\x76\xf7\x7a\x82\xb5\x91\x56\x83\x9c\x5b\x0e\xc1\x51\x1b\xb5\xda\xb0\x5b\x35\x42\xa5\x56\x90\x87\x04\x41\xe7\x54\x5b\x55\x62\x77\xa7\x24\x91\x59\x3d\xab\xc0\x2d\xe1\x6d\x7b\x58\x6f\x0f\x3f\xff\x7d\x39\xf8\xe3\x6b\xe9\xff\xb8\xbb\xbb\xbb\xfb\x53\xe5\x10\xb2\xdb\xfd\xf9\xef\x4f\x4d\x5b\x2d\x63\x33\x5d\xb6\x16\xb6\xdd\x4c\xf1\xfd\x35\xfa\x18\x3f\x0e\xaf\xa0\xfd\xce\x5e\x21\xd3\xf7\x57\xc0\x3c\x0f\x2f\xb7\xad\x7d\x71\x2f\x4b\x59\x2c\x37\xcb\x97\xc7\x6f\x3d\x5e\x1e\xb3\xff\xb1\x9b\xb6\x97\xad\xcb\x37\xbf\x40\x8b\x07\x7f\x0b\x5d\x2f\x37\xd3\x63\xfc\x78\xbe\x74\x88\xfd\xb7\xd8\x5f\xb6\x66\x09\xc7\xf2\xfe\x17\x38\x6d\xf7\xf1\xdc\x98\x7e\xec\xe2\xd7\xdb\x7e\xea\xf3\x73\xf3\xfb\x42\x8e\xd3\xc3\x65\x6b\xf1\x3c\x68\xab\xe5\xdb\x17\xa6\xef\x7a\x5c\xae\xfc\xe5\x1b\xbe\x2f\x54\x36\x8f\xa7\xd6\x07\x56\x22\x25\xb2\x4c\x75\x60\xf2\xe4\x3d\x4c\x23\x9b\x49\x88\x4a\x1b\x35\x8f\x24\x09\xba\x49\xee\xbf\xd7\xb8\xe8\x92\x89\x66\x67\xd9\x6b\x86\x5a\x1b\x8d\x41\x45\xbc\x8e\xc4\x8e\x95\x1c\x90\x8d\x9d\x7b\x65\x60\x19\x6d\x50\x01\x19\x2a\x94\xbc\x37\x8b\x77\x16\xdd\xbf\xe6\x3e\x09\x81\x7a\x94\xca\x4d\x7b\xf3\x80\x1a\x9e\x39\x05\xa8\xcb\xe8\x90\x83\x3a\xa4\xd4\x87\xfc\x5e\xc2\x31\x82\x3b\x16\x47\x2e\x2e\x29\x6b\x66\x18\xa5\x04\x48\x74\xec\x80\x1d\xb4\xc8\xb0\xf9\xa1\xc2\xd4\xbb\x71\xe2\xd6\x3a\x45\x96\x01\x69\xb6\x7e\x6f\x4b\xe8\xf2\x2d\xec\xfe\x61\x16\xf1\xb0\x8b\xbf\xae\x24\x3c\x81\x1f\x10\xb2\x33\x83\x1a\xb2\x08\x54\xa3\xd2\x6d\x9e\x8d\x36\x46\xeb\x58\x53\x60\x53\x16\x6d\xd6\xf2\x6b\x2f\x02\x5f\xe1\x2b\xfc\x5e\x72\x6b\xb5\x14\x6b\x59\xb2\x63\x6e\x05\x86\xab\x74\x61\x56\x28\xb5\x44\x42\x10\xc4\xa2\x2d\x30\x91\x46\xe1\x86\x0d\xa4\xa7\x94\x05\x66\x4f\x0b\x7a\x43\xf2\xd0\xa3\x3d\xac\x65\xb3\x18\xb2\x5c\x7d\x99\xe2\x30\x7d\x6e\x7a\x99\x46\x4f\x0c\xa9\x21\x92\x06\xa4\x1e\xc3\x06\x24\xc9\x94\x78\xf6\xa1\xd5\x22\x46\xb6\x37\x4c\xe9\xb9\x90\xa2\xa1\xb9\x8b\x47\x1e\x19\x85\x91\x4b\x0e\xd2\xa0\x32\x88\xc2\xab\xd7\xc8\x5a\x6b\xc2\x56\xc1\x5b\xd1\xd9\x69\x17\x63\xf7\x42\x06\x5d\xf4\x16\x13\x1a\x87\xcd\x76\x5a\x8e\x1f\xbf\x7e\x7c\x4e\x46\xc3\x8e\x1d\x53\x36\x6f\x69\xd6\x19\x33\x1b\x3a\x7f\xcb\xe8\x0a\xa3\xcf\x12\xb2\xf4\xe0\xeb\xd9\x2d\x5f\xdb\x3b\xeb\xda\x07\x41\xe5\xd4\xf2\xd0\x24\x5e\xdd\x00\xa5\x25\xc3\xc2\x50\x28\x72\x1a\x25\x51\xaf\xa5\x69\x08\xd2\x48\xa2\x5e\xba\x56\xe7\x81\xd8\x05\xe0\x86\xe0\xf7\xdb\x2f\x8f\xcb\x69\xf1\xb8\xbc\x9a\xd5\x27\x23\xb5\xda\xfe\x32\x8f\xab\xed\xfd\x62\x35\xfb\xb2\x73\x60\x8a\xfd\xfa\xb9\xbd\x8e\x69\xbf\xb4\xc3\xab\xe6\xc2\x97\x07\x93\xbd\xbf\x86\x97\x9b\x29\xf6\x1b\x59\x2d\x56\xdf\x5e\x5f\xda\xed\xb7\xeb\x98\x1e\xe2\x78\xf8\xa8\x75\x2c\x6e\x4d\x83\x1c\x1d\xb0\x77\x68\x99\xb0\x67\x35\xd1\xf9\x17\xb4\x1e\xc0\x69\x58\x83\x6b\x72\x56\xdf\x22\x67\x17\x24\x05\x04\xba\x4b\x60\x93\x59\x6d\x53\x2b\x04\x14\x25\x4b\x29\x59\x74\x38\xaa\x54\x0f\xad\x5e\x39\x27\xf6\xee\xcd\xa2\x75\xb6\x42\x23\x97\x4a\x7c\x7b\xe0\x57\xdb\xfb\xb1\x9e\x16\x4f\xff\x3e\xb7\xe0\x32\x27\xd1\xc6\x99\x22\xb0\x87\x55\xc5\x5e\x73\x2d\xaa\xc2\x5a\xdc\x6b\x81\x64\x9a\x9d\xae\xe5\xce\xef\xc9\x4d\xbd\x86\xb4\xea\xea\x29\xb7\xe2\x75\x68\x2d\x2c\x0c\xde\x92\x57\x42\x6d\x23\x92\xd9\x18\xda\x19\x92\xb4\x56\x6a\x8f\xd6\x12\x46\xe3\x04\x23\xdf\xd2\xb4\xd9\x80\x4e\x62\x8f\x8b\xd3\xdf\xcf\x49\x8d\x23\xa2\xca\x00\xe1\xc6\x24\x32\x34\x12\x52\x96\x64\x43\x7d\xd4\xda\x03\x86\x76\x61\xb9\x56\xb3\xfe\xee\x6c\x53\x45\x18\x81\x73\x68\x99\x46\x22\x49\x56\x62\xd4\x8a\x86\xd5\x92\x68\x89\xa0\xa4\x26\xad\x8b\xe4\x34\xa2\x37\xcf\x19\x30\xa9\x63\x87\x4a\x71\x53\xea\xfb\xed\xbc\xac\xa7\xad\x1e\xc7\xdb\x9a\x36\x77\x39\xf5\x78\xd6\x86\xbf\x1d\xb6\x9b\x9d\x3e\xb7\x2e\x2e\x9d\x1a\xf6\xe5\x3e\x36\x5f\x4e\x4f\xf6\x38\xd8\x7e\xb9\x9b\xb6\x2f\x04\x67\xbb\x9f\xce\xf9\xd2\x0b\x9d\xf9\x08\xbb\xcf\x55\x47\xca\x09\xd5\x98\xa8\x91\x57\x2e\x65\x8e\x30\x05\x6b\xca\x40\x75\x0c\x77\x1f\x57\xda\xf4\x01\x27\x05\x6d\x04\xd6\x12\xc1\xa9\xcb\xb0\xc6\xd4\x72\x89\x6e\x25\x6a\x76\x45\x31\x13\x95\x41\x2d\x63\x75\x1d\x08\xad\x66\x69\x5e\x67\x06\xce\x15\xeb\xad\x18\xef\x7e\xbb\x92\xcd\xfd\x3b\x23\x7c\x39\x84\x17\x04\xef\xa9\xb5\x90\xcd\x8f\x57\x88\x1f\xf7\x32\x9d\x51\xbf\x9f\xf0\xb4\x5c\xc7\x61\x92\xf5\xee\xa3\x43\xaa\xc5\xc3\x25\xcd\xf1\xb1\x16\x6b\x49\x29\x39\x62\x55\x90\x51\x78\x64\x17\xe2\x41\x58\xaf\x14\x15\x3e\x17\x3d\x16\x49\x56\x55\x4b\x4f\x44\xa9\x4b\xc5\xde\x66\xae\x27\xd6\x5b\xd5\x40\x31\x80\x8e\x94\xd9\xa4\x4b\xc6\x52\xbb\xcf\xdc\xa8\x77\x0c\x76\x4a\xe9\xb6\xd1\x3a\x0d\xf3\x61\x23\xbb\xdd\x27\x5d\x24\x46\xa5\xd1\x09\x89\x5c\x2d\xa7\x86\xe1\x03\xfb\x29\xae\x9a\x19\x41\x69\xc8\x8d\x07\xbd\xc3\xf2\xac\xf1\xd0\x04\x49\x23\x77\x21\xf6\x4a\xcd\x4a\xb7\xaa\x02\x3e\x53\x9a\x2e\x52\x09\x86\x50\x55\x6a\xec\x4c\xec\xad\x50\xcb\xda\x7c\x8c\x1e\xb7\xbd\xe1\x7e\xb9\x5a\xc9\xaf\xc8\xe7\x53\x12\xa6\xae\x34\x0a\x16\x65\x6c\x10\xe1\xa3\x0e\x1e\xc9\xa2\x57\x53\xce\x3c\xd3\xda\xdc\xa4\xe0\x27\xb4\x27\x5a\x1e\xa4\x09\x08\x21\xd1\x80\xae\x06\x19\xc6\x1c\x0d\x36\x67\x2e\x9c\x13\x59\xaf\x9c\x47\x97\x56\x91\x7a\x97\x8e\x52\xfa\xa8\xd0\xa8\x0e\x1a\xef\x88\xbd\x3e\x7e\xff\x9c\xc8\x91\x5b\x42\x0d\xc7\x36\x92\x67\x6e\xad\x69\xd2\xdc\xb4\xd6\xa2\x15\x7b\x8a\x1e\xa3\x0f\x1d\xd7\x22\xd7\xaf\xf8\x7b\x91\x4b\x76\x4f\x5d\x20\x75\x2a\x8a\x89\x6a\x54\x20\x07\x05\x47\x14\xe3\x42\xc4\xde\x11\x63\xa6\x58\x75\x98\x31\x1a\x08\x24\x6a\xd1\x09\x7a\xbf\x6d\x90\x9f\x44\xfe\x2b\xf4\xb0\xb5\xc7\xf8\xe4\x5c\x87\x14\x87\x51\x3b\xa8\xa8\x5a\x30\x15\x32\x36\x1a\xd9\x09\xb8\xc8\xcc\x03\x3a\x0f\xd2\x6b\xc1\xf1\x3d\x4f\x64\xc9\x81\x45\x6b\xd1\x8c\x16\x3c\x82\x6c\x14\x77\x0b\x95\xd6\x9d\xd4\xb8\xa7\x36\x52\x82\xea\x5a\x15\xa0\x67\x62\x76\xc9\x6e\x86\xc5\xe1\x96\xa5\x7c\x90\xc3\xc3\xd2\xb6\xfb\xdd\xe2\xc1\xae\x32\x23\x4f\x36\xee\xeb\xb3\xb1\x7b\xb0\xd5\x42\x0e\xd3\x79\x73\x27\xfb\xc3\x4b\x08\x7b\x42\xf6\x27\x26\x77\x0e\x1d\x4c\x36\x9b\x57\xd0\xb4\xb7\xed\xe6\xdb\x39\x34\x6d\x1f\x63\x73\xee\xec\x5e\x3d\xfc\x04\xbd\x7a\xd4\x09\x7b\xb9\xf1\x23\x01\x97\x69\x0d\x52\x26\xc6\x0c\x66\x00\xc0\x51\x32\x12\x5b\x91\x9a\xfa\x60\x23\x10\x2c\x6f\xf8\xb2\xf4\xde\x0c\xf5\x96\xbc\x94\x99\x2d\x40\xd3\x1e\xb5\xb3\x37\x2e\x3c\x62\xd4\x28\x39\x86\x5a\xeb\x2d\x28\x0d\x4c\xc9\x14\xa2\x25\x57\x13\xc6\x54\x04\xbc\xea\xad\xa5\xb9\xdc\xd8\x76\x73\x78\xd8\xc7\xb7\x10\x5d\xc5\x62\xbd\x3d\x1e\x62\xda\xcb\xee\x73\x4b\xb4\xd5\x8a\x55\x82\x8d\xc1\xca\x40\x81\x34\x72\x31\xf1\x6e\xd1\x73\x48\x41\xe3\xac\xda\xde\xf0\x3c\x9f\xf0\x3b\x99\x15\x9b\x43\x3e\x25\x70\x0b\xc2\xe0\x0e\x99\x6b\x13\xea\xb5\x0e\x95\x4c\x6e\xe0\xc5\x47\xc9\x52\x23\x46\x64\x6d\x42\xa5\x14\xd3\xd4\x10\xca\x8d\x21\xf9\xdb\xfa\x61\xeb\xf7\x71\x98\xe3\x8f\xe5\xfd\xf6\x93\xc1\x59\x41\xe7\x48\x26\x09\x73\x20\x70\x07\x4c\xc0\x75\xf4\x82\x2d\x81\x6a\x31\x22\x6f\xca\xff\xbc\xd4\x52\x4b\x64\xcc\x6e\xa9\x6a\xcb\x9d\x31\x48\x95\x3c\x95\x64\xe1\xa5\x91\x95\x5a\xc8\x0b\xd5\x94\x7b\xce\xb9\xf7\x3c\xa8\x73\x2e\xa2\x3a\xea\x68\xb7\xb2\x2a\x8f\xfb\x7f\x29\x36\xd0\x5e\x22\x27\x31\x27\x20\x11\xb7\xa2\xad\x67\xf1\x32\x46\xcf\x32\x46\x46\x66\xf5\x11\xef\x99\x21\xaa\xdd\x5b\xc3\x26\x82\x15\x2d\xa3\xfa\xe8\x92\x47\x73\xed\xb9\x42\x19\x4d\x46\x0a\xaf\x15\x4a\x2b\x8a\x85\x7a\xf7\xa8\x99\x0c\x53\xc2\x62\xb7\xf2\xd0\x6b\xb9\x5f\xda\x76\x23\xcb\xfd\x4c\xda\x76\xb1\x9f\x96\x71\xbd\x07\xf1\xc1\xa0\x3b\x53\xce\x15\x4f\x3b\x21\x4d\x87\x20\x76\x28\x6c\x15\x7b\x99\x67\x18\x13\xd8\xd0\xeb\xd8\xef\x03\xd1\x40\xe2\x0e\x94\x70\x5e\xc5\x15\x20\x1b\x5b\xb7\x22\x96\x13\x50\xcf\xbd\x40\x27\xb2\x3e\x9b\x65\xe9\xd6\x70\x54\xae\x9a\x43\x67\x97\xef\x29\x4a\xd0\x4d\xe1\xa7\xe9\xb4\xd9\xb4\x3c\xc8\x34\x7d\x92\x48\x55\x93\xe2\x3a\xa8\x78\xee\x11\x02\xc2\x8c\x9a\xad\x21\x4a\xab\x42\x0e\x56\x4c\x91\xec\x3a\xf4\x4b\x5f\xdf\x49\xb2\x8c\x41\xa1\x12\xb9\xd8\xd0\x91\x4a\x73\x4a\x14\x01\x94\x3c\xb0\x45\x4d\xd4\x52\x74\xcb\x5c\x34\x6b\x1f\xae\xda\x30\x8d\x51\xc5\x1a\x70\xa7\x71\x2b\x87\x36\x8b\x3d\xed\xf6\xdb\xa3\xff\x24\x92\xff\xfb\xcc\xd7\xff\x37\xbe\x4f\xb1\x99\x3f\xf1\x7a\x0d\xec\xf4\x94\xc3\xff\xc8\x42\x00\xcc\xa5\x5b\x60\xf7\x28\x29\xc2\x13\xe4\x2a\x82\x5a\x4b\xf1\x14\x91\x2a\xa5\xa0\x7a\x35\x22\xb3\xa9\x7f\x87\x78\xb5\xae\xaa\xa0\x42\xda\x2c\x0f\x0c\x4f\x21\x10\xa2\xd4\xd4\x3d\x77\x62\x09\x6b\x01\xad\x75\x00\xaa\x39\xdc\x0a\x00\xcf\x2c\x2c\x69\xaa\x72\x8b\x78\xad\x97\x93\x3d\xc4\x6a\x75\xca\x2b\x3e\x6c\xd7\xe1\xcb\xfd\x27\xf7\x1e\x03\x66\x12\xdd\x35\x63\x22\xa5\x0a\xd6\x38\x7a\xd0\xa8\xac\x63\xcc\x71\x47\x85\x4e\xa3\x7c\xc2\xcd\x51\x56\x2b\xe6\x85\xa1\x98\x37\x73\x42\xc8\xcc\x9c\x9c\xa0\x25\xaf\x6e\xcc\xa9\x36\x89\x0c\x46\x95\x72\xaa\x08\x0a\x5d\x33\x0d\xcb\x29\x43\x7f\x57\xf6\xb5\xec\x0e\xd3\xfe\x68\xd3\x71\x1f\x9f\x4c\x83\x50\xae\xc2\xc8\xe1\xee\xca\x42\x9a\x8b\x7a\xed\x15\xdc\x8a\x70\x2d\xcd\xb4\x10\x8f\x78\x8b\x72\xbf\xc3\x3f\x99\x5a\x01\x8f\x2c\x03\x6b\x12\x46\x33\xb2\x36\xf2\xc8\xa9\x42\x74\xc2\x99\x91\x4b\x07\x25\x57\x4c\x7e\x7a\xad\x4b\x2b\x3a\x0f\x53\x6d\x37\x13\x02\xbb\x58\xad\x62\x5a\xc6\x7e\x9e\xf9\x69\xbb\xbe\xde\xa4\xfa\x98\xed\x4b\xe0\x80\x2d\x8d\x91\xa3\x14\x94\x2e\xd4\xcc\xdd\xc0\x18\xdd\x00\xe6\xa5\xd9\x81\xdf\x98\xf6\x77\xf9\x67\x49\x01\x4c\x0c\x4d\x98\x40\xba\x8e\x5e\x9b\x79\x22\x0f\x94\x52\x45\x06\xf4\x54\x8d\x92\x0d\xc6\x08\x1d\xc5\xa0\x8e\xd6\xcd\x67\x9f\xda\xca\x2d\xc3\xbf\x7b\xbc\x5f\xc4\x7e\xbf\xdd\x7f\xd2\xda\xd7\x42\x31\x52\x2a\xc4\xe1\xbd\x08\x00\x03\x37\x1d\xda\xbd\x63\xa2\x54\xd0\xaa\x8f\x9a\xdf\xd8\x86\x7d\xdf\xda\x27\xec\xa6\xc0\x8a\x51\x2c\x03\x20\x22\x99\xf7\x06\xbd\xa5\x81\xce\x36\x0a\x2b\x6b\xc1\x56\x29\xc3\x48\xb9\xe4\x2e\xbd\x07\x17\x8d\x5e\xc7\xcd\xa9\x5e\xc7\x3f\x64\x3f\xdb\xbc\x2f\xbe\x1c\x63\xb5\xd4\x2b\xc9\x9f\xf1\x8f\xd0\x39\xc6\xd6\xab\xb5\x92\x92\x40\xae\xd8\xd1\xa0\xd6\x52\x2b\x49\x24\xe9\xae\x8c\x60\xd5\xde\x88\x2e\xdf\x55\x74\x03\x49\xf3\x83\x06\x57\xc8\x91\x3a\x06\x60\x62\x1b\xd8\xbb\x8e\x5a\x46\x49\xb5\xbb\x79\xb5\xde\xa4\x16\x1d\x33\x9f\x1c\x29\x55\xf1\x82\xa8\x72\x2b\xe2\x78\xc9\xe6\xfe\xdc\x0a\xfb\xdf\x27\xeb\x7f\x33\x49\x73\x9e\xfb\xbd\x40\x4e\x8f\x7a\x98\xa6\x0f\x67\x5d\x24\x70\x76\x49\xe6\xce\x49\x07\xa0\x39\xe4\x22\xde\xd8\x21\xd7\x2a\x75\x9e\xd1\x51\xf3\x1b\x26\xe0\x3d\xca\x87\x4e\xe6\x15\x5c\x84\xa8\x12\xf4\x16\xe0\x5c\x55\xa2\x16\x57\xb5\x9a\x7a\x72\xb1\xd1\x0a\x94\xd2\x83\x2b\xb2\xd5\x32\x9c\x33\xa6\xac\xc9\x6f\x11\xdd\xeb\xb1\x5a\x6f\x3d\xae\x8d\xc3\xfd\xf6\x43\x3b\x6a\x96\x7b\x03\xef\xcc\x90\x74\x68\xc6\x41\xc3\x44\x51\xa4\x28\x87\xd5\x1e\x95\x84\x79\x7c\xaa\x3a\x21\xb3\x24\x13\x07\xce\x62\x85\xb5\x06\x16\x82\x1a\x69\x30\xcf\x3c\x10\xbc\x19\x36\x28\x18\x24\xd4\xa3\xab\x43\xf6\x18\x8a\xce\x62\xb7\x7c\xc2\xb9\xf8\xdb\xf5\x7a\xbb\x79\x7b\x8d\xc4\xf7\xdd\xcc\x8a\x7f\xae\x8f\x5f\x1b\x09\xba\x9c\xf4\x38\xc7\xf2\x5f\xb7\xfb\xfb\xc5\x5f\x7f\x2d\xee\xb7\x72\x9c\xb6\x9b\xf8\xb5\x85\xf1\x34\x98\x1f\x4d\xd9\x99\xb6\xe2\x91\xba\xe7\x26\x05\x9a\x69\x6d\x9d\x23\x0c\x66\x15\x80\x94\x4a\x9a\xc9\xd1\x27\xa2\xa4\x18\x6d\xf6\xa6\x0c\x38\x98\xad\x71\xc9\xc5\xd9\x12\x57\xea\xd8\x4a\x6d\x5d\xad\x53\xea\x29\x34\xb2\x68\x46\x8d\x99\x6c\xa5\x50\x0b\x0a\xb8\xc9\xaf\x2e\x15\xc5\xc6\x95\x85\x7d\x15\xde\xff\x1a\xb8\x13\xc1\xfa\x09\x6e\xc6\x2f\xc5\xfb\x3e\x3e\x9c\x31\x86\x4e\x5a\xb0\xf7\x02\xd9\x51\x92\x7b\xea\xe9\xe4\x0d\x38\xb9\xc7\xac\x83\xc8\x41\x12\xef\x18\xa0\x42\x54\xa5\x94\xc8\x94\x94\x92\x14\x4e\x54\x8a\xb3\xa6\x1a\x3c\x54\xd8\xb0\x57\xc3\x79\xfc\x53\x94\x36\xa8\x8c\x2c\x8c\x50\x88\x1b\x72\xbf\x35\x2e\x7b\xdb\x6f\xff\x5a\xc5\x8f\xd9\x02\x3f\xef\x51\x7d\x2e\xdb\x83\x2d\x95\x80\x4a\x50\x29\xe6\x68\x31\x55\x1c\xc4\xc0\x5a\x14\xb9\x70\x09\x1e\xd2\xf8\x1d\x33\x5b\x65\x76\x5b\x2a\xad\x16\xa1\xea\xd8\xa8\x77\x9e\xd5\x71\xe4\x5e\xba\x63\xa9\xdc\xbd\xf4\x52\x70\x9e\xf0\x4e\x11\x4d\xf2\x53\x25\x10\x61\xb5\x5b\x1b\x2b\x87\xdd\x80\xbc\x90\x11\xfb\xab\xf8\xf8\xd5\xbc\xaf\x63\xfd\xd1\x79\xf5\x92\x3a\x81\x89\x24\x6f\xa5\x65\xce\x2e\x30\x86\x62\x1f\x6d\xb0\x77\x2d\x11\x63\x84\x5e\x79\xdb\x0f\x10\xab\xd9\x58\x34\xd0\xf0\x46\x05\x6b\xe7\xdc\x06\x35\x2f\x31\x7b\xe0\x14\x09\x98\x66\x79\x73\xae\x43\x5a\x76\x15\xeb\x3d\x75\x2e\x50\x4b\x30\x18\xdc\xa2\xd4\x4f\xc3\x60\xf2\xd9\x6d\xea\xce\x95\x72\x26\xed\xd6\x20\xb5\x8c\x80\xd8\x23\x9f\x12\x9c\x26\xca\x3d\x63\x23\x50\x79\x23\x88\x78\x97\x51\xb5\x31\x2c\x71\xcf\x29\x93\x5a\x0b\xe4\xe6\x92\x6b\xef\xce\x23\x80\xbd\x26\x29\x98\x82\x2d\x77\x8d\x81\x79\xf4\x42\x46\xb9\xf5\x1e\x5e\x25\xdd\xa4\x92\x3f\x25\xde\xea\x5e\x3e\x99\x21\x52\x34\x12\xe3\x61\xa9\x0c\xa3\x18\x32\xb4\xa6\x96\x52\x83\xec\x65\x48\x4d\xac\xad\xb5\xab\x24\x66\x7a\x3f\x6e\xaa\x3d\xa4\x04\xe6\x06\x99\x3b\xbb\x61\x52\x50\x0f\x27\x67\x17\x34\x1a\xac\x01\x65\x0c\xee\x9d\x34\x62\x94\x0e\xbd\xf4\x70\x87\x6e\x58\xed\x56\x4d\xc2\x93\xc8\x7f\xfb\x4b\x56\x53\xec\xff\x0a\x99\x1e\x62\xbf\x96\x2b\x6f\xf1\xd1\xc2\xbe\x52\x52\x8d\xd2\xba\x49\x45\x4e\xa7\x7d\xcc\xda\x87\xe5\x3c\xf2\x48\xa5\x40\xcb\x96\xa4\x7e\x8a\x51\x29\x40\x42\x2c\x10\x6d\xd4\x42\x6a\x3d\x92\x21\x4a\x64\xc2\xe8\x23\xb9\xd5\xd2\x8b\x56\xd3\xe2\x90\x71\x08\x0f\x2c\xd0\x4a\x24\x00\xbe\x59\x6e\xf3\x24\xfe\x6e\xac\xe4\x8a\x44\x7d\x70\x13\x86\x3b\x74\x1c\xb5\x5b\xad\x96\x08\x91\x43\x53\x16\x83\x06\xa2\x51\x23\x27\x8e\x68\x6f\x6d\xa0\xa6\xaf\xf9\x9d\xdc\x41\x0f\x90\xda\xba\x20\x35\x08\xac\x34\x8a\x0e\x06\xc9\x41\x91\x51\xa4\x2a\x94\x56\x84\xc8\x34\x85\xf4\xc2\x8d\x92\x19\xb4\xd3\x66\x81\x73\xfd\xad\xc8\xdf\x96\xbb\xf8\x64\x80\x8c\xa4\x39\x89\xa4\x9a\x87\x41\x8f\xd2\x6b\x45\xed\x95\xb9\x22\x61\x88\xba\x0d\x1c\xe9\x29\x5f\xf5\x4f\xce\x72\x8b\xee\x58\x1b\xa7\x64\x6d\x48\x1b\x99\x00\x59\x50\x72\x23\xae\x91\x7b\x78\x1a\x00\x26\xad\x54\xaf\xce\x2a\x0d\x1b\xf7\x14\x01\xb9\x0f\x1e\x37\x45\x9e\xf6\x31\xd9\xc3\x7e\x31\xc5\xe1\xad\x92\x94\x27\x63\x2e\x87\x43\xec\x7f\x71\xa1\x7d\xfc\xfd\xb8\xfc\x59\xc4\xf6\x11\xb7\x8d\x5a\x47\xcb\x51\x53\x2f\x2e\x9e\xba\x34\xab\x41\x8d\xb0\x17\x85\xd6\xc2\x64\xe8\x1b\x65\x13\xb3\xb1\x7b\x47\xf3\x35\xdb\x53\x50\xa6\x50\x13\x48\x2f\x75\xa6\x50\x79\x64\xb0\x94\xa5\x71\x85\x5e\xd1\x14\x8d\xbb\xdb\x48\x4c\x6c\xa5\xe5\x5e\x72\x54\x67\xc1\x9b\x9a\xff\x63\xe3\xd3\x1c\x34\x9f\x2a\x51\xfc\x2a\x94\xfa\x59\x99\xf2\xf3\xe2\xbf\x5f\x34\x17\x26\xf6\x10\x57\xe0\x76\xbd\x93\xfd\xcb\x16\xc3\x33\xfe\x33\x48\x7d\x85\x8e\xe5\x6a\xba\xee\xbb\x9c\x62\x2f\x67\xbb\xef\xcf\xf8\xdf\xb6\xc7\x99\x60\xbd\x86\xd7\xb1\xbe\xfe\xb8\xed\x6e\x7a\x0d\x1d\xa6\xed\x5e\xee\xaf\xbe\x78\x12\x5d\x5d\x81\xbf\x38\xdc\x87\x32\xe1\x56\x67\x16\x13\x1c\xb9\x0d\x01\x68\x83\xb2\x91\x70\xaa\x9e\xb5\xe6\xc4\xc9\x7b\x29\xf4\x8e\x6d\x4f\xa4\x95\x4a\x1d\x79\x94\xdc\x0a\x55\x26\xe4\x1e\x86\x9e\x0b\x7a\x0e\x26\xd4\xea\x0c\xe6\x83\x47\x2e\xac\x21\x9c\x81\x46\x6d\xcd\x1b\xf1\x2d\x07\x3e\xc5\xc6\x63\xbf\x5e\x6e\xa6\x7f\xb1\x46\x39\xa8\x97\xc4\x85\xc7\x28\x63\x8c\x64\x54\x07\x6b\x15\x2a\x10\x88\x5a\x33\x75\x08\x61\xff\x44\xf4\x93\x7a\x13\x91\xc6\x98\x82\x3c\xe9\x68\x4c\x73\xfc\xaf\xd4\x2c\x12\x58\x26\x9b\x9d\x3c\x57\xd1\x92\x79\xd6\x75\x00\x8c\x2e\x12\xdd\x4a\x6b\x78\xcb\xac\x9f\x49\x1e\x8e\x44\x70\x55\x48\xf9\x8a\xc8\x85\xff\x25\x7b\x3f\x3c\x75\x7d\xc6\xbe\x4f\x7b\x79\x41\x3e\xc2\xf1\x7a\xee\x2d\x21\x39\x2a\x3b\x50\xb7\x51\x62\x68\x6a\xd1\x42\x47\x87\xa2\x66\x81\x89\xde\xa9\x4f\x43\xe3\x06\x52\xc8\x3a\x9b\xa0\x99\xb5\x4c\x32\x28\x31\xb0\x79\x44\xa2\xa1\x6e\x54\xbc\xe8\xa0\x54\x91\x52\xcb\x95\x73\x4e\x29\x32\x84\xf8\xad\x88\xf0\x6c\x4c\xee\xb7\x5f\x64\xbd\xdc\x7c\x72\xf7\x67\x88\xd4\x68\x19\xb8\x94\x40\x6d\x5a\xa5\x54\x71\x4c\xc8\x09\x3b\x41\xf4\x99\x6a\x6b\x5c\xa5\x08\xd3\x57\x78\x97\xd8\x51\xce\xdc\x12\x77\xaf\x62\xda\x2a\xe4\x4c\xbd\xf6\x6c\xd6\x8b\x04\x31\x76\x90\x9a\xac\xa6\x9c\x47\xd2\x31\x2a\x0e\xe9\x9e\x07\x36\xb6\x6a\x25\xdf\x4a\x9c\x9c\xc9\xbe\x94\x6f\x9f\xcc\x10\x66\x31\x63\x18\xda\x3b\x80\xcf\x44\xaf\x24\x96\x4e\x24\x01\xa3\xc7\x69\xfb\x92\x7b\xe0\x5b\xc7\x16\xde\xdc\x01\xbc\xdc\xf7\xe2\x08\x75\x20\x4d\x94\x0a\x8d\xe1\x0a\x49\xb8\x97\x48\x09\x86\xb8\x51\xad\x75\xb4\x92\x7b\xf6\x98\x03\x43\x8e\xa0\x5e\x31\x82\xd9\x86\xdf\x8a\xd7\xce\xe4\x7e\xf9\x79\xc3\xfb\xa9\x2d\x17\x97\xc5\xeb\x27\x28\xbe\xcb\x7a\xb7\x8a\x85\x6d\x3d\xde\xbc\xf0\xf8\xed\xa2\xda\xfb\x74\xed\xb2\x44\xfc\x04\x5d\x14\x09\xe9\x6a\x6b\x8f\xf6\x20\xcb\xcd\x79\x21\xfb\xd9\xd7\xda\x76\xbd\x96\x8d\xbf\x14\xa9\x5f\x94\x97\xdb\x76\x73\x88\xcd\xe1\x78\xb8\x02\x2e\x5f\xf3\x66\xed\xbb\xec\xd7\x2f\xee\xe5\x27\xf6\x6c\x24\x5e\xa1\x1b\xdb\xfa\x72\x73\xbf\x78\x52\x96\xcb\x8b\xeb\xd8\x3f\xbe\xf8\x8e\x67\xf0\xb8\x9a\x96\x87\xb3\x0f\xbd\x84\x17\xba\x9c\x64\xbf\x97\x1f\xaf\xae\x1f\xc2\x76\x48\xf5\x11\x5e\xe1\xd3\xfa\x41\x0e\x0f\xaf\xc0\xef\x07\x59\x1d\x04\xd3\xe1\xc7\xfa\x29\x04\xff\x65\xaf\xbe\x2d\x3d\x36\xf6\xe2\xcf\x96\x7a\x58\xc8\x71\xda\x8e\xe5\xea\x12\xfc\x79\x6a\xe3\x1c\x3a\x2b\xb6\x7f\x6e\x2f\x66\x56\x7c\x78\x8d\xbe\x14\x31\x3c\x21\x4f\xc9\xa5\x73\xe8\xcc\x21\xcf\xcd\x57\xde\xff\x04\x7d\x8b\xcd\x74\x09\x8d\xd5\xf6\xaf\xbd\x4c\x97\xdf\x79\x5e\x32\x3b\xb7\x77\x47\x3d\x1c\xf5\x0d\x68\xf1\xf7\x63\xec\x7f\xbc\x5c\x38\x7f\xce\x14\xaf\x96\xf5\x09\x7a\xfd\x55\x53\x2c\x76\xfb\xed\xf7\x1f\x67\x91\xfd\x6e\xbb\x7d\xc9\xe5\x9c\xad\xfe\x1d\xee\xce\x7e\x2e\x6c\xbb\xd9\x9c\xb7\x77\xf1\xfd\xbc\x79\xdc\x6d\x5e\xba\xef\x97\xdf\xbe\xbd\x90\x98\x8b\x17\xee\x77\xf6\xea\x3b\x4f\xc8\x99\x66\x3d\xb7\x2f\xd7\xf8\x8c\xde\x9f\x1d\x1b\x99\xdb\xab\xa5\xbe\x6a\xbe\xf1\xe8\x19\xbd\xd4\xd3\x67\xf4\xe2\xf9\x87\xe9\x6c\x56\x4e\x8d\xc5\xf4\x7d\xb9\xf1\x17\x29\x2f\xc0\xc5\xe3\xb7\xb7\xf1\xcd\x71\xb5\x7a\xf3\x44\xc8\x4b\x19\xe0\x5b\x67\x44\x3e\x52\x25\x66\x6c\x19\x19\x8d\xa1\x57\x68\x15\xcb\x98\x99\x94\x9a\x33\x85\x85\x78\x40\x57\x7b\xab\x72\x17\xe9\x33\x95\x19\x22\x63\xa4\x32\x24\x81\xb3\x76\x2c\xc3\xa3\x36\xe6\x46\x6c\x46\xdc\x34\x8b\xe5\xda\x40\x59\x4e\x47\xdb\x8a\x61\xd7\x4a\xd4\x3c\x45\xcc\xe1\xc0\x85\xad\x3e\xe5\xef\x4f\x59\xd6\xef\x8b\x9f\xe6\xea\xed\x53\x46\xa7\x8b\x67\xf6\xf3\xaf\xb1\x3c\x33\x0d\x0f\x62\x0f\x82\x69\xb7\x5d\xfd\x80\x9c\xe8\x17\x7e\xdc\x7f\x8b\x0b\xd3\xf6\xf0\xe8\xe3\x2a\x6b\xf9\x7c\xfb\xd5\x85\xc3\x51\xa7\x17\xdb\xb1\x11\x5b\x2d\x74\xfb\xfd\xa2\x7d\x08\xdb\xc7\x74\x86\x6e\x77\xb1\xd9\xdd\xef\x2e\xed\xec\x33\x78\xa9\x76\x3b\x7d\xf4\xf1\xcb\x0e\xbd\xfe\xf8\xfd\x72\x17\x6b\x87\xfa\xeb\xb3\x7e\x1a\xbe\xc5\xe9\xff\x47\x57\x47\x6e\xb5\xcc\x1c\x39\x17\xa1\x82\x92\x25\x46\x2b\x5e\x35\x61\x64\xd2\x68\xa2\x9d\xb3\xea\x29\x40\x3f\x6c\x8f\x7b\x3b\xcd\xcb\xc3\x34\xed\x0e\xff\xb1\x58\xbc\xed\x4b\x9f\x27\xea\x77\x1e\xdd\x73\x1d\x44\xc2\xcc\x54\x92\x23\x6b\x0d\xc9\x86\x21\xc8\xde\xc0\x5a\x55\x70\xee\x94\x87\x9b\xe7\x20\x23\xd3\xd2\x73\x1d\xa8\x02\xd0\x07\xdc\x5c\x25\x9b\xeb\x0a\xbb\x5f\x4e\xf0\xfc\x90\xd9\x2c\xc2\x62\xfe\x73\x7f\x7c\xb1\xb5\x73\x1b\x2f\x1a\x8b\x87\xf9\x49\xbf\x66\xde\x37\x72\xb5\x0a\x4e\x25\xba\xb1\x5f\xbe\xe8\xec\x26\xa6\xf3\x4c\xf7\xb4\x17\xfb\x70\xa4\x8c\x8c\x5a\xb2\xea\x68\xa6\xdd\x33\xb9\x8f\x92\x46\x77\x82\x94\x62\xe4\x9e\x9b\x59\x78\x7e\x23\x98\xb8\xd8\x53\x55\x6f\xa4\x20\x08\xac\x46\x9c\x34\x91\x71\xab\xaa\xa1\xa3\xb4\x51\x82\x39\x67\x28\xa1\x4a\xd6\x4c\x07\xc6\x88\x44\x92\x58\xa0\x17\xa7\xdb\x4a\x78\xf8\x71\x23\xd9\x6f\xbb\xe3\xb3\xbc\xc7\xcd\xf2\xfb\x47\xc5\x2d\x01\x23\x06\xd5\xc4\x04\x14\xd6\x04\x2d\xa2\x29\x79\xe4\xa4\x52\x9d\xb5\xe4\x6e\xfa\x8e\xb8\x82\xa2\x15\x7b\xad\xd6\x88\x0a\xba\x43\x77\xd4\x54\x79\x84\x75\x2a\xd4\x9a\x60\x42\x00\x6f\x96\x42\xaa\x44\x2b\x35\x35\x14\x70\xb3\xb9\xf3\x4d\x71\xdf\x2a\xce\x7d\x5e\x4e\xab\xd5\x99\xe9\xff\xd9\x5c\x9c\x8e\xde\x5d\x5b\x90\xed\x6a\x35\x89\x5e\xe1\xf7\x2f\x75\x89\x2f\xcb\x49\xee\xaf\xb1\xfd\x32\xde\xea\x7b\xb4\x5f\xef\x9a\x3f\xfa\x78\x16\xc3\x1f\xc2\x8e\xfb\x58\xe8\xd2\x97\xfb\xe3\x8b\x91\x9a\xf6\xb2\x39\x8c\xed\xcb\x59\x9a\xe3\x66\x39\x73\xd7\x53\xc7\xd7\x98\xad\x7c\xff\x1a\xdb\xbc\x71\xef\x5e\x36\xf7\xf1\x92\x2c\xf8\x48\x90\x84\x20\xc5\x87\x52\xe4\x3e\xa8\x33\xe5\x04\x6e\x58\x49\xba\xc7\x48\x98\x2b\x99\x65\x4f\xd7\x4e\xe9\xdd\xe3\x24\xa9\x35\x03\x23\x66\x4a\x4d\xb3\xe6\x60\xa0\xea\xd0\x3c\xd7\x80\xa8\xc0\xd8\x23\x58\x29\x0b\x69\xc1\x34\x20\x0d\xec\xa1\x5e\x24\x29\x36\xba\x8c\x15\xb6\xdb\xfb\x55\x7c\x3d\x5b\x12\xf7\xb1\x79\xaa\xee\xbf\xde\x28\x9d\xbb\xca\x6e\x79\x58\xcc\x44\x61\xf6\xee\xc7\xc3\x87\xe2\xa6\x9e\xa3\x2b\x5a\x56\x8e\x5c\xad\xa4\x56\x15\x33\x69\x46\xca\x0d\x19\xa1\x55\x95\xc0\x77\xa4\x46\x17\xcd\x28\x25\x17\x82\xc8\x98\xa2\x70\x4d\x7d\x8c\x42\x05\x87\x0f\xab\x94\x8d\xc9\xcd\x32\x79\x4a\x95\xc2\xe6\x90\x30\xbb\x53\x49\xe1\xad\xbc\x23\xf5\xcc\xa0\x7e\x9f\x22\x50\x59\xc9\xc6\x5e\xd8\xd2\x73\x7b\xa1\x72\x88\x2b\x70\xbf\x3d\x6e\x7c\xbf\xd5\xb3\x08\x67\xeb\x71\x1e\xb0\x6c\xc2\xa6\xe5\xb7\xe5\x74\x16\x0c\x84\xc7\x66\x5a\xca\xcb\xf1\xde\xe7\x08\xe4\x75\x7b\x71\x71\x00\x63\xfe\xf8\x33\xaa\xfc\xac\x3c\x57\xca\xa4\x62\x8f\xdb\xf1\xa6\xfb\xdf\x6c\x62\xf5\x8f\x6b\xed\xdd\xef\x6c\x2f\x9b\x5f\x2a\xf8\x18\xb1\x93\xd5\xf2\x5b\xbc\xb0\xe4\x49\x5c\x26\x79\x61\x04\xeb\xb3\xaf\xdd\xc5\x19\xb7\x8c\xc3\x76\xf5\xed\xba\xbd\xf0\xcd\xe1\x0a\xdb\xc9\xe1\x30\x3d\xec\xb7\xc7\xfb\x87\x73\x32\x79\xc1\x49\x5f\x82\xbf\x49\x76\x17\xca\xbf\xdb\xfe\xcc\xe2\x7e\x24\x6d\x5b\xbb\x54\xe0\xae\xa6\x29\x06\x34\x1a\xcd\xc5\xc2\x92\xf6\xca\x30\x0c\x0a\xb8\xb1\xf6\x37\x76\xe5\xde\xd5\xd3\x5c\x30\xb7\x2e\x56\xdc\x74\xfe\x41\xa5\x74\xf7\x86\x79\xa4\x76\x4a\x67\x00\xe7\x9e\x29\xe3\xa0\x68\x49\x3c\xb0\x40\x46\x2b\xa7\x73\x07\x98\x2e\x73\x5b\xdb\xdd\xe3\xfd\xd7\xe5\x66\xf1\x43\xd6\xab\xaf\xdf\xf0\x73\x19\x0c\x2a\x98\xa4\x6b\x6d\xa5\x78\xd6\x94\x0b\x89\x22\xe7\x51\x87\xa9\x00\x1b\xb7\x3e\x00\xfa\xd5\x91\x59\x7c\x4e\x52\x9f\xe6\xe7\xcb\x3c\xeb\xf3\xdb\x64\x23\xab\x1f\xff\x88\xfd\x97\xe7\xaf\xf4\x38\x55\x56\xff\xc2\x5f\x9e\x01\x7f\xdc\xdd\x2d\x37\xbb\xe3\xf4\x65\xb9\x9e\xe7\xe7\xfc\xb4\xd6\x6f\x4e\xdc\x2f\x5e\x1d\xae\xff\x6d\xdf\xcb\x10\xe7\x03\x5d\x2f\x8e\xc1\x7f\xa4\xff\x59\x98\xf5\x91\xee\x2f\x47\xf7\x7f\xdf\xfb\xad\x33\xfc\xbf\xbf\xe3\xfc\x80\xff\x6f\x7b\x5e\x06\x78\xbf\xed\x7a\x11\x94\xfd\xb6\xe7\xe5\xa1\xfd\x0f\x74\x7d\xe3\x44\xff\x3b\x77\xfd\x3a\xcf\x7f\x77\x73\x83\xf6\xfa\xea\x9b\xd9\xae\xc5\x75\xf6\xe9\xdd\x5b\x5e\x67\x44\x3e\x78\xc3\x45\x2a\xe4\x63\xf7\xbc\xe4\x4a\x3e\xd6\xff\xcc\xcc\xbf\x7b\xc3\xe5\x41\xc5\x27\xd3\xfa\x4b\x55\xef\x77\x87\x2f\xe6\x4f\xe7\x56\x7e\x5e\x3a\xd7\xd6\xff\x1f\x00\x00\xff\xff\x0c\xdc\xd8\x91\xaa\x48\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x44\x8b\x31\xaa\xc3\x30\x10\x05\xfb\x3d\xc5\x03\x17\xae\xbe\xea\xdf\x07\x72\x0f\xc5\x7a\x5e\x2d\x91\x25\xd0\xae\xf1\xf5\x43\x92\x22\xdd\x30\xcc\x2c\xb8\x5b\xa3\xe3\xaa\xb6\x55\xe4\xc9\xbe\x06\x3a\x59\x58\x10\x03\x8f\xd3\x5a\x41\x54\xc2\x8e\xac\x4c\xb8\xd5\xdc\xd5\xba\xbe\xdd\x81\x32\xe8\x7d\x0d\x59\x10\xd3\x54\x39\x91\xd1\x79\x7d\xb7\x24\x49\x2d\x24\xf9\x96\xf7\x7d\xb4\xf2\xa3\xbf\x63\x94\xb3\xd1\xe5\xd3\xc9\xf3\xdf\xa5\x0d\x15\x8f\x1c\x94\xd7\x00\xcd\x1a\x2a\x72\x92\x00\x00\x00"),
 		},
-		"/Gopkg.toml": &vfsgen۰CompressedFileInfo{
-			name:             "Gopkg.toml",
-			modTime:          time.Date(2018, 11, 4, 21, 45, 11, 314750649, time.UTC),
-			uncompressedSize: 1359,
+		"/bare/.gitignore": &vfsgen۰CompressedFileInfo{
+			name:             ".gitignore",
+			modTime:          time.Date(2019, 2, 17, 21, 50, 54, 0, time.UTC),
+			uncompressedSize: 220,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x93\x4f\x6f\xab\x38\x10\xc0\xef\x7c\x0a\x0b\xae\x8d\x8d\x71\x20\xa1\x52\x0e\x7b\xda\xfb\x9e\x76\x55\xf5\xe0\x3f\x83\xe3\x0d\x78\x78\xb6\xe1\xb5\x97\xf7\xd9\x9f\xa0\x79\x6d\x94\x36\x55\x7b\x01\xcb\x0c\xbf\xdf\x8c\x66\xa6\x20\x7f\xe3\x78\xb2\x34\xe1\xd0\x13\x78\x92\xc3\xd8\x43\x56\x64\x05\xf9\x07\x3a\x08\x24\x21\x39\xa6\x34\xc6\x7b\xc6\xac\x4b\xc7\x49\x51\x8d\x03\xb3\xd8\x4b\x6f\x99\x81\x91\xa9\x1e\x15\x1b\x64\x4c\x10\x98\x41\x1d\xd9\x1b\x8e\x0e\x26\x2b\x48\x87\x81\x18\x48\xd2\xf5\x60\x2e\x5d\x06\xf5\x34\x80\x4f\x32\x39\xf4\x74\x55\x06\xf8\x31\xb9\x00\x86\x1c\xc8\x43\x7e\xa1\x9b\x22\x04\x96\x8e\xce\x5b\xa6\x07\xf3\x72\xca\x1f\xb3\x82\x38\xeb\xf1\x56\xfc\x18\xf0\x7f\xd0\x89\x8d\x27\xfb\x6f\x7e\x47\x72\xe5\x92\x9a\xf4\x09\x12\xc5\x60\xdf\x85\xfc\xb5\x3c\xfe\x5b\xa0\x59\x41\x1e\x1e\x34\xfa\x98\x82\x74\x3e\x3d\x2e\x1e\x42\xbc\x1c\x80\x1c\xc8\x2d\x4b\xbe\x06\xcd\x10\xa2\x43\xbf\xc4\x71\x5a\xd2\x32\x3f\xd3\x70\x86\x10\x9c\x81\xdb\xac\x27\xf6\xfc\x0e\x51\xd1\xed\x2b\x62\x0c\x93\x87\xf3\xdf\xe8\x37\x16\xc9\x81\x74\xb2\x8f\xb0\x5e\x59\xdc\x24\x88\x29\x92\x03\x49\x61\x7a\xb9\x9b\xfc\x14\xc1\x6c\x46\xa9\x4f\xd2\xc2\xeb\xa7\xec\xaa\xb6\x0f\xb3\xd1\x18\x07\x8c\xe7\xd7\x26\x9a\x53\x9e\x11\xa2\x82\xf4\xfa\xb8\x44\x1a\x98\xa1\xc7\x31\x5f\x60\x17\xa5\x7d\x88\x3a\x4f\xca\x18\x30\xa1\x9a\xba\x05\x74\x51\xe2\x81\x53\xbe\xd4\xf8\xa5\xac\xe2\xd8\x71\xc1\x34\xaa\x20\xaf\x30\xbf\x4a\x5a\x52\xfe\xa5\x7c\x12\x78\x03\x61\x70\x3e\x31\x8b\x1b\x39\x38\x8f\xd7\x39\xcd\x25\xe5\xd5\x39\xab\x6f\xe0\x9c\x9c\xfb\x8f\x50\xfc\xfb\xa8\xb7\xe3\x35\xb0\xa4\x55\xbd\xf2\xb2\xa2\x20\x29\x48\x1f\x5d\x72\x33\x10\x03\x63\xbc\x23\x3f\x5d\x3a\xe2\x94\x48\x80\x1e\x64\x84\x78\x9f\x15\x5f\x31\xc7\x67\x6f\x52\x58\x5a\xb5\x34\xd6\xa8\x45\x1a\x60\x76\x7f\xac\x7a\xab\x1b\xde\xd4\x1c\x5a\x10\xbb\x4e\x72\xbe\xeb\x6a\xa1\x6b\xd9\x96\x8d\x11\xaa\x11\x65\x5b\x9a\xfd\x76\x5b\x7f\x52\xe6\x3a\x05\xeb\xe2\x3d\xb1\xf8\x1c\xaf\x0d\x5b\xe0\x1d\x74\x75\x53\xb6\x35\xaf\x41\xef\x64\xa5\x01\x76\xaa\x36\x20\x4a\x25\x1b\xd3\xaa\xad\xd8\x6b\xd5\x7d\x66\x40\xdb\x03\xbd\x10\x59\xf0\xeb\xd4\x5d\xbb\xc4\x5e\xc0\x5e\x55\x5a\xa8\x16\x44\xa3\xb7\xe5\xae\x51\x95\xa8\x95\xa8\x6a\xb1\xab\xda\x8a\xef\x1a\x25\xa1\x5a\x9b\x76\x5e\xbb\xf7\x1b\x76\x73\xbf\x7e\x07\x00\x00\xff\xff\x7b\xc9\x7c\x53\x4f\x05\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\xcc\xc1\x4a\x04\x31\x0c\xc6\xf1\x7b\x9e\xe2\x83\xbd\x95\xb5\xfb\x0e\xa2\x07\x41\xf0\xa0\x0f\xb0\x9d\x99\x4c\x27\x10\x27\xa5\x4d\x5d\xe7\xe2\xb3\x4b\x75\x2f\xe1\x0f\xf9\xf8\x9d\xf0\x28\x7b\xaa\xc2\x0d\xab\x55\x94\x6a\xb9\xa6\xcf\x86\xb4\x2f\x28\xda\xb3\xec\x8d\x42\xe4\x6f\xfe\xbf\x3f\x14\xe2\xa2\x4a\x21\x36\x1b\x79\xa8\x4c\x44\x27\x7c\x70\x73\x4c\x43\x3a\xce\x98\xba\xe8\x82\x9b\xf8\x86\x6b\x36\xf8\xf8\x3d\xcc\x57\x0a\x71\xe4\x98\xbf\x75\x2f\xdd\x61\x2b\x7c\x63\x64\xc3\x6c\x5f\x5c\x53\x66\xb8\x99\x9e\xd1\x0a\xcf\xb2\xca\x9c\x54\x0f\xdc\x36\xde\xd1\x1b\xdf\xc9\x57\x71\x7e\x79\x7a\xa6\x10\xad\xff\x61\xef\x9e\x9c\xb1\x9a\x2e\x5c\xe9\xd2\x3c\x39\xd3\x45\x2d\xd3\xef\x00\xde\x27\xf8\x7f\xdc\x00\x00\x00"),
 		},
-		"/app.go.tmpl": &vfsgen۰CompressedFileInfo{
+		"/bare/Dockerfile.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "Dockerfile.tmpl",
+			modTime:          time.Date(2026, 10, 19, 11, 1, 13, 735849500, time.UTC),
+			uncompressedSize: 1314,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x54\x4d\x6b\xc4\x36\x10\xbd\xfb\x57\x3c\x76\x21\xa7\xd8\xee\x39\xb0\x87\x64\x93\x94\x90\x26\x5b\x36\x2d\xa5\xb4\xa5\xcc\x4a\x63\x59\xac\x2c\x19\x49\xde\x8d\x09\xf9\xef\x45\xb2\xf3\xd5\x84\x42\xa0\x27\xc3\x7c\xbc\x37\xef\x8d\xc6\x4b\x84\xd1\x46\x7a\xc4\x0a\xd2\x89\x3d\xfb\x7a\xfa\x34\xda\xf0\x19\x3f\xf6\xec\x75\xc7\x36\x92\x29\xae\xb7\x9b\x3b\x28\x67\xc8\xaa\x33\x32\xbd\xb6\x8c\xf3\x07\xec\x06\x6d\x64\xc9\xf6\x50\x14\x4b\x3c\x70\xc4\xd1\xf9\xbd\xb6\x0a\x52\x7b\x16\xd1\xf9\x11\x8d\xf3\x88\x2d\x4f\xa5\xc5\x6f\x9b\xed\xed\xe5\xcd\x16\x75\xf0\x62\xee\x19\xfa\x29\x07\xb6\x07\xed\x9d\x4d\x84\xc5\xf6\xd7\x7b\x50\xbf\x07\x49\x89\xb2\xb4\xae\x14\x24\x5a\x86\xd2\x31\x75\xad\x5d\x3f\xc2\x59\x33\x66\xe4\xce\xc9\xc1\x30\x3a\xb2\xba\xe1\x10\x43\xb1\xde\xfc\xfc\x3b\x94\xab\x3a\x27\xd3\x27\x0c\x1d\xaa\x3a\xf5\x5d\x73\x14\x2d\x24\xf7\x6c\x25\x5b\xa1\x39\x54\xf8\xa5\xd5\x01\x86\x46\xf6\xd0\x01\x99\x46\x62\xb0\x51\x9b\x17\x08\xe7\x5f\x50\x44\x4b\x56\xf1\x69\xb1\x04\x59\xf9\x8e\x3b\x80\x3c\x63\xcf\x7d\x84\xb6\x20\x5c\x24\x3d\xb7\x3a\x4e\x70\xe8\xdc\x60\x23\x48\x78\x17\xc2\xa4\x35\x54\x59\x61\x59\xe6\xd4\x2a\x8e\x3d\xaf\x72\xed\x69\x24\xaf\x38\xae\x6a\xe5\xea\x7e\xaf\xea\xc4\xaf\x5c\xa2\x81\x74\x47\x6b\x1c\xc9\x24\x64\xdd\xb2\xd8\xe7\x01\x5e\xa2\x2c\x3f\xe8\x02\x29\xd2\x36\xc4\x79\xf2\xef\xb3\x1d\xd8\xeb\x66\x4c\x5c\xe7\x52\x22\xb8\xc1\x0b\x46\x7a\x16\xb3\xbd\xd5\x6c\x69\x96\x9a\xed\x48\x74\x64\x4c\x72\x74\xde\xf6\x2c\x5f\x87\xc9\x9a\x0f\x06\x9c\x22\x38\xc4\x96\x62\xde\x63\xb1\xcc\x62\x7a\x12\x7b\x52\x1c\x70\x6c\xb5\x68\x67\xbb\x65\x36\x57\xb8\xae\xd7\x86\xe5\x24\xec\x3b\xf6\xfd\x47\x95\x77\x2e\xd6\x55\x9e\xb2\x56\xae\xcc\x93\xe1\xcf\x02\x00\xd6\x3f\x6e\xfe\xbe\xba\x3f\xbf\xf8\xe9\xea\x72\xf5\x43\x32\x65\x4a\x96\x07\x94\x46\x36\x86\x54\xc0\xa2\x0c\x28\x8f\x0b\x94\x73\xb2\x7e\x7a\x42\x75\x4f\x1d\xe3\xf9\x59\xa2\xaa\x45\xf7\x16\x4a\x91\x93\x93\xff\x07\x5b\x18\xfd\x09\x5d\x18\x9d\x76\x75\xad\x2d\x19\xe8\x8e\x14\x4f\x17\x3b\x9d\xea\x19\x4b\xc5\x29\x7f\x33\xed\x08\x82\x4a\xc1\x3e\xea\x46\x0b\x8a\x1c\xfe\x75\x6e\x43\x2f\x29\xf2\xa7\xa2\xd7\xdb\x4d\xb6\xbd\x9d\xe1\x81\x3d\x76\xda\x92\x4f\xcf\xae\xf1\xae\x7b\x3b\xf7\xfc\x67\xc8\xcf\xa5\x2c\x53\x66\xf5\x1a\xcd\x3f\x80\xfa\x0b\xdb\xea\x21\xf8\x7a\xa7\xed\x87\xe8\xb7\x30\x92\x3d\x5f\xa1\xcc\x16\x6d\x07\x9b\x07\x94\xc4\x9d\xb3\xd8\x8d\x90\xdc\xd0\x60\x62\xb1\xbe\xbb\xc4\x1f\x8b\x77\x1d\x72\xf1\x57\xf1\xcf\x00\xe4\x82\x0f\xc3\x22\x05\x00\x00"),
+		},
+		"/bare/app.go.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "app.go.tmpl",
-			modTime:          time.Date(2018, 11, 4, 21, 45, 11, 314904737, time.UTC),
-			uncompressedSize: 2048,
+			modTime:          time.Date(2026, 10, 19, 10, 3, 11, 195511882, time.UTC),
+			uncompressedSize: 2676,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\x51\x6f\xe3\x36\x0c\x7e\x96\x7e\x05\xe7\x87\x83\x5d\x78\xce\x7b\x80\x3c\xa4\xed\xe1\x70\x6b\x93\x0d\x97\xad\x2f\xc3\xb0\x93\x65\xc6\xd5\x12\x4b\xaa\xa4\x2c\x09\x82\xfc\xf7\x03\x65\x27\xb1\xdb\xe0\xda\x97\x44\x26\x3f\x92\x1f\x3f\x51\xb4\x42\xae\x44\x8d\x20\xac\xe5\x5c\x35\xd6\xb8\x00\x29\x67\xa5\x68\x20\xa9\x55\x78\xde\x94\x85\x34\xcd\x48\x1a\xdf\x18\xdf\xfd\xfd\xea\xab\xd5\xa8\x14\x1e\x85\xb5\x09\x67\x3f\xc7\x49\x53\xa1\x4c\x38\xf3\xd5\xea\x9d\x8c\x61\x6f\xd1\xbf\x9b\x6f\x37\x12\x9b\xf0\xfc\x01\x58\x29\xf4\x2a\xe1\x4c\x94\x52\x0d\x0a\x07\xd4\x15\xba\x46\xe9\xd0\x3f\x12\xec\xcc\x40\x36\xfa\x03\x21\x6b\x55\x52\xc9\xa6\x31\x3a\xe1\xac\x2a\x9b\x8f\xc6\x54\xe5\x2b\xfa\x3f\xc1\xae\x4d\x9d\xf0\x8c\x73\x69\xb4\x8f\x37\x23\xac\x9d\x8b\x06\x61\x02\xc9\xe1\x00\x45\x3c\x1f\x8f\x11\x33\x1a\xc1\x6c\x3f\xb5\x16\x96\x6a\xd7\x20\xa7\x6e\x3a\x83\x0f\x6e\x23\x03\x1c\x38\xbb\x29\x45\x53\xdc\x0a\x8f\x53\x6b\x39\x93\x95\x84\x9b\x78\x43\xc5\x1d\xfd\x72\xce\x56\xb8\x9f\x09\xa5\x01\x00\x6e\x7c\xb5\x2a\x1e\x9e\x16\xc1\x38\x7c\xc0\x7d\xf4\x4d\xa5\x34\x1b\x1d\xde\xf8\x38\x13\xad\x67\x26\xac\x45\x07\x74\x49\xc5\xb4\x6f\xa2\xa1\xd2\xab\x07\x44\x72\x03\x00\x7d\x15\xed\x27\x3f\x46\xf2\x73\xdc\xf6\xf9\x2f\x37\x5a\x9e\x6d\xe9\xda\xd4\x35\x3a\x58\x9b\xba\x78\x8c\xc7\x1c\xaa\x12\xaa\xb2\x29\xee\x6f\x33\xb8\x69\x03\x0f\x6d\x4b\xe3\x09\xcc\xc4\x0a\x63\x4b\x69\xc6\x59\x49\xbe\xf1\x04\xa8\xf7\x39\x6e\xbb\xf6\xd3\x4e\xca\x1c\xd6\xe7\x84\x79\x4b\xfc\x1e\x97\x62\xb3\x0e\x7f\xee\xee\x91\xd4\x71\xa9\xac\x64\x96\x71\xce\xfe\x17\x8e\x9e\x0a\x4c\xe0\x53\xac\x78\xe0\x8c\x75\xe9\xc6\x40\x65\x72\xce\x88\xc2\x98\x3a\x04\x59\xc9\x9c\x73\x76\xd2\x34\x1a\x49\xb7\x39\x6e\x2f\xd2\xa5\x49\x23\x94\x4e\xb2\xbc\x05\x76\x9a\x8d\xaf\x01\x85\x94\x11\x77\xe4\x71\x0e\x8a\xa1\xe4\x93\x96\xfb\x1c\xb7\x03\xdd\x53\xce\x22\x38\x92\x69\x8f\x97\x32\xd1\x42\x51\x7f\x38\x13\x4c\xec\xe4\xec\xc8\xba\x2a\xbd\x6b\x9b\xb4\xb7\xd6\x69\xd8\x1a\xd3\x37\x4c\x4e\x81\x0b\x0c\x5f\xb5\x0a\x77\xcf\x42\xe9\x0e\xa7\x2e\xdf\x27\xd4\x8c\x02\x63\x8f\xfe\xeb\xf4\xe9\x31\xbd\x70\x24\xcd\xae\x52\xa6\x50\x74\x8e\xae\x94\x7c\x8f\x46\x54\x8f\x22\xa0\x0f\x4f\xe8\xbc\x32\x3a\xed\x25\xc8\x38\x53\x4b\x20\xf4\x2f\x13\xd0\x6a\x4d\x33\x42\x0f\xbc\xf8\xbc\x53\x21\x45\xe7\x8a\xcf\xce\x19\x97\x66\x59\xab\xab\xc3\xb0\x71\x3a\xee\xc3\x76\x2a\xbf\xa0\x46\xaf\xfc\x22\x88\x80\xfd\x97\x35\xb0\x5f\x1e\x58\xc7\xd2\xc3\xdf\xff\x44\x61\x7b\x9a\xc2\xf7\xff\xbc\xd1\xe3\xa4\x13\xcb\x27\xdf\xa9\x44\x1c\x73\x62\xdc\x0d\x71\x06\x3d\x91\x52\x19\x76\x71\x12\xee\x8c\x0e\xb8\x0b\x39\x38\x7c\x01\x5a\x56\xc5\x37\x7c\xd9\xa0\xbf\x28\x9c\x9d\xcc\xde\x1a\xed\xf1\x6c\x27\x52\x9e\x48\xfe\xb6\xf8\x7d\x4e\x92\x39\x7c\x29\xa6\xd6\x46\xe2\xb7\xfb\x80\x9e\x73\x56\xf7\x9b\x19\x4f\x40\xe3\x36\xed\x37\x98\x0d\x04\x97\x95\x2c\xfe\xd2\x8d\x70\xfe\x59\xac\x29\x6d\x7a\x2e\x90\x43\x3d\x0c\x7b\x2b\xbe\x15\x5a\x49\x52\xbe\x53\x7c\x69\x1c\xfc\x9b\x83\x90\xf1\xe1\x3a\xa1\x6b\x1c\x24\x29\xce\x92\x52\xb4\x90\xf2\x64\x98\x6f\x9a\xb2\x9d\xfb\xd7\x13\x58\x7c\xc1\x30\xc7\x5d\x18\x00\x49\xcb\xac\x1b\xa7\x21\x7a\x81\x27\x24\x61\x72\xf8\x24\xa4\x7c\x35\x0e\x57\xa5\x3d\x1c\xbb\x19\x39\x6f\x9b\xfe\xea\xea\xad\xa0\xc1\x92\xa5\x36\x68\x8f\xd0\xa6\x9a\x40\xeb\x98\xe3\x96\x16\x55\x9c\x98\x6f\x58\x2b\x1f\xd0\xb5\xb1\xb4\x79\xda\xd5\x79\xd5\x41\xb3\x71\xcd\xde\xa6\x3d\x7b\xdc\xde\x06\xd3\xb9\xba\x96\x64\x25\xf9\x91\xff\x08\x00\x00\xff\xff\x28\x35\x8e\xdd\x00\x08\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x56\xcd\x8e\xdb\x36\x10\x3e\x93\x4f\x31\xd5\x21\x90\x0c\x55\xbe\xbb\xf0\xc1\xf9\x41\xba\xcd\xda\x2d\xb2\xed\x5e\x82\xa0\xa1\xa8\xb1\x96\xb5\x44\x2a\x24\x5d\xdb\x30\xfc\xee\xc5\x50\x94\x2d\xbb\x46\x92\x4b\x22\xcf\xff\x7c\xf3\x0d\x67\x3b\x21\x37\xa2\x46\x10\x5d\xc7\xb9\x6a\x3b\x63\x3d\xa4\x9c\x25\xa8\xa5\xa9\x94\xae\xa7\xff\x38\xa3\x13\xce\x59\x29\x5a\x48\x6a\xe5\x5f\xb6\x65\x21\x4d\x3b\x95\xc6\xb5\xc6\xc5\xff\x7e\x76\xd5\x66\x5a\x0a\x87\xa2\xeb\x12\xce\xbe\x6d\x27\x4d\x85\x32\xe1\xcc\x55\x9b\xef\x44\xf4\x87\x0e\xdd\x77\xe3\xed\xa7\x62\xeb\x5f\x12\xce\x44\x29\xd5\x55\x44\x8f\xba\x42\xdb\x2a\xed\xc7\x9f\x64\x76\x0e\x2d\x5b\xfd\x03\x2e\x8d\x2a\x29\x65\xdb\x12\x16\xac\x2a\xdb\x1f\xf5\xa9\xca\x9b\xf2\xbf\x61\xdb\x98\x3a\xe1\xcc\xb7\xa1\xb4\x1f\x48\x10\x5b\xc8\x38\x97\x46\xbb\x30\x36\xd1\x75\x2b\xd1\x22\xcc\x21\x39\x1e\xa1\x08\xdf\xa7\x53\xc2\x33\xce\xa7\x53\x58\x1e\x16\x5d\x07\x6b\xb5\x6f\x91\x93\x73\x14\x38\x6f\xb7\xd2\xc3\x91\xb3\x49\x29\xda\xe2\xb5\x70\xb8\xe8\x3a\xce\x64\x25\x61\x12\x86\x55\xbc\xa1\x7f\x39\x67\x1b\x3c\x2c\x85\xd2\x00\x00\x13\x57\x6d\x8a\x0f\xcf\x4f\xde\x58\xfc\x80\x87\xa0\x5b\x48\x69\xb6\xda\xff\x4f\xc7\x99\xe8\x35\x1f\x10\x3b\xb4\x40\xf3\x2a\xa2\x71\x2f\xe2\xa7\x50\xe1\x0a\x77\xe3\x22\xd7\x5b\x2d\xcf\xb2\xb4\x31\x75\x8d\x16\x1a\x53\x17\x8f\xe1\x33\x87\xaa\x84\xaa\x6c\x8b\xb7\xaf\x33\x98\xf4\x8e\xc7\xbe\xee\xd9\x1c\x96\x62\x83\xa1\xee\x34\xe3\xac\x24\xdd\x6c\x0e\xd4\xe0\x0a\x77\xb1\xc7\x34\xe2\x95\x43\x73\x0e\x98\xf7\xd5\xbd\xc5\xb5\xd8\x36\xfe\xcf\xfd\x5b\x24\x08\x6c\x2a\x2b\x99\x65\x9c\xb3\x7f\x85\xa5\x65\x81\x39\xbc\x0a\x19\x8f\x9c\xb1\x18\x6e\x06\x94\x26\xe7\x8c\x4a\x98\x11\x48\x20\x2b\x99\x73\xce\x06\xe0\x82\x90\xc0\x59\xe1\xee\x82\x4f\x9a\xb4\x42\xe9\x24\x23\xcf\x0b\x8a\xb3\x7b\x86\x42\xca\x60\x77\xe2\x9c\x89\xae\x2b\xae\x71\x9d\xf7\xb5\xaf\x70\x77\x05\x6e\xca\x59\x30\x0e\xc5\xf4\x9f\x97\x34\x94\x34\x78\xfd\x61\x8d\x37\xa1\x93\xb3\x22\x8b\x59\x9e\xd0\x3f\x68\xe5\xdf\xbc\x08\xa5\xd1\x12\x6a\x85\xba\xfc\x1e\xac\x96\xe4\x15\x7a\x72\x0f\x8b\xe7\xc7\x21\x6b\x6c\xfd\x6e\x66\x72\x45\x6b\x61\x36\x27\x4c\x8b\x47\x23\xaa\x47\xe1\xd1\xf9\x67\xb4\x4e\x19\x9d\x8e\x02\x64\x9c\xa9\x35\x90\xf5\x4f\x73\xd0\xaa\x21\xc2\x32\xd9\xea\xe2\xdd\x5e\xf9\x14\xad\x2d\xde\x59\x6b\x6c\x9a\x65\x3d\x3c\x16\xfd\xd6\x6a\x8a\x1b\xc9\xf5\x1e\x35\x3a\xe5\x9e\xbc\xf0\x38\xde\x82\x2b\xf9\x65\x19\x62\x95\x0e\x3e\x7d\x0e\xf8\x8c\xa0\x81\x2f\xf4\x2a\xce\x92\x88\xbe\x4b\xbe\x50\x8a\xc0\x56\xaa\x38\x72\x31\x83\x11\x48\xa9\xf4\xfb\x30\xd0\x37\x46\x7b\xdc\xfb\x1c\x2c\x7e\x05\x7a\x8a\x8a\x8f\xf8\x75\x8b\xee\x82\x70\x36\x88\x5d\x67\xb4\xc3\xb3\x9c\x8a\x72\x54\xe4\x6f\x4f\xbf\xaf\x08\x32\x8b\x5f\x8b\x45\xd7\x85\x86\x5e\x1f\x3c\x3a\xce\x59\x3d\x6e\x66\x36\x07\x8d\xbb\x74\xdc\x60\x76\x05\xb8\xac\x64\xf1\x97\x6e\x85\x75\x2f\xa2\xa1\xb0\xe9\x39\x41\x0e\xe3\x50\x77\xc1\xef\x84\x56\x92\x90\x8f\x88\xaf\x8d\x85\xbf\x73\x10\x32\xec\x9f\x15\xba\xc6\xab\x20\xc3\xca\xbb\xe0\x2d\xa4\x1c\x04\xab\x6d\x5b\xf6\xf4\xbd\xa5\x74\xf1\x1e\xfd\x0a\xf7\xfe\xca\x90\xb0\xcc\x22\x9d\xae\xad\x9f\x70\xb0\x24\x9b\x1c\x5e\x09\x29\x6f\xe8\x70\x17\xda\xe3\x29\x72\xe4\xdd\x9e\x2e\xe0\x00\xea\x42\x57\xcf\xa2\x51\x95\xf0\xc6\x3a\xc0\xa0\x73\xe0\x5f\x10\x02\x4c\x60\xd6\xe1\x87\xe8\xba\x46\x49\xe1\x95\xd1\x40\x18\x88\xa1\x6b\x58\xab\x06\x8b\x3b\xc4\xf8\x46\x9a\x34\x83\x94\xd8\x55\x7c\x14\xbb\x25\x3a\x27\x6a\xcc\xe1\xd3\xe7\x78\x16\x8a\x38\xcb\xb3\x7d\x4e\x1b\x61\x6c\x46\x90\x12\xc5\xe2\x60\x57\xb8\x8b\x44\x4b\xbd\xdd\x62\xde\x53\xea\x57\x14\x15\xda\xe3\x29\xbb\x43\x94\x31\x49\x68\x3c\x11\x47\x37\xbb\xc3\xff\xe3\x29\x3c\x41\x77\x06\xf0\xe0\xd1\x0a\x3f\xd8\xb9\x7e\x0a\xd4\x7f\x4a\xac\x18\xbf\xfb\x19\x94\xc6\xf4\x4b\xac\xd6\x10\xa3\xe4\x60\x36\x54\x0c\x71\x23\x9d\xdc\xa6\xcd\x7e\x21\x35\x79\x5c\x55\x3f\x44\x74\x3d\x81\x50\x57\xe9\x5d\x75\x0e\x93\x98\x86\xc8\x73\xe2\x6c\xe0\xc4\x5a\x34\x0e\x39\x3b\xc5\x87\x2c\x04\xcd\xe1\x66\x4d\x96\x97\x25\x79\xd0\x15\x6a\x7f\x95\x24\x87\x24\xc9\x21\x01\x48\xee\x6e\x4a\x4c\xa4\x55\x93\x93\x2c\x04\x27\x04\x39\x9b\x4e\xe1\x32\x7b\x10\x16\x41\x1b\x0f\xad\xd0\xa2\xc6\x0a\xca\xc3\x2d\xc1\x8a\xf1\xc3\x16\x53\x0f\x61\x23\x87\xcf\x87\x6f\x7c\x45\x47\xd7\xf0\xea\xa8\x53\x79\x74\xd2\xe8\x68\xce\xa1\x57\xac\x70\x47\x37\x33\xc0\xff\x11\x6b\xe5\x3c\xda\x60\x1c\x8e\x60\xf8\xc3\xed\xae\xbc\xf7\x3e\x6b\xec\xa1\xf3\x26\xaa\x62\xcd\xb2\x92\xfc\xc4\xff\x1b\x00\xf4\x59\x65\xd9\x74\x0a\x00\x00"),
 		},
-		"/cmd": &vfsgen۰DirInfo{
+		"/bare/cmd": &vfsgen۰DirInfo{
 			name:    "cmd",
-			modTime: time.Date(2018, 11, 4, 21, 45, 11, 315378193, time.UTC),
+			modTime: time.Date(2019, 2, 17, 21, 50, 54, 0, time.UTC),
 		},
-		"/cmd/{{ .Name }}cli": &vfsgen۰DirInfo{
+		"/bare/cmd/{{ .Name }}cli": &vfsgen۰DirInfo{
 			name:    "{{ .Name }}cli",
-			modTime: time.Date(2018, 11, 4, 21, 45, 11, 315127308, time.UTC),
+			modTime: time.Date(2019, 2, 17, 21, 50, 54, 0, time.UTC),
 		},
-		"/cmd/{{ .Name }}cli/main.go.tmpl": &vfsgen۰CompressedFileInfo{
+		"/bare/cmd/{{ .Name }}cli/main.go.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "main.go.tmpl",
-			modTime:          time.Date(2018, 11, 4, 21, 45, 11, 315269655, time.UTC),
-			uncompressedSize: 1273,
+			modTime:          time.Date(2026, 10, 19, 10, 38, 5, 347189340, time.UTC),
+			uncompressedSize: 1290,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x53\x51\x6b\xdb\x30\x10\x7e\x96\x7e\xc5\x4d\x8c\x61\x83\xa7\x30\xf6\x16\xc8\x43\xea\x86\x76\xd0\x76\x1d\x65\x7b\x19\x7b\x50\x4e\x97\x54\xd8\x96\x5c\x49\x2e\x29\xc1\xff\x7d\xc8\x71\xd2\x34\x5b\x4b\x9f\x2c\x74\xdf\x7d\xdf\x77\x9f\xce\xad\xc2\x4a\xad\x09\x1a\x65\x2c\xe7\xa6\x69\x9d\x8f\x90\x71\x26\x5c\x10\x9c\x33\xd5\xb6\x20\xb6\x5b\x90\x17\xee\xb6\x5a\x43\xdf\x0b\xce\xc4\xda\xc4\xfb\x6e\x29\xd1\x35\x13\x74\xa1\x71\x61\xfc\x7c\x0e\xba\x9a\x60\x6d\xc8\xc6\x77\xc2\x26\x15\x3d\x85\xf7\x62\x7d\x8b\xef\x85\xc6\x8d\xe0\x4c\x75\xf1\x1e\x1b\x0d\x6f\x77\x6c\x26\x09\xb7\x6f\xc4\xda\x9c\x68\x84\x76\xf5\xe5\xeb\x04\xdd\xd2\xab\x93\x4a\x24\xab\xc9\x37\x26\xe9\x3d\x1f\x6b\xb3\x0c\x3b\x9a\x9c\x73\x74\x36\x44\x08\xd1\x79\x9a\x23\xc2\x0c\x84\x42\x14\x9c\x3f\x2a\x9f\x42\xf6\xce\xc5\xb2\xd1\x30\x83\x4f\x83\x80\x2c\x5d\xd3\x28\xab\xb7\x9c\xb1\x9f\x81\xa6\x00\xbb\xf0\x6f\x54\x43\xd0\xf7\x89\xb5\xe0\x8c\xdd\xdd\x3b\x1f\xa7\x2f\x4a\x50\xee\x62\x2f\x38\xeb\x39\x3b\xa7\x95\xea\xea\x58\x5e\x7d\xbb\x74\x0d\xc1\x0c\x5c\x90\x8b\x4d\xab\xac\x5e\xd8\xc7\x4c\x7c\xbc\xfc\x7e\xbd\x98\xc8\x13\xe6\x3c\x19\x5e\x75\x16\x87\x5d\xc8\x72\xd8\x72\xb6\x73\xb5\xb0\x6a\x59\xd3\xe8\xed\xce\xf9\x68\xec\x1a\x66\xb0\x52\x75\x20\xce\x50\x23\x4c\x67\xa0\xda\x56\x5e\xab\x8a\x4a\xa7\x09\xb3\x9c\x1f\xa6\x93\x73\xad\xc7\xde\x6c\x97\xb2\x2c\x9d\x5d\x99\x75\xd9\xe8\x2c\xcf\x39\xf3\x2d\x1e\x61\x42\x36\xf6\x25\x8a\x87\x8e\xfc\x53\x4a\x68\xfa\x46\x44\x00\x62\xc0\x0d\xd9\xcc\x6b\xa3\x02\x85\x29\xfc\xfe\x13\xa2\x37\x76\xbd\x15\x0f\xa2\x3f\x4a\x0d\x40\xfc\x48\xe8\x34\x43\xe8\x96\x38\xaa\xee\x92\x7b\x56\x3c\x76\xcd\xd9\xe0\xf1\xac\x76\x58\xed\xef\xf2\x62\xbc\xfd\xa5\x6a\xa3\x55\x74\xfe\xb8\x92\x73\x16\x37\x2f\x86\xda\xf3\x16\x80\x1a\xf3\xff\xeb\x8c\xe9\x5c\x19\x4b\x67\x9e\x54\xf5\x36\xec\x82\xe2\x81\x9d\xb3\xfd\xb6\xa7\xeb\x39\xa2\xeb\x6c\xca\x30\xdb\xaf\xde\x20\x5b\xc0\xbf\xa0\x73\x42\xa7\xc9\x67\xc9\xd5\xe0\x5c\x4a\xf9\xca\xe3\x71\x76\xb0\x93\x86\x3f\x75\x3b\x8c\xfd\x5a\x63\xfa\xcf\xe5\xc1\x6e\x3e\x62\x69\x43\xd8\x45\xe7\xd3\xf3\x62\x6d\xe4\xad\xa7\x56\x79\xba\x56\xc6\x26\xf3\x23\x55\x01\xe2\xe6\x4e\x14\xf0\x72\xab\x73\xce\xc8\x0f\x9d\x7b\x16\xb9\x18\x0e\x94\xe5\x9c\x99\x15\xa4\xea\x87\x19\x58\x53\xa7\x4d\x66\xad\xb2\x06\x33\xf2\x3e\x4f\x0f\xdd\xf3\xbf\x01\x00\x00\xff\xff\x5a\xd2\x40\xd4\xf9\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x52\xc1\x6e\xdb\x3a\x10\x3c\x73\xbf\x62\x1f\xf1\xf0\x20\x01\x7e\x32\x8a\xde\x0c\xf8\xe0\x38\x46\x52\x20\x49\x53\x18\xed\xa5\xe8\x81\x5e\xae\x1d\x42\x12\xa9\x90\x54\xe0\xc0\xd0\xbf\x17\x94\xa5\xc0\x71\x9b\x20\x27\x09\xe4\xcc\xec\xec\x70\x1a\x45\xa5\xda\x31\xd6\xca\x58\x00\x53\x37\xce\x47\xcc\x40\x48\x17\x24\x80\x50\x4d\x83\xf2\x70\xc0\xe2\xca\xdd\x97\x3b\xec\x3a\x09\x42\xee\x4c\x7c\x68\x37\x05\xb9\x7a\x4a\x2e\xd4\x2e\x0c\x9f\xff\x83\x2e\xa7\x54\x19\xb6\xf1\x83\xb0\x69\xc9\xcf\xe1\xa3\x58\xdf\xd0\x47\xa1\x71\x2f\x41\xa8\x36\x3e\x50\xad\xf1\x7d\xc6\x7e\x9a\x70\x23\x91\x2a\x73\x36\x23\x34\xdb\x4f\x9f\xa7\xe4\x36\x5e\x9d\xdd\x44\xb6\x9a\x7d\x6d\x6c\x3c\xfd\xad\xcc\x26\x24\x35\x09\x39\x00\x39\x1b\x22\x86\xe8\x3c\x2f\x88\x70\x8e\x52\x11\x49\x80\x27\xe5\x53\xc8\xde\xb9\xb8\xac\x35\xce\xf1\xbf\x7e\x40\xb1\x74\x75\xad\xac\x3e\x80\x10\xdf\x03\xcf\x10\x8f\xe1\xdf\xa9\x9a\xb1\xeb\x92\xea\x04\x84\x58\x3f\x38\x1f\x67\xaf\xae\x70\xd9\xfb\x97\x13\x10\x1d\x88\x4b\xde\xaa\xb6\x8a\xcb\x9b\x2f\xd7\xae\x66\x9c\xa3\x0b\xc5\x6a\xdf\x28\xab\x57\xf6\x29\x93\xff\x5e\x7f\xbd\x5d\x4d\x8b\x33\xe5\x3c\x19\xde\xb6\x96\xfa\x2e\x64\x39\x1e\xfa\xe7\x2f\xd6\x1c\x97\xce\x6e\xcd\x2e\xcb\x41\x1c\x6d\xae\xac\xda\x54\x3c\x98\x5d\x3b\x1f\x8d\xdd\xe1\x1c\xb7\xaa\x0a\x0c\x82\x34\xe1\x6c\x8e\x89\x7b\xab\x4a\x5e\x3a\xcd\x94\xe5\xf0\xb2\x6e\xb1\xd0\x7a\xe0\x66\xc7\xd8\x8b\xe3\x80\x65\xad\xb3\x3c\x07\xe1\x1b\x3a\xc1\x84\x6c\xe0\x25\x89\xc7\x96\xfd\x73\x8a\x6c\xf6\x4e\x66\x88\xb2\xc7\xa5\x34\xc4\xa2\x32\x2a\x70\x98\xe1\xcf\x5f\x21\x7a\x63\x77\x07\xf9\x28\xbb\x93\x18\x11\xe5\xb7\x84\x4e\x3b\x84\x76\x43\xc3\xd4\x44\xee\x4e\x26\x9e\xba\x06\xd1\x7b\xbc\xa8\x1c\x95\xe3\x59\x3e\x19\x4e\x7f\xa8\xca\x68\x15\x9d\x3f\xbd\xc9\x41\xc4\xfd\xab\xa5\x46\xdd\x09\x92\xa6\xfc\xef\x73\x86\x74\x6e\x8c\xe5\x0b\xcf\xaa\x7c\x1f\x76\xc5\xf1\x45\x1d\xc4\x58\xff\xe2\x8a\xe3\x82\xc8\xb5\x36\x55\x2d\x1b\xbb\xd8\x8f\x9d\xe0\x9f\xa0\x4b\x26\xa7\xd9\x67\xc9\x55\xef\xbc\x28\x8a\x37\x1e\x0f\xc4\x8b\x9d\xb4\xfc\xb9\xdb\x44\x7e\x93\x58\xf2\x73\x18\x9f\x2e\x0c\x11\x81\xe0\x3d\x53\x1b\x9d\x4f\xfd\xa1\xca\x14\xf7\x9e\x1b\xe5\xf9\x56\x19\x9b\xcc\x0f\x52\x13\x94\x77\x6b\x39\xc1\xd7\x35\xcf\x41\xb0\xef\x99\xa3\x4a\xb1\xea\x7f\x38\x15\xd7\x6c\x31\xdd\xfe\x33\x47\x6b\xaa\x54\x6d\xd1\x28\x6b\x28\x63\xef\x73\x10\x1d\x74\xf0\x7b\x00\x36\x15\x16\x62\x0a\x05\x00\x00"),
 		},
-		"/cmd/{{ .Name }}d": &vfsgen۰DirInfo{
+		"/bare/cmd/{{ .Name }}d": &vfsgen۰DirInfo{
 			name:    "{{ .Name }}d",
-			modTime: time.Date(2018, 11, 4, 21, 45, 11, 315437217, time.UTC),
+			modTime: time.Date(2019, 2, 17, 21, 50, 54, 0, time.UTC),
 		},
-		"/cmd/{{ .Name }}d/main.go.tmpl": &vfsgen۰CompressedFileInfo{
+		"/bare/cmd/{{ .Name }}d/main.go.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "main.go.tmpl",
-			modTime:          time.Date(2018, 11, 4, 21, 45, 11, 315560787, time.UTC),
-			uncompressedSize: 1519,
+			modTime:          time.Date(2026, 10, 19, 10, 38, 2, 43598240, time.UTC),
+			uncompressedSize: 4715,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x57\xdd\x73\xdb\xb8\x11\x7f\x26\xff\x8a\x2d\xdb\xbb\x92\x19\x9a\x72\xdc\xdc\xa5\x51\xc7\x0f\x3a\xcb\xf1\xb9\x39\x3b\x9e\x28\x77\x7d\x48\x33\x3e\x08\x58\xd1\xa8\x48\x80\x05\x20\x59\xae\x46\xff\x7b\x67\x41\x88\xfa\x88\x1c\x3b\x73\x7e\xb0\xc8\xc5\x62\x3f\x7f\xfb\xc1\x86\xf1\x29\x2b\x11\x6a\x26\x55\x1c\xcb\xba\xd1\xc6\x41\x1a\x47\x09\x2a\xae\x85\x54\x65\xef\x3f\x56\xab\x84\x08\xc6\x68\x63\xe9\x69\x52\x3b\xfa\x91\x9a\xfe\x6b\x9b\xc4\x71\xc4\x9a\x06\x92\xe5\x12\x8a\x0b\x7d\x33\x2d\x61\xb5\xa2\xa3\x52\xba\xbb\xd9\xb8\xe0\xba\xee\x71\x6d\x6b\x6d\xc3\xcf\x91\x15\xd3\x1e\xaf\x24\x2a\x92\x53\x32\xc9\x2e\x95\x74\xf0\x04\x7f\x2d\x7a\xc4\xda\x93\x4a\xba\xa7\xa5\x6b\x81\xfc\x49\x2e\x8b\x66\x8e\x26\x89\x23\x2b\xa6\x4f\xa8\x77\x0f\x0d\xda\x27\x05\x2e\x7a\x6c\xe6\xee\xf6\xd8\x6c\x33\x79\xf9\xb7\x1e\xd7\x63\xc3\x0e\x9e\xcc\x65\xe3\x8d\x60\x63\x2e\x77\xac\x70\xa8\x04\x9a\x5a\x2a\xb7\xfd\x48\x6c\x07\xcd\x39\xcc\x5e\xc9\xb1\xa5\x60\x7f\x03\xb3\xae\x6b\x9f\x72\x31\xae\x9f\x61\x8f\x57\x20\xc6\xcf\x97\x5f\xe9\xf2\x59\xcc\xcd\x49\x93\xc4\x91\xab\xbd\xaf\xcf\x30\x24\xc4\x24\x8b\xe3\x5e\x0f\x86\x38\x61\xb3\xca\x5d\x6b\x81\x3f\xeb\x1a\x61\x22\x17\x35\xc6\x73\x66\xbe\x38\x39\x05\x6d\x8b\xf3\x45\xc3\x94\x38\x57\xf3\x34\xf9\xcb\xcf\xef\xaf\xce\x7b\x05\x81\xf9\x9a\xd5\x08\xab\x95\x48\xb2\x38\x9e\xcc\x14\xf7\x55\x92\x66\xb0\xf4\x80\x2f\x46\xe8\xce\xb4\x9a\xc8\x32\xcd\xe2\x88\x0b\x0e\xfd\x53\x20\xfa\x15\x9b\xe2\x19\x01\xd0\xd3\xdd\x82\xe8\x2d\xd4\x8a\x6b\xbc\x0f\xfa\xcf\xb4\x72\xb8\x70\x9e\x85\xa0\x51\x9c\x2b\x36\xae\xf0\x4c\xd7\x35\x53\x62\xa4\x8d\x93\xaa\x84\x53\x98\xb0\xca\x62\x1c\x19\xad\xdd\x59\x2d\x48\xd4\xf7\x1e\x4a\x45\xe0\x5c\xc6\x51\xf4\xab\xc5\x3e\xec\xfe\x25\x3b\xf6\xe7\x71\x14\x8d\xee\xb4\x71\xfd\x47\x99\x60\xd0\x34\x30\x64\x58\x6b\x05\x69\x6b\x6d\xe6\xef\xdd\xa0\xb1\xd2\x3a\x54\xee\xc6\xe0\x87\x99\x3a\xef\xaf\x9d\xf9\xe2\xe4\xad\x4a\xb9\x5b\x64\x79\x1c\xad\xda\x9e\xe0\xeb\x7a\xe3\xfd\xa0\xa5\x2c\x07\x4d\x73\x81\x6a\xe4\x98\xc3\x3e\xb0\xcd\xcb\xaa\xf3\xb3\x18\x08\x11\x1c\x4c\xa9\xe0\xcf\x6a\x41\xa2\x73\xe0\x82\xe7\x10\x24\x67\xd9\x41\xfe\x75\x43\x29\x3e\xa2\x75\x0a\xdd\x5b\x59\xa1\x7d\x4c\x40\x1c\xad\x6d\xeb\x04\xd8\x2d\xc6\x20\xbe\xbb\x41\x01\x51\x78\x3f\x68\x9a\x1c\x70\x41\xcd\x72\xd0\x34\xde\x91\x81\x12\x1f\xaf\x7e\x63\x95\x14\xcc\x69\x63\x49\x72\xaf\x07\x8d\xc1\x86\x19\x04\xa6\x04\x30\x21\x60\x52\xb1\xd2\xc6\x11\x2e\x90\xcf\x9c\x36\x94\x4e\x5e\xc9\xe2\xa6\x65\xfb\x89\x59\x24\x43\x3b\xad\xc9\xd5\x20\xc9\xf7\x11\x9b\xc5\x11\x1a\x7f\x75\x2d\xa6\x38\xf7\x0f\x48\x60\x92\x13\xa0\xd3\x3f\x9d\x82\x92\x15\x21\x35\xea\xf5\xe0\x8e\x29\x51\x21\xdc\x4b\x77\x07\x7f\xfe\xfb\xeb\xe3\x38\x8a\x1a\xa6\x24\x4f\xd1\x98\x8c\xb2\xb5\xf2\x45\x13\x22\xed\x7f\x25\xab\xe4\xff\xd0\x82\xbb\x43\x28\x51\xa1\x95\x16\x26\xb2\xc2\xdc\x53\xe6\x6b\x47\xbd\x67\x44\x51\x5a\x20\x4c\xf1\xa1\x68\x2b\x65\x2b\x69\xf0\x22\x84\x38\x40\xde\x87\x16\x5e\x70\xaa\x90\xc2\xd7\x49\x17\xde\x3d\xa0\x64\xf0\x62\x07\xeb\xe4\x0e\x7f\xa2\x08\x12\xd2\xbc\x0d\xf8\xe4\xb2\xf3\xa6\x73\x84\xfb\xb2\xcd\xa1\x31\x72\x7e\xb4\xf1\xa5\xf5\x8f\x14\x35\x27\xcd\x91\xf7\x88\x48\x5e\xda\xc0\x94\xb6\x0f\xd0\xda\x73\xad\xe9\x95\xc8\x6d\x41\x90\xcb\xe9\xed\x9e\xb5\x39\xdc\xc2\xa7\xcf\xd6\x19\xa9\xca\x8c\x92\xa2\x0d\x39\x10\x45\xad\x76\xf2\x82\xbb\x45\xd1\xb6\x90\x0d\x9d\xda\xca\x07\xad\x5d\xea\xe7\x42\x71\x81\x6e\xe4\x45\xa4\x04\x14\xca\xff\xdb\x8a\x95\x04\xfd\x28\xe2\x77\x4c\xaa\xcb\x21\x49\x3a\xc0\x8c\xca\x15\xc4\x7b\xd6\x72\xf9\x1b\x72\x02\xeb\x4b\xa7\xa7\x90\x24\xad\x41\x9d\xa0\x53\x98\xd4\xae\x18\x35\x46\x2a\x37\x49\x13\x87\xd6\x1d\xf9\xb3\xa3\xef\xe6\x49\x0e\xed\x64\x28\x3e\x50\x83\x72\x26\xfd\xb1\xb5\x82\x6a\x3d\x8a\x28\x5a\xef\xf0\x21\x87\x80\xcd\xe6\xa4\x29\x7e\xd1\x4c\xbc\x37\x17\xa8\x08\xba\xef\xf0\x21\x0d\x1e\x86\x57\x2a\xcc\x34\x5b\xdb\xb5\x87\xda\x28\x32\xe8\x66\x46\x91\xbc\x8d\x96\x66\x4a\xb2\xbb\x0a\xff\x80\xa4\xe0\xcc\x20\x73\x78\x63\xe4\xbc\xab\xbf\xb5\xa6\x1d\xe2\x96\x3e\x26\x84\xc9\xc1\x22\x37\xe8\x3a\x93\x03\xf8\x2e\x50\xa1\x61\x0e\xcf\xb4\x54\x64\xf4\xb3\x0d\x24\xb9\xa1\x1b\x74\x32\x03\xe2\x3c\x31\xf5\x2d\x85\x54\x7f\xa3\xc8\x2b\xb4\x96\x95\x1b\xa1\x5c\xf0\xe2\x8a\x19\x7b\xc7\xaa\x7f\x8e\xde\x5f\xa7\x35\x6b\x3e\xb5\x38\x0b\x70\x5b\x26\xad\x6b\x49\x3f\xf8\xb8\x7a\xb6\x46\x22\x3a\x7d\x43\x08\xa0\x50\x5b\x67\x66\xdc\x05\xde\x80\x24\x1a\x1d\xad\x9e\xf5\x20\x01\xf8\x9d\xd6\xc4\x7e\xe2\xc1\x72\x2b\x45\xf2\xbb\xbf\x40\x89\xbe\x1c\xc2\x57\x2e\x10\x6c\x36\xfc\x83\xce\x57\xa0\xe3\xe2\x03\xbb\x5f\xbf\x07\x7e\xd6\x34\xb7\x75\x4b\x6a\x75\xac\x76\x10\x4c\x25\x19\x45\xad\x71\x69\x80\x64\x71\x39\x4c\x33\x1a\x4a\xbb\xc1\xec\x02\xac\x67\x1b\x08\xb4\x4d\x69\x2b\xb6\x97\x4a\xa0\x72\x6d\xe6\x42\x58\xbe\x25\x79\x54\x4e\x6f\x43\x39\x69\x5b\x8c\x9c\x40\x63\x72\x48\xbe\xb3\xff\x56\x49\x1e\x82\x92\xea\x19\x8d\xa2\x28\x8a\x4a\x54\x43\xed\xf7\x88\xb0\xf6\x78\x30\x5a\x69\x87\x9a\xef\xa4\xc0\xcf\xf1\x1d\x9f\x3b\x94\xdb\x3e\x7c\xfa\xbc\x77\xbd\x3b\x5c\xc2\xf2\x66\x36\x7e\x87\x0f\x7d\x68\xa6\x39\xdc\xe8\x7b\x34\x7d\x78\x79\xbc\x82\x55\xbe\xce\x40\x98\xcb\x00\x1d\x98\x3b\x77\x82\xd7\x2d\xae\x87\x9a\x17\x41\x30\x0e\x14\x4d\xcf\xa6\x42\x87\x69\xf6\x8f\x67\x85\x26\x90\x82\xa0\x11\x9b\xe3\xc0\xae\xcb\x36\x98\xbd\x29\xd8\x55\xd8\x29\x78\x2d\x7c\x4f\xb3\x69\x56\x1c\x68\x8b\x5f\xcc\xca\x1c\x12\x42\xc1\x5f\x2d\xdc\xd1\x16\x28\xa4\x41\xee\xb4\x79\x48\xb2\xc7\x64\xed\x75\xcd\x1c\x92\x24\x87\x64\x3d\x38\x68\x1c\xb4\x1d\xf4\x48\x8a\x1c\xe4\x04\x2a\x9c\x38\x18\x57\x4c\x4d\xe1\x5e\x56\x15\x8c\x11\x0c\x53\x42\xd7\xd5\x03\x70\xdf\x98\x68\x7d\x5c\x07\x80\xd7\x22\xcc\xda\xad\xb5\x07\xda\xc3\xdd\x59\x6b\x29\x0b\xa0\x27\x9e\x48\x8d\x58\xa1\xf3\x0d\x98\x29\x51\xc4\xcb\xe5\x11\x29\xc7\xff\x42\x31\x1a\xbe\xfb\x8d\x16\x31\xad\x20\x99\x1f\x17\x27\x3f\x14\xc7\x09\xac\x56\xed\x18\xde\xd2\x92\x1e\x9c\xba\x17\xa8\x3e\x2e\x2c\x7c\xfa\xbc\x57\x6f\x19\xa4\x7b\x14\x5f\x23\xda\xd0\xf6\x4b\xda\xb1\xb2\xf8\x4c\x35\xb7\x07\xd0\xfc\x07\x95\x2b\x41\xba\xa9\x0a\x2b\x54\x69\x27\x2a\x23\xd4\xbd\xf4\xe5\x18\x02\xae\x64\x15\xee\x5a\x5a\xbe\xd3\xa4\x9e\x59\x07\x8d\xd1\x73\x29\x10\x18\x58\xa9\xca\x6a\x13\x75\x67\x98\xb2\x8c\x3b\xa9\x15\x65\x6d\x15\x47\x73\x66\xc0\x2d\xd6\x8b\xc9\x48\xd6\x4d\x85\x3e\x68\xdd\xaa\x15\x1a\xf2\xaf\xaa\xde\x6a\xc9\x9d\x49\x9f\x8e\x3f\xe7\xf0\xbd\x5b\x7c\x59\x15\x7b\x16\x7a\x6d\x81\x16\xcc\xe9\x02\x9a\x83\x5b\xd0\x86\x6a\xb2\x80\x9e\x6d\x86\xa7\xe1\xc3\x9a\xa6\x92\x9c\x91\x57\x39\x4c\x66\x8a\xbe\xeb\x89\x9b\x76\x3e\xc6\xb9\x9e\x29\x47\xac\x34\x99\xc2\xfa\xb6\x2d\xff\x10\x70\x84\x30\x60\xc5\xb4\x18\x70\x4e\x66\xa1\xb5\x5f\xcd\x59\x07\xff\xbd\xc1\x45\x5f\x4b\x01\x13\xde\x57\x0a\xcb\xa0\x35\xc8\xb7\x31\xfa\x9a\x2e\x68\x2b\x0e\x44\x3a\x8f\xfc\xbf\x28\xa8\xed\x7b\x5b\xda\xde\x45\x03\x9b\x76\x34\x6f\x99\x7f\x59\xd2\xd3\x35\xde\x5f\x2a\xf7\xe3\x2b\xa2\x10\x54\x8a\x21\x2a\x5d\xe7\xf0\xe6\xf8\xf8\xf5\xcb\x37\x6f\x4e\x7e\x78\xf5\xfa\xd5\xf1\x9b\x37\x27\x19\x35\x99\xb6\xd5\xf8\x7f\x2b\x1f\x6c\x1f\x8e\x76\xe5\x4f\x2b\x5d\x96\x68\xa0\xd2\x65\xf1\x8b\x7f\xcc\x41\x8c\x41\x8c\xeb\x62\xf8\x53\x0e\xce\x30\x8e\x23\xa7\x0d\x82\xd4\xc5\xbf\x8c\x74\x68\x32\xa0\xcf\xf5\x62\xb0\x49\xc0\x56\x34\xc8\x96\x6b\xbc\xbf\x7a\xd8\xc8\x26\x81\x1b\xb5\x5f\xfd\xc2\x48\xe3\xe8\xdb\xed\xc9\xe3\x43\x79\x7a\x7c\x5e\x1c\xc8\xe1\x63\x56\x17\xe7\xfb\xd6\x6e\xd9\x9a\xc5\xab\xf8\xff\x03\x00\xf7\x0b\x1b\x6d\x6b\x12\x00\x00"),
+		},
+		"/bare/config.go.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "config.go.tmpl",
+			modTime:          time.Date(2026, 10, 19, 10, 45, 42, 74898702, time.UTC),
+			uncompressedSize: 879,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x92\xcf\x6e\xdb\x30\x0c\xc6\xcf\xd6\x53\x10\x3a\x25\x68\x23\x15\x1d\x76\x29\xd0\xc3\x96\x60\xc3\x50\x60\x18\x10\xa0\x77\x59\xa2\x63\x21\xb6\xa8\x99\x72\xb1\xc2\xf0\xbb\x0f\xfe\x93\xb4\x59\xbc\xed\x64\x90\xf4\xf7\xa3\xf8\x91\xd1\xd8\xa3\x39\x20\x98\x18\x45\xd7\x81\x2f\x20\x20\xa8\xfd\xee\xe9\x19\x1b\xf6\x14\x40\xbe\xdc\xa9\xfb\x8f\xea\x4e\x42\xdf\x0b\x5f\x47\x6a\x12\xac\x44\xc6\xee\x08\xf2\xe0\x53\xd9\xe6\xca\x52\xad\x2d\x71\x4d\x3c\x7f\x36\xec\x8e\x3a\xbd\x46\x64\x29\xd6\x03\x16\x83\x1b\xe4\x96\x02\x8f\x6a\xad\xe1\x33\xda\xf2\xc3\xfd\x8f\x06\x0b\xff\x0b\x3c\x43\x2a\x11\xe2\x14\x51\x31\x46\xc6\x5a\x6a\x43\x02\xe3\x5c\x83\xcc\xc8\x4a\x64\x17\xaa\x47\x90\x5d\x07\xea\x22\xd7\xf7\x52\x64\x5a\xc3\x0e\x03\xd5\x27\xae\x1b\x02\x1f\x4c\x1a\x06\x9a\xe9\x96\x7c\xe0\x73\xab\x18\x2b\x6f\xc7\xba\x12\xd9\xa4\x9d\xe9\x53\x30\x60\xd7\xb3\x41\xf8\xf3\xef\x06\x69\x0d\x7b\x4c\x5b\x0a\x85\x3f\x80\x23\x64\x08\x94\x4a\x1f\x0e\x0f\x63\xd3\xed\x68\x0f\xec\x77\x4f\x30\xab\xa0\x34\xd3\x1b\xf3\x71\x8a\xd9\x02\x64\xa1\x35\xe4\xad\xaf\xd2\xc6\x07\x25\x8a\x36\xd8\x37\xf0\x6a\x0d\x5d\x2f\xba\x6e\x03\x58\x31\x5e\xb5\x65\x4c\x8b\xc8\xf3\xb0\x67\x3f\xe1\x5b\x82\xba\xe5\x04\x39\x82\x35\x55\x85\x6e\x6c\x8b\x05\x35\x08\x26\xbc\x9e\x7e\x1d\x7c\x6c\x19\xdd\xd2\x43\x44\x66\xa7\x69\x1f\x1e\x81\xdd\x51\x7d\x7d\x2b\x9e\x4a\x6a\x8f\xe9\xfd\x92\xbe\x50\xf3\x69\xda\xed\xea\x7d\xfa\xf6\xe2\x26\x6e\x64\x6c\x73\xf9\x2f\xc6\xb3\xa9\xbc\x33\x89\x9a\x0b\xca\x8d\x7c\x31\x15\x45\x6c\xe4\x2d\x2c\xe6\xff\x87\xdd\x52\x60\x0c\xdc\xf2\x77\x72\x78\x8d\x1e\x6e\x78\x09\x3d\xe4\xff\x44\x9b\x6a\xb5\x16\xf3\xa6\x82\x83\xbe\x17\xbf\x07\x00\xdd\xfb\x70\x93\x6f\x03\x00\x00"),
+		},
+		"/bare/go.mod.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "go.mod.tmpl",
+			modTime:          time.Date(2026, 10, 19, 10, 37, 39, 612039702, time.UTC),
+			uncompressedSize: 3592,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x56\xcb\x8e\xdb\x38\x16\x5d\x8f\xbf\x42\xc8\x6a\x66\x41\x89\x97\x0f\x91\x5a\xcc\x2c\x66\x06\xe8\x45\x6f\x1a\x48\x90\x6d\x40\x91\x57\x12\x63\x49\x54\x28\xca\x55\x4e\x21\xff\xde\x90\x5d\xdd\x65\x07\x65\xd9\xd9\xd8\x04\x74\x78\xee\x83\xe7\x3e\x86\xe0\x96\x1e\xb3\x97\x97\x2c\xff\x2d\xfc\xb1\x6f\xb3\x1f\x3f\x76\xbb\x36\x64\x90\x03\xec\x76\x11\xbf\x2d\x3e\x62\xf6\xcf\xdd\x3f\x5a\x9f\xba\xa5\xce\x6d\x18\x8a\xff\x2e\x71\x4c\x1f\x97\xb9\xf3\x45\x0a\x43\x9f\x1d\x68\xce\x73\xc8\x8a\x22\xf3\xa3\xf3\x11\x6d\xba\x82\x7f\xf6\x07\xef\xfe\x17\x62\xc2\xe7\xa2\x0d\x9d\x9f\x53\x68\xa3\x19\xb2\x03\xe4\x34\xa7\x37\xaf\xd5\x26\x26\xdc\x8f\x45\x1b\x48\xed\x27\x5e\xad\x66\x68\x4e\x09\xa3\xa0\x00\xa0\x04\xc9\x2a\x59\x12\x43\x65\x55\x2a\x34\xb4\x92\xee\x36\x17\x86\x38\xaa\x62\xc2\xb8\x9f\x2f\x78\x34\xe5\x0c\xa0\x14\x4a\x28\xc2\x8d\x52\xe0\xaa\x8a\x55\x8a\xdf\xe6\x69\x71\x4c\xf1\x58\xcc\x13\x9a\x3d\x9a\xf9\xb8\x92\xc1\x56\x10\xc9\xce\x8b\x4f\xb8\x1e\xdc\xa5\x65\xa0\xc0\x29\x15\x82\x69\x52\x2a\x94\x8a\x3b\x06\x60\xec\x43\x44\x4b\xf2\xfd\x55\x14\x92\x09\xca\x99\xa2\x9c\x38\x61\xad\x56\xb5\x2e\x29\x94\x37\xb9\x6c\x98\x87\x30\xbf\xfe\x91\xd9\xed\x4f\xcf\xff\xf1\xff\xbf\x7f\xc6\x38\xfb\x30\xae\x1a\x78\x07\xff\xce\x43\x68\x5a\x82\x86\x4a\x70\x10\x44\x32\x90\x1a\x45\x59\xa9\x5a\xdf\x34\x8d\xf5\x62\xbb\xc1\x8c\x45\x63\x7c\x4f\x12\xce\xe9\x82\x4d\x51\x4e\x39\x2d\x81\x71\x4a\x2a\xd9\x68\x5a\x01\x55\x8c\xc9\x9b\x6c\x4d\x88\xe9\x98\x9e\x58\xd1\xa3\xd9\x9f\xc9\x20\x67\x1b\xcf\xd1\x06\xb2\xf7\xa9\xd8\xfb\x93\xd9\x72\x1b\xd9\x87\xb6\x19\x52\x71\xfe\x5b\xf1\x7c\x1b\x3f\x27\x63\xf7\xc5\xe9\x77\xf5\x43\x6f\xa2\xdb\x50\x4c\x31\xa4\x50\x2f\xcd\x0a\x86\x8d\xfa\x69\x43\x6f\xc6\xb6\x68\xfb\xd0\x5e\x24\xab\xa4\xc0\x4a\xc6\x25\xa7\x9a\x30\xee\xb0\x11\x58\x5a\x10\xf5\x3d\x9a\x79\x34\xd3\x74\xbc\x20\xd2\x54\x82\xa6\x52\x48\x5a\x11\x86\xa5\x6c\xb4\x64\x52\xba\x2d\xa2\xd0\xf6\x58\xb4\xa1\x59\xbe\x7f\xbf\x20\x52\xeb\xd3\x81\x12\x4a\x72\xc2\x84\x06\xdd\xa8\xaa\x6c\x4c\xb3\x41\x14\x7d\xdf\x9b\xc2\x86\x31\xe1\x73\xba\x9f\x87\x33\x7c\x58\x9e\x57\x68\x99\xb3\xbb\xd0\x27\xac\xe7\x60\xf7\x78\x57\x18\x9d\x99\x3b\x6f\x43\x9c\x8a\xce\xf6\xf7\x3a\x93\x1f\x6d\x18\xe7\x2e\xe2\x01\x4d\xdd\x63\x31\x84\x65\xc6\x14\xcd\x74\xef\xe2\xd7\xa1\x0b\xae\xc5\xb9\xe8\xf1\xe0\xdb\x70\x91\xbb\x12\x00\x24\x54\x5c\x88\x8a\x58\xc1\x5c\x85\xd4\x1a\xca\x6e\x77\xa2\x7d\xbc\x10\xe6\x2b\x89\xa0\x8c\x95\x94\x53\x25\x81\xd4\x5a\x20\xa7\xc6\x3a\x09\xb7\xeb\x67\x30\xad\xb7\x61\x34\x3e\xae\x62\x9c\x30\x26\x8f\xf3\x3d\xed\x0e\x26\xa5\x53\x57\xf6\xb3\x49\xe9\x55\x49\x62\x13\x9e\xa6\x18\x16\xf7\xaa\xc0\x2f\x7f\xe9\xfe\x0b\x3e\x27\x1c\xd7\x86\x73\xb2\x49\x37\x9e\x7e\xf0\xc9\x76\xd8\xf7\xdd\x6a\xb7\x0b\x03\x3a\x1f\xef\x25\xfb\xed\xce\x60\xa6\x39\xc5\xc5\xa6\x25\xe2\x7a\x0b\x36\x94\x13\xc6\xd9\x17\x6d\x18\xb0\x35\x2b\x54\x6c\x40\x27\xec\x7b\x4c\x1e\xe3\xea\xd4\x79\x10\x6e\x8b\x6c\xda\xb7\x05\xc6\x18\xe2\x69\x06\x6d\xe5\x78\x1a\xf0\xbb\x89\x6b\xc6\x88\xf3\x4d\xd3\xfb\xfa\x5e\xb4\x53\x0c\x03\xa6\x0e\x97\xb9\xb0\xbd\xc7\x31\x7d\x39\x67\x7b\xb5\x54\xe5\x94\x4c\x11\x57\x82\x53\xcf\x56\xb4\x02\x26\x35\x15\xc4\x20\x53\x50\x69\xeb\x5c\xf5\x2b\xd4\x43\x70\x78\x3d\x81\x14\x30\xa0\x12\x80\x12\x69\xb9\x56\xe0\x74\x55\xc1\x63\x94\x61\x18\xc2\x78\x49\x06\x14\x24\x30\xc1\x98\x22\xb5\xad\x95\x70\x48\xb5\xe3\xea\x11\xb2\x29\x06\xdb\x5c\x4d\x78\xa0\x54\x82\xa0\x0c\x34\x01\x2d\x6b\xc1\xb4\x16\xc0\x6f\x6f\x0a\xd1\xc6\xf0\xd4\xe3\x71\x4d\xfd\x80\x29\x7a\x7b\xbd\x30\x48\xca\x41\x89\x92\x6b\x82\x4c\x51\x81\x50\x4a\x28\x6f\x57\xd8\x3c\x35\xc0\x0b\xd3\x60\x0c\xf7\x94\x77\x86\x5a\x73\x7f\x8a\xbd\x22\x43\x1d\xcd\xd9\x37\x78\xe7\xf3\xd7\x27\xd3\x27\x8c\x4f\x68\x52\x87\x71\x30\xe3\x3d\x05\x9d\x59\xa7\xa6\x37\xed\x19\x7a\xbb\xf9\x9c\xa1\x07\x3f\xe1\xdd\x2a\x9c\x53\xc4\x64\xbb\x58\xac\xe3\xd9\x37\xc7\x15\xcf\x36\x2a\x7d\x3e\x8e\x2e\xad\x15\xd5\xe3\x01\x7b\x57\x5f\x25\x5f\x51\x4d\x39\x95\x12\x88\x15\xb6\x5c\x53\x8f\x15\xde\x76\x33\xe1\xe8\x30\x0e\x7e\x4c\x7f\xaf\x5f\x5b\xbb\xda\x05\x1c\x1d\x93\x12\xae\x57\x4e\xca\x14\x95\x94\x41\x45\x9c\xe6\x5a\x51\x26\x1d\xab\xab\x2b\xb6\x97\x17\x92\xf9\x26\xc3\x6f\x57\xdb\xd4\x87\x03\xcd\x99\xcc\xe9\x87\x9f\xf7\xaa\x0b\x83\x6d\x20\x66\xf0\xe3\x69\x24\x00\x7b\xcc\x49\x6f\x0e\xa7\x22\x84\x07\x83\x7a\x3b\x66\xaf\x1e\x9d\x1c\xc6\x7e\xc6\x47\x3d\x13\xbf\xec\x19\x3c\x82\x7f\x3b\xae\xb7\x58\x99\x03\x89\xf6\xd5\xbb\xd1\x9d\x9d\x3b\x75\xb3\x3c\xc4\xb6\x78\x2e\x6c\x3c\x4e\xe9\x72\x7c\x6a\xaa\x19\xa5\x42\x2a\x2a\x08\x57\xa5\x50\xb2\x6a\xb8\x30\x3f\xd7\xe5\x69\x71\xc9\x2f\xa8\x5a\x1c\x4f\xd3\xe8\x9a\x8a\x6a\xd0\xbc\xe2\x82\x70\xcd\x51\xd7\xcc\xf2\xba\xc2\xbb\x54\x71\xb2\xab\xb8\xe1\xe7\x2d\xf1\x5f\xbb\x5d\x51\x64\x9f\x3a\xcc\x1c\x4e\x6b\xa0\xa3\x5d\xa7\xec\x32\x63\x96\x3a\xcc\x3e\xbd\xc5\xde\x84\xb8\xcf\x42\x93\xbd\x13\x6b\xbe\x8b\x38\xf5\xc6\xe2\x7b\x1f\xb3\x7f\xff\x27\x7b\x3f\xb1\x0f\x27\x6a\xf7\xe7\x00\xc6\x9a\xd3\x60\x08\x0e\x00\x00"),
+		},
+		"/bare/go.sum": &vfsgen۰CompressedFileInfo{
+			name:             "go.sum",
+			modTime:          time.Date(2026, 10, 19, 10, 37, 39, 617414680, time.UTC),
+			uncompressedSize: 13740,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x54\x4d\x6f\xe3\x36\x10\x3d\x93\xbf\x62\xaa\x16\x85\x04\xa8\x54\x8b\x1e\x5a\x04\xc8\x41\xeb\x78\x77\x0b\xd4\x69\xb0\xd9\xb6\x87\xa2\x87\x11\x39\x56\xd8\xf0\x0b\x24\x1d\x7b\x61\xf8\xbf\x17\x94\x15\xe7\x63\xbb\x41\xea\x03\x4d\x88\x6f\xde\xbc\x99\x79\x64\x40\x79\x8b\x23\x81\x45\xed\x38\xd7\x36\xf8\x98\xa1\xe6\xac\x22\x27\xbd\xd2\x6e\xec\xfe\x49\xde\x55\x9c\x55\xda\x97\xd5\xa7\x8a\x73\x86\x21\x40\xb5\xdf\x83\x78\xe7\xaf\x6e\x47\x38\x1c\x2a\xce\x46\xd4\xf8\x8b\xd3\x19\xaa\x51\xe7\x9b\xcd\x20\xa4\xb7\x9d\xf4\xc9\xfa\x34\xff\x7d\x97\xd4\x6d\x27\xad\xea\x0a\xb4\xd3\x4e\xe7\xc2\xf8\x22\x3a\x51\xbc\xa3\xf8\x0c\x96\xc2\xfa\x87\x1f\x3b\xe9\x87\x88\x15\x67\x38\x48\xfd\x24\x67\x26\xa7\x28\x5a\xed\xf2\xe3\x6d\x81\x75\xf9\x53\xa0\xf4\x8c\xed\xbf\xe1\x46\x0f\xa9\x93\x46\x57\x9c\xa9\xc1\xbe\x82\x7f\x0a\x50\xc3\xeb\xc9\x8d\x1f\x2b\xce\xb2\x9d\x34\xbd\x22\xc1\xac\xbd\xe1\xbc\xeb\xe0\x82\xd6\xb8\x31\xf9\xd2\x2b\x7a\xef\x2d\xc1\x5a\xef\x2c\xf1\x3b\x8c\x9f\x9d\x9c\x83\x4f\x62\xb9\x0b\xe8\xd4\xd2\xdd\xd5\xd5\x37\xef\x7f\x5b\x2d\x3b\x51\x86\x77\x89\x96\xe0\x70\x50\x55\xc3\xf9\x7a\xe3\xe4\x64\x82\xba\x81\x3d\x67\x52\x49\x38\x3b\x07\x0c\x41\xac\xf0\x96\x16\x5e\x91\xac\x1b\xce\x64\xde\x95\xef\xc7\xb1\x88\x4b\xda\xce\xe9\x16\xde\x65\xda\xe5\x09\x52\xe6\x22\x96\x0e\x07\x43\x0b\x6f\x2d\x3a\x75\xed\x63\xd6\x6e\x84\x73\x58\xa3\x49\xc4\x59\xf4\x3e\x2f\xac\x2a\x54\xdf\x1e\xf1\x33\x72\xcf\x19\xfb\x3d\xd1\x19\x3c\xfd\x55\x4f\xe4\xb6\x9c\xb1\xeb\x1b\x1f\xf3\xd9\x17\x41\xd0\x87\x00\x17\x48\xd6\x3b\xa8\x8f\x6a\x9b\x29\xee\x8a\x62\xd2\x29\x93\xcb\x57\x91\x3e\x6c\xdc\xf2\xec\xbe\x98\xcf\x4e\xde\xba\x5a\xe6\x5d\xd3\x72\x76\x38\x5a\x7e\x72\xf7\x43\xf5\x73\xe9\xfd\xf1\xe0\x54\x94\xe8\x95\x9a\xab\xa9\xef\xef\x84\x28\xcb\xc2\xaa\x42\xd8\x82\x54\xb2\x85\x99\xaf\x69\x5e\x0e\xfc\x48\x29\x3b\xca\x6f\xb5\xa1\xf4\x25\x02\xce\x66\x45\x0f\x04\xe9\x11\x70\xa6\x3f\x45\x94\x36\x38\xda\xf6\x21\xb4\x40\xbb\x72\xe1\xfb\x10\xae\x33\x66\xea\x9d\xfa\xb8\xfa\x03\x8d\x56\x98\x7d\x4c\x85\xb9\xeb\x20\x44\x0a\x18\x09\xd0\x29\x40\xa5\x60\x6d\x70\x4c\x9c\xd1\x8e\xe4\x26\xfb\x58\x3a\x22\x8d\x16\x57\x47\xd8\x1b\x4c\x54\x84\x9e\xb2\x56\xab\xbe\x6a\x9f\xdb\xb2\xe1\x8c\xe2\x14\x7a\x4f\x23\x96\xd3\x86\x8a\x85\xf4\x1a\xca\xe9\x57\xe7\xe0\xb4\x29\x76\x2c\x32\x6e\xd0\x29\x43\xb0\xd5\xf9\x06\xbe\xfe\xf9\xa7\xef\x39\x63\x01\x9d\x96\x35\xc5\xd8\x94\x19\x1d\x66\x17\x1f\x6b\xab\x8d\x1f\x47\x8a\x60\xfc\x28\x7e\x9d\xb6\x2d\xa8\x01\xd4\x60\xc5\xc5\x9b\x16\x72\x44\x49\xd7\xd9\x47\x02\xed\xc5\x9f\x51\x67\x8a\x0d\x94\x47\x42\xf4\x21\x18\x2d\x31\x6b\xef\x4a\xee\x48\x79\x13\xdd\x74\x15\x2e\x69\xbb\xfa\xf4\xc0\x5d\x08\x9b\x53\xda\x17\x5b\x59\x73\xf6\xff\xf5\xb4\xbc\x81\xba\x3c\xbf\xe2\x03\x6e\x57\x94\x12\x8e\xd4\xc2\x5f\x7f\xcf\x6f\x86\x78\x47\x8e\x92\x4e\xa7\x2c\x6d\xe9\x9a\x8f\xcd\x23\xd5\x4e\x9b\xf6\xb4\xf0\x03\xff\x37\x00\x00\xff\xff\x54\xf8\x58\xc3\xef\x05\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\xbb\x49\x93\xa3\x4a\x9a\xfd\xbd\xef\x4f\x51\x7b\x59\x24\x38\x33\xaf\x59\x2f\x98\x24\x90\xc4\x28\xc4\xa0\x4d\x1b\xf3\x3c\x83\x18\x3e\xfd\x6b\x8a\xc8\xae\x3f\xaa\xbe\xb7\x32\xea\xe6\xdd\x64\xc4\x26\x7f\xe7\x9c\x07\xf7\xc7\xdd\x71\x22\xc9\xc6\x74\xf2\x7f\x04\x4d\x05\xb1\x53\x5f\x8f\xb7\x69\x48\x33\x68\x6c\xaa\xf2\x1f\x4f\xf8\x07\xfa\x03\xfc\x23\x05\xff\x9f\xed\x14\xae\x5b\x12\x6e\x8f\x76\x6c\x0c\x2e\x24\x2d\xb0\xf5\x15\xab\xbc\x02\x56\xb3\x8a\x8d\x59\xd8\xb9\xc7\xb4\x55\x22\x94\xaa\xff\xf7\x7f\xfd\x92\x08\x25\xcd\x8f\xaa\x09\x5f\xe0\x45\xb4\x39\xe5\x94\xb3\x78\xd3\x65\x7c\x4f\x6d\x71\xdd\xa0\xb2\x38\x45\x88\x38\xe2\x83\xc4\x16\x43\x0b\xa3\x5d\xb0\xc6\xb6\x7c\x7f\x03\x5b\xd9\x33\x0b\xb9\xa6\x1f\xa3\x05\x4a\x9a\x34\x1b\xc6\x26\xe9\xbd\xea\x1f\x4f\xf0\x03\xfe\x01\xbf\xc8\xc4\x21\x65\xb7\x43\xf7\x1c\xe0\xb3\xda\xf7\x4a\x9a\x56\x06\x59\x1e\x97\x06\x1f\x24\x65\x61\xb9\x93\xd3\x87\x63\x09\xdd\x9f\x7d\x23\xfc\x07\xe4\x9d\x75\x2d\xc6\x2b\xb6\xeb\xf8\xc5\xf5\x9c\x09\x4d\xf9\xbe\xbf\x1f\x66\xa2\x9e\x71\xb8\x81\xb0\x83\x19\x30\x1d\xdf\x15\xd0\xf3\x2e\x92\xc9\x9b\x80\xef\xf5\x63\x54\xd4\x50\xd2\x7c\xf8\x59\x8b\xd2\xaf\xa2\xc0\x3f\xe0\x0f\x04\x06\x24\x00\x80\x00\x38\x42\xe3\xc4\x87\x07\xe3\x34\x41\x46\x1e\x4c\xe3\x9f\x72\xc0\x63\xb2\xdc\xa3\x93\x1e\x16\xd7\x27\x76\x89\xf5\xc0\x08\xe6\xb2\x3a\x4a\x7d\x5a\xf0\x95\x94\x23\xe1\x86\xfb\x45\x02\x0d\x10\xf5\xbb\x72\xbb\x90\x59\xa0\x2c\x10\xa1\x87\x47\xbf\x4c\x07\x21\x7f\x44\x29\x63\x74\xbe\x32\x55\x6b\x75\x37\xa1\x35\x9c\xcb\x6b\x93\x1f\xc3\x27\x79\x2b\xde\x55\xa3\xa6\xaf\x49\xa8\x8d\xfa\x62\xd8\x29\x52\x30\x8a\x00\x40\x60\x24\x46\x7e\xa0\x1e\x49\x82\x90\xa6\x11\x9a\x44\x5f\x01\x97\x33\xe6\xa1\x4f\xee\xe8\x9d\x8e\x50\xde\x3d\xb7\xab\xec\x36\x77\x4a\x43\x01\x29\xe2\xaa\x7e\xb0\x32\x0f\x33\xaa\x49\x9b\xb9\x1b\xfc\x3b\x52\xbb\x70\xfc\x1c\x85\x0d\x64\xb7\x3d\x82\x99\x5e\xa7\x2d\xd5\xe2\x8f\x53\x74\xc0\x95\xfb\x96\x75\x98\x84\xdd\x28\xd8\x35\xa8\x44\x89\xd1\xf7\x51\xed\x27\x51\x3d\xf6\x2b\x34\xb4\x91\x57\x44\xde\xb0\xbe\x12\x82\xaf\x61\xc7\xae\xee\xba\x5c\x69\xa9\x66\x80\x6a\x77\xcb\xb9\xeb\x5a\x84\xc1\x5b\x57\xbc\xf7\x5c\xc6\x5c\x89\x0b\x7a\x3e\x5c\x2f\xb7\x41\x2f\xdc\x6f\x31\x77\x76\x0f\xdb\xb0\x3e\x58\xcd\x2e\x37\xd2\x24\x72\x8a\xe2\xcc\xe4\xa6\xe0\xbe\x4c\xd2\x04\x53\x58\x31\x5c\xb0\x3c\xb6\xb5\x30\xc7\x49\xc3\x3b\x7a\x0c\x86\x29\x1b\xa3\xd7\x2f\xe1\xbe\x42\x00\x06\x28\x0c\x63\x18\x42\x7d\x10\x64\x84\x93\x68\x88\x00\xe0\x05\xaf\x18\xd0\xb6\x1c\xc4\xa4\x9c\x91\xb3\x02\xb5\xb3\xe5\x55\xe0\x41\x05\x26\x67\x96\x58\x6b\xaf\xbd\xff\x54\x6b\xa4\x69\x9a\x8e\xd3\x6f\xbf\xa7\xb5\x7f\x1a\x55\x05\x09\x5b\x95\xd7\x5c\x36\x47\x4e\xb5\x19\x12\x93\xdd\x6d\x4e\xaa\xad\x4a\x4b\xf2\x62\x4b\x9e\x78\x81\x8d\xd6\xea\x64\xfa\x9f\x4a\x4e\x63\x56\xee\x55\x61\x1c\xc1\x60\x14\x21\x61\xf4\x23\xc4\x82\x80\x22\x7d\x8a\x80\x01\xf1\x4a\xc8\x0e\x0f\xe6\x9c\x70\x93\x3c\x34\xc7\x87\xfc\x50\xd6\x9c\xbc\xae\x23\x31\xdc\x28\xaf\xe6\xd3\x28\xb4\x7a\x46\xe6\xd4\x55\x93\xa5\xe6\x77\xe5\xf6\xcf\x10\x57\xce\xc8\xa1\x7b\x9a\xab\x45\x47\xcb\x9d\xb9\x42\xfd\xe2\x64\x28\x1f\x5c\x13\xc4\x1c\x0e\x6b\x75\x67\x5c\x7c\xc5\x94\xa7\xfc\xde\x34\x82\x66\xa8\x9a\xe1\xe7\x8f\x8f\x21\x2c\x5e\x29\x11\xfc\x6b\xcc\xc1\xb6\x0f\x43\x8b\xea\xab\xac\x32\xcf\xf1\x59\xa2\xaf\x96\xc1\x9e\x9c\xd9\x2f\x48\x62\x2b\x39\x61\xbe\x10\xda\xbd\x5f\x70\x2f\x96\xbf\x07\xdd\x19\x3e\xf7\x0e\x74\x6e\xcf\x53\x7d\xd6\x1d\x56\xc2\x35\xc1\x41\x36\xe1\x2a\xca\xc7\x4d\xef\x21\x80\xd3\x39\x9f\x4b\x69\x34\xa8\x29\x12\x7c\x83\x4d\x7c\x19\xee\x87\xcb\x4d\xc0\xe8\x07\x71\x78\xd0\xb8\x16\x73\x97\xbb\xa6\x47\xda\xb3\x59\x45\x46\xb2\x12\xc9\x3a\x33\xc5\xb9\xb8\x45\xd6\x1c\xdc\xbf\x07\xfd\xfb\x0c\xff\x41\x9b\xa4\x60\x02\x50\x80\xc6\x50\x80\x7d\xe0\x08\xc0\xa9\x08\x23\x68\xd2\xa7\x5e\x49\xa4\x39\xab\x01\x32\x1b\xfa\xb8\x3e\x52\x91\x38\x5a\xe8\x5a\x1c\x83\xf0\xa4\x94\xae\xb0\x35\x91\x01\xe7\x0a\x65\xd5\x87\xb3\x3d\x48\xbf\xa9\xb6\x8b\x38\xde\x96\x6b\xe3\x3a\x2b\x5b\x65\xc7\xe8\xd2\x3e\xab\x0e\x0b\x37\x6f\x95\x43\x2e\xe7\xea\x89\x9a\xba\x8a\x5b\x07\xe9\xc4\x9a\xc8\x7b\x8f\x09\xbd\x67\x14\x24\xe9\x6b\x29\x18\xda\x68\x7e\x2d\x69\xe0\x6b\x7d\x7f\xe6\x74\x0e\x4d\xc0\xef\xea\x27\x27\xc4\x67\x75\xbe\xa7\x63\xa9\x32\x46\x37\xa0\x87\xbe\x10\x5d\x17\xa0\xb9\x6b\x9b\x77\x9a\x0c\xbe\x41\xdc\x3f\x0d\xd2\xa5\xdc\xc0\x46\x94\x2c\x1d\x92\xca\x6a\xa0\xea\x89\x96\xcc\x5c\x42\x43\xa1\x2a\x58\x76\x15\xf3\xdb\x20\x1d\x02\x5c\x44\xdf\x57\xad\xc8\x9f\x82\xb4\xf2\x6a\x28\xf6\xb2\xf2\x63\x8c\x86\x71\x57\x22\x12\x46\x61\x14\x26\x00\x82\xc2\x1f\x34\x1e\x53\x30\x0d\x60\x12\x41\xf0\x57\x12\xd2\x74\x4c\xca\x10\x1a\x7f\x7b\xdc\x25\xfa\xb4\x0d\xc6\xc8\xf0\x08\x1d\xc5\xa6\x0b\x89\xc9\xc5\xb8\x23\x8b\x5b\x5b\x60\x2b\x3d\xf9\xf7\x05\x77\x41\xd5\xa3\xc9\xda\x00\xbb\x5b\xe7\x1b\xa5\xc1\x43\xda\x3a\xa4\x1a\x6a\x2e\xdc\xb5\x85\x19\x32\x24\x63\x9f\xdc\xa3\x17\xc8\x1c\xc2\xbf\x07\x8d\x9b\x7e\x5c\xc7\x19\x81\xca\xc8\x2b\xbe\x64\xc1\x0f\xe4\x6b\x9a\x04\x39\x71\xe2\xb2\xf9\xcc\x8b\x64\x89\x8e\xb2\x78\xcd\x1f\x7c\x03\xeb\x9d\x36\xf6\xce\x39\xb3\x6f\x12\x7d\x4e\xda\x48\xbf\xcc\x07\xfd\x5b\xcc\x9d\xdd\x9c\x1f\x72\x3b\x69\x99\x53\x5e\x21\x1c\x43\xda\x63\xca\xa6\x10\x17\x3e\x5c\x41\x3b\xa2\xc0\x11\xbb\x49\x9c\x83\xf2\x11\xa4\xf8\x7b\x1f\x8a\x87\xba\x19\xb3\x78\xfd\xe7\x2f\x2f\xbb\xd8\x0f\xf2\x65\x57\x72\x86\x83\x7e\xad\x6a\xc7\x46\xb8\xc0\x99\xdc\x03\x25\x6f\x4f\x28\xb6\x85\x41\x3b\xd9\x4b\x17\xc5\xda\xc8\x69\x38\x57\x5b\xb4\xf4\x2d\xe6\xde\xee\x9c\x0e\x1b\xe6\xd3\xe8\x0c\x69\x9a\xd1\x43\x9d\x02\xdc\xb5\x8a\x27\x6a\xa4\xc8\x6b\x7d\xe4\xe4\x46\x7f\x8e\x4d\xde\xe6\xc7\xf7\x66\x9d\x34\x1f\x45\x36\x42\x45\xf6\x39\x7c\x7e\x76\x9f\xd9\xcc\xe2\x76\x64\x4e\xd2\x2a\x4d\x59\x8f\xf9\xc6\xaa\x60\x29\x79\x28\x18\x0f\xf1\xb0\x28\x6a\xaf\xae\x55\x19\x11\xde\x75\x3a\xf5\xef\x61\x3b\x83\x0b\xbb\x5c\x24\x95\x26\x42\x47\xb6\xed\x15\x96\x6b\xdb\x1a\xab\xb9\x60\x68\x08\xa0\x5e\xb7\x68\xf5\xb3\xcf\x8f\xae\xcc\x20\xde\xfb\x7a\x99\x34\x1f\x65\x93\xc4\xd5\x08\x7d\xfd\x78\x91\xd1\x2f\x9b\x94\x78\x1f\x02\x8a\x34\xbd\x9b\x7d\xbd\xcc\x7d\x3d\x55\x09\x47\x41\x6e\xd0\xd4\x3c\x12\x9f\xf5\xc1\x38\x33\x83\xed\x69\x09\x92\x05\xdf\x41\xee\xcc\xea\x23\xd0\x1a\x15\xa7\x1a\x7c\x9c\x6f\x4c\x50\xcc\x40\x2c\x8f\xce\xb5\x12\x07\xd5\xc1\x21\xf8\xea\x9b\xf4\x89\xad\x79\xbc\xb4\xdf\xb7\xc6\xaf\x59\x3e\x7a\x41\x01\x7d\xfe\xfb\x7a\xf2\xd4\x97\x55\xfc\x96\xc8\x9b\x22\xe3\xe2\xd2\x0b\xb9\x05\xcf\x33\x52\x9a\x95\x43\x08\x88\xb4\x0d\xf1\xe2\x0d\xd8\xc1\x15\x6d\x63\x40\xaf\x43\xf1\x6b\xe0\xce\xe8\x13\x8e\x89\xc9\x59\x57\xf9\xf4\x34\x12\xe9\x52\x38\xd3\x61\x6d\x09\x4d\xb5\x4b\xb8\xbb\x9c\x28\x3c\x51\xa0\x2a\x2a\x0d\x54\xe4\xdf\x1b\x5d\xd2\x24\x0d\xd4\xf6\xcd\xd8\xf8\x53\xbc\x6b\x73\x24\x62\x1c\x64\xdc\x9a\x52\x81\x87\x2e\x53\x5e\x3d\xac\x40\xea\xa6\xa9\xa1\x2a\x36\x71\xb0\xc6\x0a\x3d\x08\xe2\x75\x1f\xd5\x9c\xe6\x97\xbc\x9d\xcd\x9e\xea\x44\xe8\xf4\xd0\x2b\x3c\x20\x6a\x1e\x32\xe0\xc6\x8b\x07\xe0\x15\x8b\xfd\x04\xf0\x42\xdd\x7c\xbd\xbc\x90\xde\x18\x8e\xf3\xfb\x3c\x4d\x9a\xd2\xab\x13\x28\x29\x9b\x64\xd7\x6c\x08\x18\x20\x04\x82\xe2\x28\x4c\x7d\x20\x68\x18\xc5\x58\x44\x04\x00\xf3\x5f\x85\xb6\x2e\xe3\xe2\xf9\x9d\xf3\x28\x8e\x08\xde\xba\x74\x54\xc4\xc6\x95\xf0\x70\x0a\x31\x31\x0d\x25\x21\x14\x38\xc2\x30\xea\x78\x8b\x53\xbf\xa1\xb4\x8b\x76\x63\x45\x72\x4d\x96\x8c\x6a\xe3\x7b\xe9\xa9\x85\x2c\x4f\x8c\x3e\x6a\xd2\xfd\x48\x45\x81\xad\xe1\x92\x50\x42\x9c\x41\x5a\x1a\xf2\x87\xd1\xf6\x35\xfb\xd9\xd4\x34\xd4\x8d\xcb\x55\x71\xa0\x28\x9d\xce\xc7\x6b\xba\x3c\x33\x25\x3c\x2e\xba\x56\x9c\x2e\x78\xc0\x07\x4c\x3b\x24\x11\xb8\x75\xf5\x53\xfe\x06\x71\x67\x95\x28\xf5\x8a\xa4\xfd\x43\xe9\x64\x72\xfc\x4c\xa0\xe0\x51\xc1\xb7\x53\x13\xe7\x12\xd7\x59\xec\x7d\xec\x35\x7c\x3d\xcb\x95\xc4\x81\xfb\x1f\x81\x87\xda\x6b\xdb\x75\x57\x1d\x0a\xc6\x01\x05\xe3\x18\x0e\xd3\x1f\x48\x44\xe0\x31\x85\x23\x38\x1e\x7e\x3e\x87\xb9\x31\x22\xed\x74\x8c\xac\x63\x7c\xbd\x28\x50\xab\x16\x71\x79\x68\x21\x93\xe9\x2e\x6a\x7c\x9c\x0e\xe4\x45\x2b\x65\xab\x6d\x0a\x68\xfe\x2d\xad\x5d\x3c\xc8\x59\xfc\xb8\x92\x13\xaa\x5c\xa2\xf8\x22\x93\x92\xc3\xa1\x31\xab\x94\x10\xe9\x1b\x41\x40\x22\x1e\x67\x6c\x82\xdd\x57\xff\xf7\x49\x34\x49\x19\x41\x49\x13\x4f\xdb\xb6\x93\x24\x5f\xeb\x19\x20\x31\x12\x47\x3f\x10\x8c\x02\x54\x4c\xd2\x44\xec\xc5\xaf\x78\x07\xc3\x60\xe8\x73\x77\x53\x1f\xc7\xf8\xd2\xab\x51\xd7\x23\x1b\x49\x1e\x28\x03\x31\x2e\x6b\x4a\x69\x27\x82\x08\x83\x09\x58\x70\x50\xfc\x96\xd6\x2e\x9e\xa8\xe1\x46\x55\x6f\xdb\x4d\xf1\x69\x1a\x35\x2e\x3a\xdf\x61\x07\xc0\x60\x99\x47\xd7\x65\x19\x77\x81\x7e\x34\xf5\x73\x14\xce\xa7\xf7\xc5\x23\x69\xfa\xac\x2c\x3d\x28\x68\xea\x31\x5a\xc6\xdd\x64\x67\xec\xb9\x8c\x9c\x73\xe1\x40\x75\x1a\xdc\x69\xff\x71\xab\x1f\x4d\x86\xa6\x50\x77\x72\x3b\x9d\x39\xa5\x1d\xb1\x3d\x22\xc8\xd3\x6d\xea\x1b\xc4\x9d\xd5\x82\x3d\x3d\xb6\x38\x67\x69\x4e\xe8\x10\xa6\xb4\x23\x40\xde\xa7\x98\x54\x78\x63\x84\x43\x01\x1e\xa8\x1b\x0e\x3a\xd8\x33\x49\x37\xf9\x43\x70\x35\x2d\x2f\x9b\xc4\x0f\xe4\x65\x53\x4b\x7a\x40\x3a\x96\xa9\x38\x4c\x81\x76\x50\x8f\x71\xed\x65\xe3\xf0\x85\x95\xa1\xce\x06\x93\x75\xb5\x0e\x52\x6a\x3c\x5a\x49\x2a\x7e\x41\xdb\x59\x04\xe5\x14\x12\xf7\x59\x3b\x10\x4d\xcf\x1f\x8d\xc9\x8c\x59\xc1\xa2\x22\x1a\xf2\x54\x19\x0a\xb0\xd8\xb2\x38\x4f\xf6\x90\xcd\x63\xfe\x75\x39\xfa\x82\xce\x91\x3f\x34\x41\x11\xed\x77\x23\xd6\x79\xbc\x3e\x53\xc2\xd2\xd7\x0a\x87\x83\xad\x7d\x6c\x0b\x4c\x6e\x50\x31\xd3\x42\xc2\x2c\x12\xba\x80\x07\x4b\x8d\x9e\x29\xeb\xfa\xb7\x98\x3b\xbb\x02\xd9\x89\x47\x17\xaf\xc0\xfd\x4c\x51\x03\x6a\xd7\x4a\x97\x5e\x72\x4d\xd4\xe1\x34\x62\x94\xa7\xdc\x30\x72\x81\xb8\x5e\x99\x17\xef\xe8\xd4\x1b\xd2\x2c\x68\xfa\x16\x4a\x83\x72\xf7\xee\x07\x66\xea\x72\xcb\xdb\x0c\x7b\x0a\xde\x60\x46\xca\xb1\x46\xaa\xeb\x39\x31\x6f\xf3\x08\x1f\x88\x21\x1e\x32\xf3\x44\x75\x81\x7d\x5a\xb0\x5f\xf2\xf6\x36\xf1\x35\xbe\x16\x07\x72\x98\xb3\xaa\xf5\x91\x2b\xc4\x94\x3e\xa4\x9d\x2b\x27\x2b\x75\xa8\x4f\x67\xef\xee\x0e\x98\x89\xc0\xb6\xf0\x2f\x36\xdb\xa0\x6c\xa6\x10\x1a\xbd\x6c\xef\xb2\x8e\x39\xf5\x79\x71\xe3\x22\x71\x35\x2a\x2d\xb2\x3e\x3d\x37\x81\x63\x22\x87\x4d\xe5\xa9\xf5\xae\xe4\x8e\x67\xc7\x66\xb9\x1e\x2f\xef\x43\xfe\x0f\x70\x3b\x93\x9e\x0f\x3a\xcd\x4f\xa5\x36\x34\x17\xa1\x50\x44\x67\x8d\x6e\x31\xfe\x4c\x17\xfb\xc6\x0d\xd0\x68\x47\x3d\x86\x68\xad\xba\xe8\xf5\x7b\x1b\xcc\xea\xa0\xa9\x87\xb4\x8f\x9e\x91\xe7\x97\x11\x54\x35\xd3\x10\x8d\xbd\xd7\xee\x1c\x3f\xa8\x71\xc2\x87\xde\xbb\x3a\x9c\x23\x05\x8c\xb1\xb0\x2d\x44\x05\xfe\xb3\x9c\x2d\x8f\x7c\x00\x45\x4c\x68\x47\xb8\xa4\xe3\xed\x29\xff\x47\xec\x9d\x7d\x6d\xe9\x5a\x29\x7a\x66\xc9\x2a\x20\x27\x72\x42\x15\xe7\x2c\x99\x08\xa3\xac\xe3\xa4\x1d\x81\xea\xf5\x2a\xc6\x33\x7c\x45\xa2\xf5\xfb\xac\xcd\xab\xb4\x09\x93\x68\x80\xca\xd7\x7f\x6e\x76\xcd\x87\x00\x00\xe0\x80\x46\x31\x8c\xfe\x08\x30\x24\xa4\x23\x38\xf0\x60\xe4\xf3\x9d\xd3\x8a\x6b\xf8\x89\x0e\x18\xe5\xfc\x18\x51\xd9\x29\xe5\x3e\x11\xae\x0d\x5e\x29\xd7\x87\xe6\x88\x94\x77\x3a\x1e\x8f\x27\x52\xda\xb4\x3b\xfc\x9b\x6a\xbb\x88\x3a\xa1\x2f\x87\x49\x44\x0d\xa6\x5b\x2f\x58\x7f\xd4\xfb\xa6\xa3\x8d\x2b\x59\x85\x05\xc3\xca\x41\x9c\x0a\xd2\xa1\x56\xa6\x4d\x3e\xbf\x0f\xa3\xa2\xdf\xed\xe8\x7e\xca\x61\x30\x82\x10\x30\x0a\x93\x38\xf8\xf0\x29\x2c\x42\x61\x2f\x08\x71\xf0\x79\x14\x32\x0f\x29\x08\x20\x86\x3e\x79\x73\xee\x61\x2e\x5d\x1d\x2d\x3b\x47\x9e\x6b\x96\x21\xbe\x7f\x57\xf8\x19\x2d\x46\xda\x5a\x2e\x88\xe0\xfe\x65\x9d\x5d\xac\x03\xdc\xb4\x9a\x87\xe8\x8f\xc7\x78\x3a\xb2\xc7\x47\x99\x67\x90\x51\x58\x81\x84\x9c\x8a\x9a\x19\x20\xde\x69\xb0\xf9\x12\x96\x8a\xf0\xbe\x5f\xad\xbc\x24\x0b\x9a\xda\xcb\xfa\xd7\xe2\xde\x46\xfd\x98\x45\xc3\x6e\x73\x79\xbd\x26\x4e\x35\xa4\x91\x13\x19\xcd\x5d\x65\xd5\x7c\x9c\x35\x9d\xb3\x25\xb7\x93\xa1\xeb\x1d\x78\x2b\x3f\xf2\x91\x16\xf5\x46\xe0\x7e\x9b\xbb\xb3\xad\xb5\x6d\xec\xc4\x93\x13\x65\x7e\x00\x11\x6e\x96\xe7\x0a\xb5\x49\x7e\x93\x8f\x54\xb0\x69\xfe\xcc\xa3\x4e\x17\xf6\xf3\x56\x2d\xef\x4f\xa3\xf2\xc6\xf1\xf3\x25\x6e\x36\x78\xe3\xf8\x73\x31\xc7\x5e\x75\xf7\x6b\x0d\x7e\x6e\x4b\xc0\x84\x91\x04\xb6\x70\xf2\x99\x12\xd7\xf2\x3b\xb1\x0d\x82\x91\x9f\x1e\x3e\xd9\x7b\x76\x13\x7a\x09\xef\x0e\xdf\x20\xee\xac\xca\x87\xd2\x70\x4c\xb6\x3b\x45\x27\x25\xbc\xe6\x25\x34\xc5\x5c\x93\xa9\x25\x8b\x2f\xa1\x5a\x74\xc6\x39\x54\x16\xd9\x36\xc9\x47\xf6\xde\xd6\x5e\xe0\xb1\xed\x5f\x2d\xe8\x6b\xb3\xf3\x3f\xff\xbb\x8b\xfa\x9f\x68\x19\xa3\x7a\xc8\x9a\xfa\xb3\x32\xf0\xd7\xca\x89\xa5\x2d\x9d\x17\xe2\x92\xca\x62\xd1\x15\x3d\x8b\x4a\x0b\x9c\x47\xc9\x0d\x1f\x16\xc8\x28\x3a\xc6\x28\x07\xfb\x41\xb4\xd2\x9c\xdd\xff\xb2\xce\x2e\x16\x4f\x89\x11\xbd\xea\x4a\xc2\x75\xc4\x03\xbf\x86\xe4\xb0\x65\xb4\x1f\xb0\xb1\xda\x1c\x9f\x10\x1a\x06\xc4\x72\x93\x8b\x2b\xa2\x71\xef\x93\xb0\xca\xc6\x20\x8d\xca\xf2\xf3\xf5\x43\xda\x54\x51\x98\xf5\xbb\x66\xf5\xbc\xf8\xd4\x2d\xed\x6e\xfe\x8a\x60\x6e\xdf\xf5\xd0\xca\xbb\xc5\x74\xdc\xa8\x10\xb6\xef\xf9\x3a\x60\x70\xff\xac\x4f\x1c\xe5\x19\xdf\xc6\xee\x4c\xdf\xe2\xd5\xe3\xee\xad\xcb\xd5\xc0\x2a\x63\x4c\xba\xbb\x19\x4f\xc7\x9a\x83\x31\xf8\x7c\xee\x8b\xeb\x26\x6d\x40\x01\x1d\xdc\xf6\x7f\x46\xaf\xbc\x76\x18\xfb\x29\x18\xa7\x3e\x7a\xf1\xc1\xd7\x7e\x20\xae\x14\xd7\x9a\xbb\xfa\x16\xb3\x74\xf5\xb8\x13\xea\x0d\x51\x89\xd3\xe0\xc8\x87\x39\x18\x8a\x07\x3f\x5d\xf4\xed\xa9\x00\x81\x8f\x84\xef\x83\x77\xc6\x8f\x96\x25\xa2\x71\x32\x4f\x1b\x27\xe2\x37\xea\x7e\x3e\x65\xb6\x30\x20\x29\x8c\x15\xf7\x94\x8e\xed\x58\xf0\x8e\xe1\x80\x81\x00\xbc\x4f\xa7\xa6\x1e\x32\x28\xc9\xea\x22\x69\x5e\xd0\x9f\x67\x69\x69\xa1\x4a\x84\x44\xfb\x16\xd5\x37\x37\x71\x6e\xc6\x21\xa0\x42\x10\x9b\x27\xf2\xae\x25\x6e\xa1\xde\x84\xab\x96\x66\x2e\xe4\x9e\xe6\x5f\xd0\x76\x16\xcb\xeb\x54\xb3\x03\xe4\x56\xc4\x95\xc5\x1f\x74\xee\x9a\x06\x49\x1c\xb3\xc9\xac\x16\xde\xe4\xa7\x41\x3d\x45\xa6\x2e\x1e\x6c\x3b\x7f\x2f\xc1\x17\xb4\xa9\xa2\xc4\x7b\x59\xc4\xbe\x0a\x8a\x56\x2e\xe7\x93\x9e\xb6\xdc\x20\xe3\x4e\x9a\x12\x58\xb1\xbe\x10\x6a\xd0\x5c\x2a\xcd\xc8\x95\xf3\x55\x11\x9c\x64\x26\x65\x11\x91\x7e\x41\xdb\x59\x8c\x96\x43\xe2\x8b\x77\x88\xb3\x26\x96\xe5\xa5\x73\xee\x23\x0e\xdc\x09\x8e\x7f\x4c\x70\x34\x20\x40\x6a\x6b\x10\xe0\x5a\x6f\x12\xdf\xab\xd8\x46\x65\x19\x8d\x59\xd4\xbf\xc6\xec\xd7\x95\xd8\x3f\x37\x58\x26\xbe\xc9\x27\xf9\x4a\x00\xbb\x3d\x1c\xcb\xc0\xb7\x73\x83\x37\xc9\x95\x59\xd2\xb3\xc2\x64\x9a\x76\x55\x8f\x02\xd7\x01\x0a\x6c\xc1\xb7\x98\x3b\xbb\xf8\x46\x5f\x04\x1e\xae\x3c\x70\xa3\x5a\x97\xd0\xc0\x10\x4e\x23\x4e\x85\x71\xdb\xf7\x27\xd6\x0f\x21\x1a\x4b\x13\x32\x2b\xbd\x7f\x79\x97\xd0\x16\x09\x14\xf5\x7d\xd3\x7f\xde\xac\xfc\x6c\xc8\x76\x78\x81\xbc\xc1\xe4\x61\x51\x39\x74\x44\x3a\xd8\x2a\x0a\x3d\xdb\x89\x29\x98\xfe\x30\xce\x84\xa7\x9c\x15\xfe\x78\x8c\xe1\x43\x37\xff\x7b\xd8\xce\xa0\x3f\x7b\xf3\x12\x8b\xec\x51\xb1\x0e\x57\x24\xbd\xb7\xa0\x17\x19\x7e\x8a\x2d\x54\x92\xc7\x9a\x37\xc2\x18\xf4\xb8\x22\x29\x42\xf9\x3e\x9d\xda\x2a\xda\xbc\xfe\xd5\xd7\x3e\xc2\x2c\x8e\xcb\xcc\xdf\x75\x00\x8c\x67\x67\x5e\x80\x95\xd3\xaa\x37\xac\xe8\x5f\x75\x57\x9b\x6f\x77\xad\xe1\x64\xdb\xc0\x59\x61\x93\x0a\x28\x06\xe5\xc3\x67\x74\xf9\x7b\xd0\x9d\xe1\xec\x22\x92\x64\xd1\x1c\x53\x77\x31\x2f\xa0\x0d\x8c\xba\xb8\x14\x5d\x6c\x36\xc9\xe0\x27\x64\xf2\x50\x2c\x17\x1b\x0c\xde\x7d\x40\xef\xbd\xb8\xed\x9b\x2a\x1a\xd3\x68\x1a\xa0\xa0\xcc\xa2\x7a\xfc\x9f\xaf\x96\xfc\x2a\x09\xfd\x03\xfe\x68\xfb\xe8\x25\xf5\x79\x26\x24\x61\x1a\x20\x38\x05\x63\x1f\x5e\x84\x90\x80\xa6\x82\x30\xa4\x3f\x93\xe5\xdd\x68\x4e\x8c\x71\xd4\x2a\x27\x3f\x97\xf6\x4c\x7b\xa6\x3e\x15\xa6\xd1\x89\x77\x6f\x79\x64\x95\x0f\x4a\x13\xa7\x1d\x23\x08\xdd\xbf\x59\x7d\x57\x02\xf2\x66\xb3\x11\xb2\x62\x3c\xa1\x5e\xec\x9b\xa2\x9f\xef\x9e\xe1\xde\x21\xc6\x73\xb4\xcb\x9a\x42\x21\x6f\xd5\x07\xe5\xb1\xc1\x97\xe3\xfc\x0b\x13\x55\x13\x46\xef\x17\x29\x24\x40\x00\x8c\x03\x00\x7f\xe0\x01\x4a\x91\x20\xa4\x68\x1a\x7c\x46\xcf\xc2\x28\xe7\xa8\x18\xc6\x2b\x5a\x3e\xa9\xc3\x24\x64\x80\x31\x3b\x7a\x48\x15\x18\x15\xfb\x45\x29\x78\xa8\x9c\xf4\xe7\xc2\x3d\xa9\xbf\x49\x75\x17\x59\xf6\x6f\xa7\xc9\x1c\x5a\x34\xf4\x1d\x0e\x83\x43\x87\xd0\x0c\xd3\x5e\x2f\x2e\x2b\xf5\xe6\xc9\x14\xe8\xa1\xd3\x95\x04\x39\x53\x7e\xf3\xa7\xe2\x4d\x55\x35\xf5\x5e\x16\xc0\x00\x07\x08\x86\x20\xe4\x87\x1f\xf8\x24\x16\x46\x30\x15\xa2\xe4\x2b\xac\x4b\xba\xe1\x99\x76\x7c\x54\x6e\x74\x75\x63\x6c\xa7\xe6\xa7\x9a\x39\xbb\xed\x33\xb5\xe6\x47\x68\x66\xbd\x12\x9f\xee\x89\x76\xf1\x98\xdf\xd6\xdb\xc5\x0c\x3d\xcb\x22\x3b\x0d\xef\xf2\x87\x3f\x0d\x0d\xa9\x85\x41\xbf\x7a\xcc\x04\x0f\xcc\xc3\xef\x15\x3a\x23\x6d\x3b\x30\x65\xfb\xd9\xff\x69\xcc\xb6\x6f\x82\xf8\xed\x3e\x16\xc0\x30\x0e\x30\x18\x01\xd4\x07\xa0\x70\x1f\x43\x28\x0a\x03\xe8\xa7\xde\xa9\x61\xca\x55\x4d\x7c\x55\x90\x8e\xa1\xc7\x77\xcb\xd9\x2a\x7d\x55\x07\xfc\x78\xaa\x1e\xf6\x00\xe9\xde\x04\xa7\x52\x59\x1c\x6c\xfd\xb7\xf5\x76\x31\x03\x94\x19\x09\x03\x6a\xbc\x4e\xb8\x70\x4a\x98\x50\xb3\x68\x81\x78\xbc\x11\xbe\xe1\xfa\x25\x9b\x4a\x79\x2e\x51\x93\x89\x48\xa7\xf7\xa3\x77\x1f\xf4\xcd\x5c\x46\xeb\xab\xeb\x54\xd1\xd8\x67\xc1\x9b\x30\x8c\xc3\x28\x20\x31\x02\xa5\x3e\x22\x84\x84\xb1\x08\x10\x38\x20\x3e\xb7\xe4\x75\x11\xd4\x00\x9b\x94\xea\x28\x4c\x27\xce\x47\x2a\xf6\xe1\xb3\x3e\x82\x19\xa1\x62\x5c\x61\xca\x87\x66\xff\xb0\xb0\xaa\xdb\x4e\x7f\x87\xe2\x2e\xaa\xcf\x75\xb5\xb5\xe9\xc5\x63\x91\x4f\xd8\x40\xd5\xa7\x39\x7b\xe0\x25\xaa\xdf\xb9\xb5\x6b\x1b\xda\x3d\x40\x0f\xf9\x41\x5b\xf9\x23\x7a\x6f\x57\x43\x1b\x03\x14\xf2\xe2\xa8\x6f\x76\x7b\x94\x8a\x82\x36\x30\x92\x50\x3c\xe7\xeb\x90\xeb\x86\xeb\x6b\xb0\xc1\x1f\xfc\xbb\x74\x84\xa8\xf1\x3c\x6b\xa1\xf0\x18\x24\x0a\x65\x38\xe9\x17\xb4\x9d\xc5\x1c\x6b\xd7\x31\x53\xac\x26\x42\x1a\xc2\xaf\xf8\xe1\xd2\x5e\x19\x4e\xd1\x78\x56\x6a\x04\xa6\xc8\xd2\x95\x2c\x9b\x33\x60\x61\xee\x7d\x10\x7c\x41\x03\xef\x9f\xd7\x1c\x2f\x87\xa2\x58\x02\xfe\x66\xf8\x82\xae\x20\x19\x35\x9e\xab\xf1\x46\x58\x99\xb6\x8a\x0b\x8a\x1f\x5a\x1c\x78\x55\x1f\x0e\x99\xc9\xf5\x45\xf2\xef\x61\x3b\x83\x3d\xd2\x07\x2e\x77\x9b\x3d\x20\x09\xcb\xc5\xe4\x33\x33\xde\xbc\xa5\x33\x91\xa3\x22\x0e\x54\xaa\xf2\x13\x76\xad\xef\x71\x72\x11\xfe\x90\xd9\xf8\xbd\xf7\x35\x4c\x3e\x37\xd9\xdb\x23\x45\x1d\xdc\x7b\xf8\x61\xdd\xe4\x07\xcc\x29\x3c\x76\xb9\xc4\xa9\x8a\x35\x23\x85\x64\x81\x1b\xa6\xa9\x21\x48\x8c\x23\xe5\xd4\x2f\x68\x3b\x8b\xa0\x84\x8d\x15\xdf\x92\xcb\xf3\xec\x0d\x4d\x86\x3a\x26\x30\xd7\x76\xb8\x45\xa4\xd6\x89\xf0\x2d\xa7\xc3\xd4\x8d\xc9\x27\xea\x74\x7f\x54\xc3\x7c\xf6\xca\x31\xea\xe7\xc8\x1b\xd3\xa8\xaf\xbc\x7a\xb7\x96\x3a\xa2\x10\xae\xec\x41\x08\x9e\x65\xf7\xf0\xaa\x9b\x8c\x3d\x54\xf9\x54\x06\x34\x3a\x12\x4c\x30\xb0\xc2\x44\x9f\x02\xf0\xac\xc9\xb5\xf8\x0f\xc8\x3b\xeb\x81\x7e\xc1\xcc\xd3\x99\x19\x75\x27\x76\x6d\xe7\xc0\x87\x4f\xb4\xba\xf0\x5b\x62\xf9\x04\xa5\x1c\xe6\x63\x7e\xf4\xb0\x3c\x8c\x58\xf3\xbd\xe7\x7c\x09\xb4\x71\xe9\x25\x5f\x76\x3f\x0f\xf6\x9b\xc6\x98\x04\x77\x5a\x89\xd9\x89\x74\x52\x19\xcd\xda\x33\xa3\x3e\xbe\xa8\x83\x45\x58\xc4\x91\xf2\x12\xd1\x39\x66\xde\xc6\x17\xc9\x2f\x68\x3b\x8b\xbc\xeb\x92\x32\x5b\x00\x3f\xdc\xa6\x81\x43\x6f\x6e\x9a\xab\x7e\x7b\x98\x8f\x6d\x8f\x25\x5b\xf0\xec\x3a\x25\xbf\xd6\x52\x2d\x24\x7f\x34\x89\x9e\x59\x1b\xed\xcf\x27\xc6\x9d\x81\x92\xf4\x86\x64\x04\xd6\x97\x75\x8d\xad\xa1\x19\xfb\xa5\x4b\xa9\x09\xa5\x6f\x81\x36\x72\x81\xf8\x4c\xe4\xfa\x30\x43\xd2\x2f\x68\x3b\x8b\x0c\x55\xac\x12\x1e\xdc\xcf\xa9\x4f\x29\x07\xb4\x2d\x62\x55\x29\x03\x21\x78\xf8\x53\x24\x12\x75\xca\x54\xf0\xb1\x23\x6f\x7d\xcd\xbe\x6f\x79\x86\xb1\x8f\xc6\x20\xed\xa1\xd7\x15\xe4\xcf\x2b\x38\xe4\xeb\x34\x88\x23\xba\x8a\xdb\x85\x74\x0f\xc4\x93\x64\x90\x42\x7d\xf2\x12\x91\xa2\x16\xe0\xdf\xb7\xee\xe4\x98\x1c\x0e\x01\x9f\x37\xef\x3a\x79\xff\x0e\x72\x67\xd6\xa3\xd4\xda\x08\x32\x1f\xab\xd3\x14\x56\x3d\x83\xb1\x0e\xee\x38\x50\x64\x71\x09\x3b\x58\xd3\xc8\xd6\x89\x57\xa2\xe0\x8b\xbb\xf5\x7e\x50\x1e\xd6\x3a\x1c\x5f\xbb\xdd\x32\x7a\x46\x65\xe8\xbf\xb5\x42\x12\xa6\x60\x14\xc6\x71\xf0\x11\x60\x01\xf1\x6a\x84\x11\x1d\x7d\x8e\x89\x81\x29\x6f\xac\xc1\x97\x58\x3b\x1c\x0d\x74\x1d\x2e\x8e\x71\x13\xa8\x61\x20\xe4\x91\x86\x0f\x95\x07\x36\xc3\xec\x1b\x53\x61\xcf\xcc\xef\xca\xed\x42\x3e\x30\xe6\xde\x22\x97\xea\xa0\xcd\x51\xa5\x36\x2a\x64\xb1\x38\xa3\x2e\xb4\x73\x1b\x24\x69\xdc\x8e\x79\x73\x2e\xd5\x5b\xe6\x56\xf5\xfb\xce\x76\x8c\xea\x30\xea\xab\xac\x1e\xff\xf9\x2d\xcd\xcf\x4f\x8c\x10\xdf\xa0\xfc\x93\x59\xaa\x57\x21\x53\xe9\xa8\xd9\x28\x70\x6f\xfd\x81\xba\x1e\x6f\xc6\x11\x91\x2d\x13\x43\xec\x6c\xb5\x29\x2a\xba\x7f\x83\xb8\xb3\xca\x73\x04\x54\xe1\x68\x3e\xea\x5b\x0f\x29\xc7\x4a\x56\x84\x09\xee\x97\x18\x50\x50\x31\x5a\x8d\x35\xca\x7d\xcd\xf3\x78\xab\x1c\xfe\x14\x1c\xf4\x6b\x3b\xee\xdf\x87\x51\x30\x85\xc0\x30\x86\x93\x30\xf6\x81\x92\x04\x46\xe2\x74\x8c\x62\xde\xe7\x6a\x38\x51\x19\x46\x07\x07\x76\x29\x1b\x07\x75\x74\x1c\x0f\x9e\xdb\x51\x71\xda\x32\xdb\x1e\x1a\xd4\xc1\x70\x76\x90\xca\x71\xbc\xe7\xcc\xef\xeb\xed\x62\x6e\x98\x3b\xce\x32\x09\x4f\x6a\x5d\x50\x29\xdc\xe6\x67\xb7\xcc\xd1\x2d\x74\xe7\x8c\x2e\x61\x94\x5d\x89\x8c\x91\x8e\x78\x0e\x69\xc5\x9f\xc9\x46\x21\x82\xe3\x60\xff\xd9\x06\x09\x60\x84\x84\x71\x18\x01\xf4\x47\x48\xa1\x14\x09\x23\x78\x88\xf8\xf4\x2b\xe7\x16\x04\xb6\x37\xc1\x1a\x75\x14\xae\x37\x1f\x13\x4d\xfe\x4c\x51\xa9\xd1\x1c\x6c\x4b\x91\x7d\xc9\x4f\x10\xb2\x3f\x76\x7c\x9f\x72\xc2\xef\x0b\xee\x82\xd6\x23\x86\xa7\x7e\xca\x17\xb6\x15\xca\x6c\xd1\x23\xa6\x9a\xa8\x1b\xd7\xb7\x6c\x10\x38\xa1\x02\xd3\xb6\x9a\xa9\xae\x29\x5a\xc2\x9f\x06\x4d\x9a\x0f\xaf\xca\xea\xcf\x0a\x83\x9f\x6b\xeb\xd6\x9e\xac\x16\x4d\x54\x6e\x2e\xf3\x09\xc2\xda\x72\x3d\x09\x12\xfc\xbc\x1e\x5d\x94\xa2\x1b\x3a\x03\x9a\x37\x94\x61\x9e\xf1\x59\xf2\x5d\xec\xce\x74\x06\xdd\x2f\x02\x7e\x6f\x82\xfa\xe0\xf5\xc9\xf9\xcc\xfa\x00\xe9\xcc\xc7\xc0\xf1\x6c\xc0\xb8\xb2\x6f\xd0\x08\xe3\x9d\xad\xea\x32\x34\xdf\xa2\x63\x5f\xa6\x2f\x7a\xcb\xe2\x63\x7e\xed\x22\xec\x50\x41\xa6\x6e\xd2\x68\x19\x27\xc0\x0a\xdd\xb5\xf5\x85\xb9\xef\x46\x5e\x2e\x28\xac\xc1\xd8\xe1\xbb\xd8\xbf\xd7\x74\xe6\x3d\x3f\x0f\x23\xe0\xe7\x2c\x47\x8d\x61\x8d\x93\x94\x85\xa8\x94\xc7\x8f\x1e\xbd\x29\x20\x7c\x6a\x13\x8a\x3f\x6b\x0e\xbe\xf9\x75\x5e\x09\xd9\x6d\xb5\x0d\x9f\x99\xbf\x83\xdc\x99\x15\x9a\x8b\x2c\x3f\x0f\x87\x91\x57\xaf\x78\x77\xb9\x58\x75\x23\x75\xa3\xa5\x0d\xa9\xd1\x0b\x5a\x74\x86\xed\x21\xe1\xd5\x2b\xe3\x4d\xf2\x37\xc8\x9f\x6b\x44\x17\x08\xac\x6e\xe4\x80\xa2\xed\x89\x09\x6f\x91\x59\xa1\xf9\x42\xcf\xde\x7a\x73\xcb\xb1\x76\x80\x14\x32\xe6\xaa\x33\x20\x31\xee\xdf\x41\xfe\xbd\x66\xff\xdf\xaf\xfb\x8f\xe6\xbc\x30\xbc\x4c\x5b\x54\x41\xba\x53\x73\xad\x3e\x72\xd7\x2a\x59\x12\x5e\xc3\x2e\xbe\x47\x90\x62\x52\xb2\x84\x8b\xae\x48\x55\x9d\xe0\xef\x83\x77\xc6\xd7\x2a\xd0\x56\xdb\x2f\x1d\xae\x2d\x39\x4d\xcf\x7d\xd5\xf5\xfb\x23\x88\xed\xba\x1d\x4a\xc6\x94\xad\x2e\x3b\x25\xb6\xff\xe8\xcb\xe0\x9b\x7c\xe2\x07\xf8\xe8\x83\xcf\xc1\x11\x31\x33\x66\xb0\x1b\x74\x3b\x6e\x27\xea\xf0\x30\xdc\x33\x2d\x96\xb8\x73\xf2\xc4\x8a\x41\xa8\xf4\x56\xe5\x3c\xcd\xc8\x97\xe7\xd0\x09\xff\x19\xfc\x2f\x07\xf8\x7c\x89\xf1\xa3\xe9\x13\x68\x81\xea\x68\x7f\x75\x40\xc1\x34\x4c\x20\x28\x0a\x60\xf0\x01\x08\x10\x84\x18\x19\xd1\x20\xfe\xd4\xa8\x4d\x7e\x14\x9f\xe2\x2d\xe4\x6a\x50\x11\x92\x19\xcb\x46\x37\xaa\x21\x44\x1f\x48\x0f\x1d\x28\x83\x55\xd4\x07\x1a\xb9\x8f\xed\xcc\xfc\x25\x8d\x5d\x9c\xea\x0a\x14\xc8\x44\x47\x4f\x17\x0b\xde\x19\x48\xb4\x7f\x9c\xe7\xf1\x9e\x1e\xd1\x19\x8d\xc7\x6a\x9e\x87\x0e\x66\xef\x15\x63\x0c\xd8\xbf\xc4\x19\xd6\x3a\x78\xd3\x42\x01\x06\x28\x18\x60\xc4\x07\x08\x09\x38\xc2\x08\x18\x04\xc4\xe7\xc5\xf9\x2c\x2b\xae\x8f\x3d\x71\xaa\xc4\xef\xac\x4c\xca\xae\x71\xd5\x4e\xc4\x23\x8d\xd5\xce\xbf\x4c\xa4\x83\x47\xeb\xb1\xa4\x1e\xe9\xe5\xc9\xfc\x35\x91\x5d\x20\x63\x91\x93\x68\xc6\xad\xf3\xb2\x4d\x11\x0e\xe5\x67\x53\xc0\xa7\x28\x6f\x73\xab\x54\x23\x28\xdb\x7a\x96\x84\xcf\x4d\x4c\x22\x9e\xfc\x7f\xb4\xde\x4f\x8f\xf4\xeb\x3d\x13\x06\x63\xc4\x47\x08\xfb\x11\x4c\x22\x20\x40\xc9\xe8\x95\xa7\x41\xb5\xe1\x26\xb8\x94\x80\x45\x8e\x5d\x38\xbd\xa4\xd1\xee\x99\xb9\xde\x0b\xeb\xd1\x6d\xe2\xd9\xc4\x79\xd5\x1b\xcc\xb5\xa6\xac\xe1\x2f\x69\xec\xe2\xdc\x4c\x8d\xe2\x9f\xfc\x1a\x40\xa1\x84\xfb\x94\x89\xa7\x43\x3a\x16\xf9\xed\x20\x60\x88\x59\xaf\x83\xc2\xdd\xb5\x30\x0f\xb2\x53\xea\xfe\x4b\x9c\x31\x5a\xf6\xdf\x4d\x25\x04\x18\xb7\x51\xc0\xbb\xe8\xa4\x53\xf4\x58\x11\x8a\x99\xe7\x32\x6d\x69\x52\x05\x53\x54\x13\x82\x92\xf0\x6e\x4d\x6f\x1b\x76\xf2\xe7\xa0\x9d\x31\xa5\x93\x29\xe1\xae\xde\x01\x56\xe7\xc5\x19\x8d\x3b\xd9\x3e\xb4\x01\x71\x0d\xeb\x39\xcd\x20\x29\x6f\x67\x71\x24\xd7\x75\x9a\x3f\xff\xf6\xe1\xf3\x0b\x88\x1f\x3b\x6c\x12\xd5\x9f\xb7\x20\x6f\xd5\xa0\x60\x0a\x50\x28\x8d\x62\x1f\x28\x85\x46\x94\x8f\x04\xa8\x4f\x7f\x56\x9c\xaa\xa4\xca\xe7\x0e\x64\xd0\x84\x46\x6a\x4a\xf7\x9c\x3c\x0f\x28\x94\xd3\x54\xd2\x2e\xeb\x91\xe4\x30\xa3\xe4\x60\x35\x3c\xa5\x04\xf6\x9b\x6a\xbb\x88\xe7\x4c\x21\x95\xa5\x61\xae\xa7\x2a\x7b\xc4\x13\xc9\x31\x22\xd6\x3b\x69\x32\x1a\xe6\xd5\x5c\xe2\x31\xaa\x4a\x09\x1e\xec\x6a\xa9\x82\x3f\x14\xed\xdb\xe0\xb5\xbb\x07\x3f\x9f\x80\x2f\x4a\xbe\x35\x70\x73\xf5\xf4\x99\x3e\xe1\xce\xd5\x35\x4c\xd4\x50\x3c\x3a\xe5\xa5\x33\x55\x2b\xf7\x33\xdf\xbf\x01\x3a\x70\xc4\x5f\xe2\x76\x26\xd7\x86\x18\x48\x55\x23\x57\x8f\x4f\x4a\xbf\x6b\xc0\x19\xc6\xba\xcb\xc6\xa4\x1d\x2b\x12\xe5\xd3\x6c\xea\x4d\xb6\x2a\x21\x0c\x1e\xaf\x05\xb1\x69\x8b\xe4\x47\x56\x43\x41\x1a\x05\xc5\x8f\x27\xd8\x15\x84\x00\xc8\xab\xfc\x00\x45\xf0\x0f\x04\x0e\x11\x3c\x42\x28\x18\x83\x3f\xb7\xa4\x6b\xca\x59\xc9\xca\x61\x0d\x88\x2c\xce\x43\xc6\x47\x49\x46\x37\xb8\x3f\xdc\xf8\x86\xa0\x51\xff\x5c\x5a\x61\x59\x9e\x46\x21\xba\xc8\x7f\x4d\x64\x17\x88\x6b\x88\xcc\xb7\xce\xcc\x56\x33\x9e\x54\x74\x2d\x95\x4e\xe6\x5c\x9e\x75\xee\x01\x03\x22\x6f\x62\x28\xf0\x15\xcc\xb2\x71\x77\x83\x77\x81\xfe\xf7\x23\xc9\xcf\x4c\xff\xfc\xf6\x72\x51\xc5\xab\xf3\x98\xad\xa7\x44\xa7\xe9\x70\x08\xae\x97\x0e\x3f\x48\x78\x53\xab\xd3\xac\x5f\x53\x7d\xce\x26\x02\x5d\x96\x52\x1c\xb0\x7f\xcb\xda\xd9\x33\x37\x4a\xc9\x1f\x62\x61\x93\x54\x7c\xd3\x43\xff\xbe\x48\x39\x6b\x06\x09\x03\x36\x50\x51\x0f\x31\x86\xed\xea\x72\x4f\x19\x79\x1d\x76\xf6\xc6\xa6\xf2\x7f\xe2\x7e\x56\x02\x03\x30\x82\x01\x14\x27\x00\xfa\x11\x86\x04\xfa\xfa\x23\x8c\x18\x44\x9f\xb6\x27\xe3\x74\x0e\x83\x4c\x15\x3d\x41\xea\xb7\x08\xb1\x29\x1d\x65\x2e\x45\xd4\x5e\xcd\x14\x49\xd5\xbe\x31\x49\xef\x40\x06\x5b\x1c\xea\x7f\x49\x63\x17\x27\x1c\xa1\x47\xaa\xe1\xd4\x76\xc3\xae\xd4\xe5\xd6\xdb\x7c\x65\x46\x6c\x21\x11\x38\x3f\xc3\xe2\xb0\xde\xc5\x49\xb0\x4a\x07\xe0\xd5\x7e\xf8\xac\x5e\x55\xfe\x78\x22\xff\x78\x22\xff\x7b\x1c\xae\xee\xe9\xd3\xa6\x85\xe1\x7a\xa8\xbd\x3b\xae\xa3\x81\x57\x6c\xb1\x40\x03\x37\x2d\x33\xb5\xa9\xc3\x53\x48\x3c\xfa\x81\x67\x45\x5d\xf8\x53\xce\xce\x56\x2a\xd1\xa8\xc3\x56\x9d\x99\x0d\xec\x51\xbe\x9b\x15\xec\x53\xc7\xea\x90\xf7\x28\x9f\x00\x45\x59\xba\xb9\x3d\xe0\x0c\xb0\x4e\x93\xf4\xdf\xff\xf5\xff\x0f\x00\xde\xbc\x5c\x8e\xac\x35\x00\x00"),
 		},
-		"/k8s": &vfsgen۰DirInfo{
+		"/bare/k8s": &vfsgen۰DirInfo{
 			name:    "k8s",
-			modTime: time.Date(2018, 11, 4, 21, 45, 11, 317912450, time.UTC),
+			modTime: time.Date(2019, 2, 17, 21, 50, 54, 0, time.UTC),
 		},
-		"/k8s/.helmignore": &vfsgen۰CompressedFileInfo{
+		"/bare/k8s/.helmignore": &vfsgen۰CompressedFileInfo{
 			name:             ".helmignore",
-			modTime:          time.Date(2018, 11, 4, 21, 45, 11, 315820168, time.UTC),
+			modTime:          time.Date(2019, 2, 17, 21, 50, 54, 0, time.UTC),
 			uncompressedSize: 333,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\x8e\xc1\x6a\x23\x31\x0c\x86\xef\x7a\x8a\x7f\x99\xcb\xee\xb0\x78\x1e\x22\xd9\xc3\x9e\x5a\x48\xc9\xb5\x78\x66\x14\x5b\x89\x47\x36\xb6\x26\x69\x7b\xe8\xb3\x97\x24\x84\xf6\xf2\x81\x3e\x24\xf1\x75\x78\xf6\x66\x5c\xb5\xc1\x32\x24\x68\xae\x8c\x4b\x64\xc5\xb8\x4a\x9a\x45\x03\x8a\x9f\x4e\x3e\x70\x73\xd4\xe1\x25\x4a\x43\x5b\x4b\xc9\xd5\x1a\x5a\xe4\x94\x10\x52\x1e\xb1\x78\x9b\xa2\x68\xf8\x8b\xca\xc9\x9b\x9c\x19\xc5\x5b\xfc\xe1\xbd\xce\xd4\x41\x39\x78\x93\xac\xf8\x5d\x2a\x1f\xe4\x8d\x67\x5c\xc4\x22\x7e\xfd\x71\x78\xd2\xf4\x8e\xac\xb7\xcb\x6b\x12\x0a\x57\x24\x51\x76\xe4\xb6\xbb\xd7\x9d\xe5\xca\xd4\x61\x93\x97\x25\x2b\xf6\x9b\x1d\x66\xa9\x8d\x5c\x10\x1b\x6e\xbc\xe7\x93\x1b\x3f\xea\x70\xe3\x43\xc4\x30\x5c\xf1\x18\xdb\x59\x87\xef\x47\xa3\x9f\x4e\x6b\xc1\x41\x12\x37\xea\x5d\xbb\x14\xea\xdd\xe8\x4f\xd4\x3b\x5b\x0a\xf5\x9f\xd4\x61\xef\xab\xe4\xb5\xe1\xff\xf6\x5f\x23\x57\x6a\x3e\xf2\x64\xe4\x64\x66\x3f\xdc\xf7\x6a\x3e\xd2\x57\x00\x00\x00\xff\xff\xbc\x5b\x94\x77\x4d\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\x8e\xc1\x6a\xeb\x30\x10\x45\xf7\xf3\x15\xf7\xe1\xcd\x7b\xe6\x21\x7f\x44\xd2\x45\x57\x2d\xa4\x64\x5b\x64\x7b\x22\x4d\x22\x8f\x84\x34\x4e\xda\x2e\xfa\xed\x25\x29\xa1\xdd\x1c\x98\x03\x73\x39\x1d\x9e\xbd\x19\x57\x6d\xb0\x0c\x09\x9a\x2b\xe3\x12\x59\x31\xae\x92\x66\xd1\x80\xe2\xa7\x93\x0f\xdc\x1c\x75\x78\x89\xd2\xd0\xd6\x52\x72\xb5\x86\x16\x39\x25\x84\x94\x47\x2c\xde\xa6\x28\x1a\xfe\xa3\x72\xf2\x26\x67\x46\xf1\x16\x7f\x79\xaf\x33\x75\x50\x0e\xde\x24\x2b\xfe\x96\xca\x07\x79\xe3\x19\x17\xb1\x88\x3f\xff\x1c\x9e\x34\xbd\x23\xeb\xed\xf3\x9a\x84\xc2\x15\x49\x94\x1d\xb9\xed\xee\x75\x67\xb9\x32\x75\xd8\xe4\x65\xc9\x8a\xfd\x66\x87\x59\x6a\x23\x17\xc4\x86\x1b\xbf\xf3\xc9\x8d\x1f\x75\xb8\xf1\x2e\x62\x18\xae\xb8\x9f\xed\xac\xc3\xcf\xd0\xe8\xa7\xd3\x5a\x70\x90\xc4\x8d\x7a\xd7\x2e\x85\x7a\x37\xfa\x13\xf5\xce\x96\x42\xfd\x27\x75\xd8\xfb\x2a\x79\x6d\x78\xdc\x3e\x34\x72\xa5\xe6\x23\x4f\x46\x4e\x66\xf6\x03\xf5\xce\x96\x52\xf3\x91\xbe\x06\x00\xbc\x5b\x94\x77\x4d\x01\x00\x00"),
 		},
-		"/k8s/Chart.yaml": &vfsgen۰FileInfo{
+		"/bare/k8s/Chart.yaml": &vfsgen۰FileInfo{
 			name:    "Chart.yaml",
-			modTime: time.Date(2018, 11, 4, 21, 45, 11, 316310336, time.UTC),
+			modTime: time.Date(2019, 2, 17, 21, 50, 54, 0, time.UTC),
 			content: []byte("\x61\x70\x69\x56\x65\x72\x73\x69\x6f\x6e\x3a\x20\x76\x31\x0a\x61\x70\x70\x56\x65\x72\x73\x69\x6f\x6e\x3a\x20\x22\x31\x2e\x30\x22\x0a\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x48\x65\x6c\x6d\x20\x63\x68\x61\x72\x74\x20\x66\x6f\x72\x20\x43\x6f\x73\x6d\x6f\x73\x20\x53\x44\x4b\x20\x61\x70\x70\x0a\x6e\x61\x6d\x65\x3a\x20\x63\x6f\x73\x6d\x6f\x73\x0a\x76\x65\x72\x73\x69\x6f\x6e\x3a\x20\x30\x2e\x31\x2e\x30\x0a"),
 		},
-		"/k8s/templates": &vfsgen۰DirInfo{
+		"/bare/k8s/templates": &vfsgen۰DirInfo{
 			name:    "templates",
-			modTime: time.Date(2018, 11, 4, 21, 45, 11, 317695609, time.UTC),
+			modTime: time.Date(2019, 2, 17, 21, 50, 54, 0, time.UTC),
 		},
-		"/k8s/templates/NOTES.txt": &vfsgen۰CompressedFileInfo{
+		"/bare/k8s/templates/NOTES.txt": &vfsgen۰CompressedFileInfo{
 			name:             "NOTES.txt",
-			modTime:          time.Date(2018, 11, 4, 21, 45, 11, 316702849, time.UTC),
+			modTime:          time.Date(2019, 2, 17, 21, 50, 54, 0, time.UTC),
 			uncompressedSize: 430,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x90\x4f\x6b\xf2\x40\x10\xc6\xef\x7e\x8a\x07\x11\xf2\xbe\x07\xd7\xb6\x50\x0f\x01\x2f\x2d\x52\x02\x62\xc5\xfe\x81\x52\x4a\x99\xac\x13\xb3\x75\xb3\x1b\xb2\xb3\x5a\x91\x7c\xf7\x12\x85\x56\xe8\xa1\xcf\x71\x97\xe7\x37\xbf\x99\x4b\x85\x3b\x16\x48\xc9\xa0\xba\xb6\x46\x93\x18\xef\xf0\xb4\x9c\x21\xdf\xa3\x89\xce\x19\xb7\xee\xbe\x03\x43\xfb\xaa\x22\xb7\x0a\x69\x0f\x5d\xe6\xf7\x8f\xd3\x14\x99\xa0\xa2\x3d\x84\x36\x0c\x42\xc1\x3b\x54\xc6\x45\xe1\x80\xc2\x37\x47\xee\xcc\xd3\xea\x86\x2c\x39\xcd\x0d\xb2\x05\xc4\x23\x67\xd0\x96\x8c\xa5\xdc\xb2\x3a\xd1\x4e\x79\xf1\x11\x9a\x1c\x76\x24\xba\x3c\xb6\x83\x90\xc4\x00\x5f\x9c\xfb\x24\x9b\x98\xb3\x16\x8b\x35\x0b\xc2\x56\x63\xb8\xc3\xe1\x00\xe3\xb4\x8d\x2b\x46\x5f\xfb\x50\xf9\xa0\x8a\x68\xad\xa3\x8a\xfb\x50\x68\xdb\xa4\x07\xf0\x67\xed\x1b\xc1\xc3\x74\xf9\x9c\xdd\x4e\xdf\xb3\xc5\x64\xf0\xef\x17\x6b\xd8\x75\x42\x4d\x9a\x3b\xa8\x5a\xb2\x65\x0a\xac\xe6\xdf\xaf\x6d\xfb\xf7\x34\x0c\x3d\x3e\x82\x77\x35\x49\x39\x49\x0e\xea\xb4\x87\xb2\x67\xc7\x50\xc6\xad\x1b\x0e\xe1\xf5\xe2\x4d\x99\xba\x4d\xfe\x77\x82\xba\xf4\x28\x45\xea\x74\x34\x1a\xfc\x68\xa6\x57\xe3\xf1\xf5\xb8\xf7\x15\x00\x00\xff\xff\xdb\x3c\xd9\xd3\xae\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x90\x4d\x4b\xc3\x40\x10\x86\xef\xfd\x15\x2f\xa5\x10\x3d\x74\xab\x82\x3d\x04\x7a\x51\x8a\x04\x4a\x2d\xf5\x03\x44\x44\x26\xdb\x49\xb3\x76\xb3\x1b\xb2\xb3\xad\xa5\xe4\xbf\x4b\x2c\x68\xc1\x83\xbb\xb7\x19\xde\x67\x9e\x99\x4b\x85\x3b\x16\x48\xc9\xa0\xba\xb6\x46\x93\x18\xef\xf0\xb4\x9c\x21\xdf\xa3\x89\xce\x19\xb7\xee\xda\x81\xa1\x7d\x55\x91\x5b\x85\xb4\x87\xee\xcd\xef\x1f\xa7\x29\x32\x41\x45\x7b\x08\x6d\x18\x84\x82\x77\xa8\x8c\x8b\xc2\x01\x85\x6f\xba\x20\x66\x9e\x56\x37\x64\xc9\x69\x6e\x90\x2d\x20\x1e\x39\x83\xb6\x64\x2c\xe5\x96\xd5\x91\x76\xfc\x2f\x3e\x42\x93\xc3\x8e\x44\x97\xdf\xe9\x20\x24\x31\xc0\x17\xa7\x3e\xc9\x26\xe6\xac\xc5\x62\xcd\x82\xb0\xd5\x18\xee\x70\x38\xc0\x38\x6d\xe3\x8a\xd1\xd7\x3e\x54\x3e\xa8\x22\x5a\xeb\xa8\xe2\x3e\x14\xda\x36\xe9\x01\xfc\x59\xfb\x46\xf0\x30\x5d\x3e\x67\xb7\xd3\xf7\x6c\x31\x19\x9c\xfd\x61\x0d\xbb\x4c\xa8\x49\x73\x07\x55\x4b\xb6\x4c\x81\xd5\xfc\xa7\xda\xb6\xff\x4f\xc3\xd0\xe3\x23\x78\x57\x93\x94\x93\xe4\xa0\x8e\x7b\x28\x7b\x72\x0c\x65\xdc\xba\xe1\x10\x5e\x2f\xde\x94\xa9\xdb\xe4\xbc\x13\xd4\xa5\x47\x29\x52\xa7\xa3\xd1\xe0\x57\x33\xbd\x1a\x8f\xaf\xc7\xbd\xaf\x01\x00\xdb\x3c\xd9\xd3\xae\x01\x00\x00"),
 		},
-		"/k8s/templates/_helpers.tpl": &vfsgen۰CompressedFileInfo{
+		"/bare/k8s/templates/_helpers.tpl": &vfsgen۰CompressedFileInfo{
 			name:             "_helpers.tpl",
-			modTime:          time.Date(2018, 11, 4, 21, 45, 11, 316974263, time.UTC),
+			modTime:          time.Date(2019, 2, 17, 21, 50, 54, 0, time.UTC),
 			uncompressedSize: 1042,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x93\x41\x6b\xdc\x30\x10\x85\xef\xfe\x15\x0f\xd1\x40\x9b\xb2\xce\xa1\xd0\xc3\x42\x4e\x69\x0f\xa5\x90\x42\x03\xe9\xb1\xc8\xf6\xa8\x1e\x90\x65\x57\x23\x6d\xb3\x24\xf9\xef\x45\x92\xe3\xdd\x0d\x18\xb2\xb7\xc1\x7a\x7a\xf3\xe6\x1b\xf9\xf1\xf1\xea\x12\x3b\x1e\xb6\x10\x0a\x30\x6c\x29\xec\x27\xba\x1e\xa2\x04\xdd\xf6\xb4\xc5\xe5\xd5\xf3\x73\x95\x54\xd5\xd7\x87\x49\xbb\x0e\xa1\x27\x38\x3d\x10\x46\x93\xeb\xb6\xd7\x3e\xd4\xd5\xac\xdb\xa0\x23\xc3\x8e\xa0\xda\x51\x86\x51\xea\x24\x55\xd8\x1c\x0e\x75\xb4\x01\xf5\x4d\xbe\x75\x9b\x7c\xea\x7b\x6d\x23\x15\xe5\x8f\x1d\x79\xcf\x1d\xe1\x09\xc1\x47\xd7\xe2\xf3\xa7\x5c\xf2\x70\x17\x8d\xe1\x07\xa8\xcd\xc1\x8c\x5c\x97\xeb\x12\xef\xc6\x93\x0e\x04\xbd\xf4\x30\xd1\xda\x3d\xfe\x46\x6d\xd9\x30\x75\xd0\xd3\x94\x83\xd7\xd5\x2f\x2a\xee\x59\x1f\x52\x8f\x34\x84\xa0\xa1\x56\x47\x21\xc8\x38\x10\xbe\xc7\x86\xbc\xa3\x40\x52\xc6\x35\x4c\xb6\x13\x68\x4f\xb0\x3c\x70\xa0\x0e\x61\x44\xe8\x59\xf0\xbe\xd9\x67\x14\x5f\x6e\xef\x92\x96\xdd\x1f\xc8\x44\xed\x87\xba\xfa\x66\xe0\xc9\x92\x96\x99\x59\x3b\xba\xa0\xd9\x49\xa1\x56\xbe\x71\xc0\x3f\xb6\x16\x0d\x21\x4a\xca\x29\xd0\x39\xfc\x9c\x76\x8d\x6c\x92\x9c\xd2\x65\xb3\xc0\x7c\x39\x5c\x80\xbe\x68\x56\x05\x6f\x22\x6e\xe5\xe0\xf4\x2e\xa7\xdf\x5e\xbf\x7d\xa9\x47\x39\x17\x10\xc5\xa5\xfe\x59\x28\x95\xcb\x4b\xd6\x93\xaf\x67\x07\x9c\x3c\xbb\x60\xa0\x2e\x64\x73\x21\xea\x95\x5b\xe9\x7b\xce\x33\x5b\xab\x4f\x9e\xdf\xd1\x5e\xd3\xcf\xb2\x23\x2f\x3c\xba\xb4\xd3\xbc\xdb\xf9\xa1\x14\x95\xd5\x0d\xd9\xf5\xfd\x66\x91\x5a\x9d\xe6\x18\x76\xa9\xef\xe7\x66\x4f\xf0\x34\x59\xdd\x12\xd4\x47\x05\xf5\x5b\x9d\x35\xe6\xff\x00\x00\x00\xff\xff\x0e\x39\x11\x77\x12\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x93\x41\x6b\xdb\x40\x10\x85\xef\xfa\x15\x8f\x25\x81\x36\xc5\xca\xa1\xd0\x83\x21\xa7\xb4\x87\x52\x48\xa1\x81\xf4\x58\x56\xd2\x6c\x3d\xb0\x5a\xa9\x3b\xb3\x6e\x4c\x92\xff\x5e\x76\x25\xdb\x71\xc1\xc1\xbe\x0d\xda\x6f\xde\xbc\x7d\xb3\x7a\x7a\xba\xbe\xc2\x9a\xfb\x25\x84\x14\x8e\x3d\xe9\x66\xa4\x9b\x3e\x89\xda\x76\x45\x4b\x5c\x5d\xbf\xbc\x54\x99\xaa\xbe\x3c\x8e\x36\x74\xd0\x15\x21\xd8\x9e\x30\xb8\x52\xb7\x2b\x1b\xb5\xae\x66\x6e\x81\x8e\x1c\x07\x82\x69\x07\xe9\x07\xa9\x33\x6a\xb0\xd8\x1f\xda\xe4\x15\xf5\x6d\xe9\xba\xcb\x3a\xf5\x83\xf5\x89\x26\xf2\xfb\x9a\x62\xe4\x8e\xf0\x0c\x8d\x29\xb4\xf8\xf4\xb1\x94\xdc\xdf\x27\xe7\xf8\x11\x66\xb1\x17\xa3\xd0\x95\x7a\xb2\x77\x1b\xc9\x2a\xc1\xee\x66\xb8\xe4\xfd\x06\x7f\x92\xf5\xec\x98\x3a\xd8\x71\x2c\xc6\xeb\xea\x27\x4d\xea\x85\xd7\x3c\x23\x5f\x42\xd0\x50\x6b\x93\x10\x64\xe8\x09\xdf\x52\x43\x31\x90\x92\x94\x2e\x38\x26\xdf\x09\x6c\x24\x78\xee\x59\xa9\x83\x0e\xd0\x15\x0b\xde\x35\x9b\x12\xc5\xe7\xbb\xfb\xcc\x72\xf8\x0d\x19\xa9\x7d\x5f\x57\x5f\x1d\x22\x79\xb2\x32\x67\xd6\x0e\x41\x2d\x07\x29\x03\x75\xfa\xc6\x8a\xbf\xec\x3d\x1a\x42\x92\xec\x53\x60\x8b\xf9\xd9\xed\xb1\x64\x33\x72\x98\x2e\xbb\x5d\x98\xdb\xc3\x5d\xa0\x5b\xe6\x28\x70\x52\xe2\x5e\xf6\x4a\x17\xb9\x1d\xcb\x9b\xd3\x97\xba\xed\x64\xb7\x0f\x62\x52\xa9\x7f\x4c\x29\x4d\xcd\x5b\xee\xf0\xeb\xd9\x06\xc7\xc8\x41\x1d\xcc\xa5\x2c\x2e\xc5\xfc\xa7\x76\x11\x4e\xd6\x0c\xdd\x9b\xf5\xc1\xf3\x7b\xb5\xd7\xfc\xb3\xac\x29\x0a\x0f\x21\xef\xb4\xec\x76\x7e\x28\x13\xe5\x6d\x43\xfe\xf8\x7e\x0b\x64\x8e\xde\xe6\x75\xd8\x53\xfd\x30\x0f\x7b\x46\xa4\xd1\xdb\x96\x60\x3e\x18\x98\x5f\xe6\xac\x6b\xfe\x1b\x00\x0e\x39\x11\x77\x12\x04\x00\x00"),
 		},
-		"/k8s/templates/configmap.yaml": &vfsgen۰CompressedFileInfo{
+		"/bare/k8s/templates/configmap.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "configmap.yaml",
-			modTime:          time.Date(2018, 11, 4, 21, 45, 11, 317185974, time.UTC),
+			modTime:          time.Date(2019, 2, 17, 21, 50, 54, 0, time.UTC),
 			uncompressedSize: 385,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\xd0\x31\x4f\xc3\x40\x0c\x05\xe0\x3d\xbf\xc2\xea\xd4\x22\xd5\x11\x8c\xd9\x10\x12\x4c\x30\x80\xc4\xee\x5c\xdc\xc6\xaa\xcf\x77\x8a\x2f\x95\x50\xe8\x7f\x47\x49\x06\x40\xd0\xd9\xfa\xde\xb3\x1e\x65\x79\xe7\xc1\x25\x59\x03\xe7\xdb\xea\x24\xd6\x35\xf0\x90\xec\x20\xc7\x67\xca\x55\xe4\x42\x1d\x15\x6a\x2a\x00\xa3\xc8\x0d\x4c\x13\x88\x05\x1d\x3b\x86\x4d\x48\x1e\x93\xe3\x61\x54\x9d\x8f\x1b\x40\xb8\x5c\xf6\x61\xd1\x91\x72\x05\xa0\xd4\xb2\xfa\xac\x01\x28\x67\x3c\x8d\x2d\x0f\xc6\x85\x1d\x25\xd5\x57\x13\xbf\xd3\x16\xd9\xb3\x46\xf4\xbe\x0e\x3d\x0d\xe5\x5f\xb0\x5c\x7e\x88\xbf\x5d\x62\x5e\xc8\xc2\xda\x87\xaf\xac\x4c\xce\xf8\x42\x91\xaf\x9b\x48\x46\x47\xee\xf6\xed\xc7\x6f\xf5\xc6\xc3\x59\xc2\x02\xd7\x71\xa6\x09\xb6\x25\x2b\x6c\xf1\x51\x94\x1d\x9f\x34\xb5\xf3\x6f\xf3\x10\xf5\xcd\x66\x87\xf7\xbe\x6e\x0a\xb8\x83\x4f\x10\xeb\xd8\x0a\xdc\xcd\x01\x5f\x01\x00\x00\xff\xff\x38\x2b\x5f\xaf\x81\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\xd0\x41\x4b\xc4\x30\x10\x05\xe0\x7b\x7f\xc5\xa3\xa7\x5d\x61\xa7\xe8\xb1\x37\x11\xf4\xa4\x07\x05\xef\xd3\x64\x76\x1b\x36\x99\x84\x26\x5d\x90\xda\xff\x2e\x6d\x0f\x2a\xba\xe7\xc7\xf7\x92\x79\x9c\xdc\xbb\x0c\xd9\x45\x6d\x71\xb9\xad\xce\x4e\x6d\x8b\x87\xa8\x47\x77\x7a\xe6\x54\x05\x29\x6c\xb9\x70\x5b\x01\xca\x41\x5a\x4c\x13\x9c\x1a\x3f\x5a\x41\x6d\x62\x0e\x31\xd3\x71\xf4\x7e\x09\x6b\x10\xe6\xf9\x60\x56\x1d\x38\x55\x80\xe7\x4e\x7c\x5e\x34\xc0\x29\xd1\x79\xec\x64\x50\x29\x92\xc9\xc5\xe6\x6a\xe3\x77\xdb\x2a\x7b\xf1\x81\x72\xdf\x98\x9e\x87\xf2\x2f\x58\x93\x1f\xe2\xef\x5b\x4e\x73\x61\x35\xdb\x05\xf4\x2a\x5e\x38\x0b\xbd\x70\x90\xeb\x26\xb0\xf2\x49\xec\xa1\xfb\xf8\xad\xde\x64\xb8\x38\xb3\xc2\x6d\x9c\x69\xc2\xae\x24\x8f\x1d\x3d\x3a\x2f\x99\x9e\x7c\xec\x96\xbf\x2d\x43\x34\x37\xf5\x9e\xee\xf3\xb6\x29\x68\x8f\x4f\x38\xb5\xa2\x05\x77\x98\xe7\xea\x6b\x00\x38\x2b\x5f\xaf\x81\x01\x00\x00"),
 		},
-		"/k8s/templates/service.yaml": &vfsgen۰CompressedFileInfo{
+		"/bare/k8s/templates/service.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "service.yaml",
-			modTime:          time.Date(2018, 11, 4, 21, 45, 11, 317391293, time.UTC),
+			modTime:          time.Date(2019, 2, 17, 21, 50, 54, 0, time.UTC),
 			uncompressedSize: 545,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x90\xbd\x6a\xeb\x40\x14\x84\x7b\x3d\xc5\xe0\xde\x6b\xee\x85\xa8\xd8\x32\x69\x43\x30\x49\x48\x7f\xb4\x9a\x58\x8b\xf7\x8f\xdd\x95\xc1\x18\xbf\x7b\x90\xac\x22\xc6\x71\x11\x52\x9e\x39\x7c\x33\xcc\x48\xb2\x1f\xcc\xc5\xc6\xa0\x71\xf8\xd7\xec\x6d\xe8\x35\xde\x98\x0f\xd6\xb0\xf1\xac\xd2\x4b\x15\xdd\x00\x41\x3c\x35\x4e\x27\xd8\x60\xdc\xd8\x13\x2b\x13\x8b\x8f\x45\x7d\x8e\xce\x4d\xcf\x15\x14\xce\xe7\x06\x70\xd2\xd1\x95\x89\x01\x24\x25\xb5\x1f\x3b\xe6\xc0\xca\xa2\x6c\xdc\xdc\xf5\xb9\xf2\x00\x06\x3a\xaf\xca\xb0\x31\x83\xe4\xfa\x23\x30\x7f\xbe\x11\xb7\x59\x36\x94\x2a\xc1\x5c\xf2\xd4\x2b\x1d\xa5\x50\xbd\x88\xe7\x7d\xc6\x4b\x90\x1d\xfb\x75\x77\xbc\xa6\x96\x4d\x26\xb0\x24\x9a\xa9\x5e\x3d\x26\x6a\x3c\x47\xe9\x1f\xc5\x4d\x39\xb9\x01\x52\xcc\x75\x29\xbf\x9e\x0f\x8d\xff\x6d\xfb\xd0\xce\x0a\x50\x25\xef\x58\xb7\x37\x7a\xca\xb1\x46\x13\x9d\xc6\xfb\xd3\x76\xd1\x2e\x53\x25\xce\xbe\x85\x8e\xa6\xc6\xfc\xd7\x5d\x7f\xbb\xd2\x57\x00\x00\x00\xff\xff\x62\xee\xcd\x6f\x21\x02\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x90\xcd\x4a\x03\x31\x14\x85\xf7\xf3\x14\x87\xee\x9b\xa2\x60\x17\x59\xea\x56\xa4\xa8\xb8\xbf\xcd\x1c\x3b\xa1\xf9\x23\xc9\x14\x4a\xe9\xbb\x4b\xc6\x59\x58\x6a\x17\xe2\x32\xe7\xf2\x9d\x9b\xfb\x49\xb2\x1f\xcc\xc5\xc6\xa0\x71\xb8\xeb\xf6\x36\xf4\x1a\x6f\xcc\x07\x6b\xd8\x79\x56\xe9\xa5\x8a\xee\x80\x20\x9e\x1a\xa7\x13\x6c\x30\x6e\xec\x89\x85\x89\xc5\xc7\xa2\x3e\x47\xe7\xda\x70\x01\x85\xf3\xb9\x03\x9c\x6c\xe9\x4a\x63\x00\x49\x49\xed\xc7\x2d\x73\x60\x65\x51\x36\xae\x6e\xf6\x5c\x74\x00\x03\x9d\x57\x65\x58\x99\x41\x72\xfd\x15\x98\x26\x3f\x88\xeb\x5d\x36\x94\x2a\xc1\x7c\xff\x5b\xbd\xd2\x51\x0a\xd5\x8b\x78\xde\x66\xbc\x04\xd9\xb1\x5f\x6e\x8f\x97\xd4\xec\xa4\x81\x25\xd1\xb4\xf3\xea\x31\x51\xe3\x39\x4a\xff\x28\xae\xed\xc9\x1d\x90\x62\xae\xf3\xf1\xcb\xe9\xa1\x71\xbf\x5e\x3f\xac\xa7\x04\xa8\x92\x77\xac\x9b\xab\x3c\xe5\x58\xa3\x89\x4e\xe3\xfd\x69\x33\x67\xcd\x88\x46\xe2\xd4\x5b\xe8\x68\x6a\xcc\xff\xf5\xfa\x57\x4b\x5f\x03\x00\x62\xee\xcd\x6f\x21\x02\x00\x00"),
 		},
-		"/k8s/templates/statefulset.yml": &vfsgen۰CompressedFileInfo{
+		"/bare/k8s/templates/statefulset.yml": &vfsgen۰CompressedFileInfo{
 			name:             "statefulset.yml",
-			modTime:          time.Date(2018, 11, 4, 21, 45, 11, 317603393, time.UTC),
+			modTime:          time.Date(2019, 2, 17, 21, 50, 54, 0, time.UTC),
 			uncompressedSize: 2562,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x55\x4f\x6f\xda\x4e\x10\xbd\xf3\x29\x46\x9c\x7f\x36\x4a\xa4\x5f\x5a\xf9\x4a\xa5\x5e\x9a\x14\x85\x34\x55\x55\xf5\x30\xac\x07\xbc\xca\xfe\xeb\xee\x98\x8a\x52\x7f\xf7\x6a\xb1\x01\xdb\x31\x2a\x05\xd5\xc7\x99\x7d\x6f\x66\xde\xbc\xf5\xa2\x93\xcf\xe4\x83\xb4\x26\x03\x74\x2e\x4c\xd6\x37\x0b\x62\xbc\x1d\xbd\x48\x93\x67\x30\x67\x64\x5a\x96\x6a\x4e\x3c\xd2\xc4\x98\x23\x63\x36\x02\x30\xa8\x29\x83\xed\x16\xa4\x11\xaa\xcc\x09\xc6\xc2\x06\x6d\x43\xba\x2c\x95\x8a\xc9\x31\xa4\x50\x55\x23\x00\x85\x0b\x52\x21\x62\x20\x16\x48\x5f\xca\x05\x79\x43\x4c\x21\x95\x76\x72\x92\xa7\xc3\x01\x50\x90\xd2\x69\x28\x26\xa2\x40\xcf\x83\x80\x5d\xa6\x85\x78\x5d\x4b\x9a\xc0\x68\x44\x5d\x2f\x7d\x24\x45\x18\x28\x7d\x40\x4d\xa7\x31\x1a\x0d\xae\x28\x4f\x16\x9b\x2e\x6a\x4e\x7e\x2d\xc5\x0e\x18\x1c\x89\x38\x9e\x27\xa7\xa4\xc0\x50\x1f\x7c\x46\x55\x52\x48\x9b\xe0\xd4\x96\x86\xeb\x2a\xa5\xcb\x91\x69\xce\x1e\x99\x56\x9b\x5a\x17\xde\x38\xca\xe0\xd1\x2a\x25\xcd\xea\xd3\xee\xc0\x08\x20\x90\x22\xc1\xd6\xd7\x67\x34\xb2\x28\x3e\xb4\xc4\xbc\x46\xce\x4b\xe4\x59\x5b\x55\x6a\x9a\x2a\x94\xfa\x89\xb4\x53\xc8\xd4\x74\x92\x40\xdb\x1a\xf5\x57\x77\x12\x63\x49\x0d\x3c\x64\x54\x67\x86\x6b\xe7\xb8\xcc\x1c\x97\x6a\x70\x1a\x79\x86\x51\x6a\xe8\xde\x2e\x0d\x91\x10\x14\xc2\xbd\xcd\xa9\xa3\x48\x02\x8f\x84\xf9\x67\x2f\x99\x3e\x1a\x71\xd4\xce\x53\xb0\xa5\x17\xdd\xc3\x9e\xbe\x97\x14\xb8\x13\x03\x08\x6c\x3d\xae\xa8\xe3\xc6\x26\x96\x06\xf9\xb3\x33\x50\x13\x9f\x2a\x0c\xe1\xe1\xbc\x9b\x9d\x34\x18\x11\x31\x23\x00\x6e\x2c\xd1\x98\xb5\xe7\x87\xfe\xce\xaf\xdb\xf8\x25\x7b\x6b\xcb\x5e\x1b\xb2\xd5\x4e\xd2\xd8\x55\x58\xb3\x94\xab\xbe\x61\xa1\x89\xdf\xa3\xeb\x4a\x7c\xe6\x4f\x30\xa9\xe1\x1a\xdd\xe8\x40\xc7\x28\x0d\xf9\x81\x16\x62\xff\xd3\xe8\xd6\x01\xd7\x49\xbd\x5b\xe8\xb8\xb5\xd1\x5d\x28\xfe\x65\x6c\x90\x6c\xfd\x06\xaa\x2a\x7b\x95\x66\x5c\x41\x55\x8d\xfb\x4c\xb3\x52\xa9\x99\x55\x52\x6c\x3a\x26\xa9\x31\xee\x90\xec\x39\xdf\xaf\x42\x06\x5f\xdb\x3d\x08\xab\x35\x9a\x3c\x96\xf8\x0f\xc6\x81\xe3\x55\xfb\xd6\x82\x38\xeb\xfb\xe6\xdc\x4f\xeb\x88\x7c\x27\xd1\x12\x67\x66\xe3\x65\xbe\xbd\xbb\xfb\xff\xae\x77\xc4\x79\xcb\x56\x58\x95\xc1\xd3\x74\x36\xc8\xeb\x9d\xf8\x33\xed\x9b\x33\x69\x95\x5c\x93\xa1\x10\x66\xde\x2e\xa8\x3b\x47\xc1\xec\xde\x13\x67\x7d\x26\xe4\x22\x83\x49\x41\xa8\xb8\xe8\xe7\x06\xeb\x7b\xc2\x5c\xfe\xeb\x22\xb5\xaf\xef\xe3\x5b\x74\x62\x1f\xa7\x2e\x40\xfc\x74\xc4\xcd\x76\x45\xdb\x0f\x9c\xb5\xfc\x4e\x7a\xa8\xaa\x49\x0d\x1e\xe4\x1d\x7a\x07\xce\x64\x8d\xd0\x8e\x50\x87\x3f\xe0\x76\x0b\x6c\xbf\xa0\x56\xad\xd7\xb6\x49\xc2\x2f\x90\x26\x27\xc3\x70\x73\xbb\xf7\xef\x76\x9b\xc0\x0f\xc9\xc5\xe1\xb4\xb1\x39\xcd\x9b\x37\xf6\x68\xf2\x76\xb4\x53\xe3\xc8\xf9\xb6\x4d\x49\x3b\xe7\x0f\x57\xc0\xe5\x52\x1a\xc9\xad\x2b\xb4\x8f\x5c\xcb\xcc\x56\x91\x47\x96\xd6\x84\x23\x79\x2b\xf8\x17\xfc\xbf\x03\x00\x00\xff\xff\x7f\x61\x20\x91\x02\x0a\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x55\x4d\x6f\xda\x40\x10\xbd\xf3\x2b\x46\x9c\x8b\x51\x22\x35\xad\x7c\xa5\x52\x2f\x4d\x8a\x42\x9a\xaa\xaa\x7a\x18\xd6\x03\x5e\x65\xbf\xba\x3b\xa6\xa2\xd4\xff\xbd\x5a\x6c\x87\x35\x01\x85\x82\x2a\x9f\x3c\x33\xef\xcd\xd7\xdb\x5d\x74\xf2\x91\x7c\x90\xd6\xe4\x80\xce\x85\xf1\xea\x6a\x4e\x8c\xd7\x83\x27\x69\x8a\x1c\x66\x8c\x4c\x8b\x4a\xcd\x88\x07\x9a\x18\x0b\x64\xcc\x07\x00\x06\x35\xe5\xb0\xd9\x80\x34\x42\x55\x05\xc1\x50\xd8\xa0\x6d\xc8\x16\x95\x52\xd1\x39\x84\x0c\xea\x7a\x00\xa0\x70\x4e\x2a\x44\x0c\xc4\x04\xd9\x53\x35\x27\x6f\x88\x29\x64\xd2\x8e\x8f\xf2\xf4\x38\x00\x4a\x52\x3a\x0b\xe5\x58\x94\xe8\xf9\x20\x60\xeb\x49\x10\x2f\x73\x49\x13\x18\x8d\x68\xea\xce\xee\x49\x11\x06\xca\xee\x50\xd3\x71\x8c\x46\x83\x4b\x2a\x46\xf3\x75\x1f\x35\x23\xbf\x92\x62\x0b\x0c\x8e\x44\x6c\xcf\x93\x53\x52\x60\x68\x02\x1f\x51\x55\x14\xb2\xd6\x38\xb1\x95\xe1\x18\x0c\x50\xb9\x02\x99\x66\xec\x91\x69\xb9\x8e\x40\x00\x5e\x3b\xca\xe1\xde\x2a\x25\xcd\xf2\xcb\x36\x60\x00\x10\x48\x91\x60\xeb\x9b\x18\x8d\x2c\xca\x4f\xc9\x30\x2f\x19\xe7\x39\xe3\x59\x59\x55\x69\x9a\x28\x94\xfa\x81\xb4\x53\xc8\xd4\x56\x32\x82\x54\x1a\xcd\xd7\x54\x12\x6d\xa3\x06\xf8\xec\x49\x05\x71\x79\x1f\xe7\x89\xe3\xdc\x19\x1c\x47\x9e\x20\x94\x08\x04\xe8\xe4\xd2\x12\x09\x41\x21\xdc\xda\xa2\x9b\x65\xf3\x8d\xe0\x9e\xb0\xf8\xea\x25\xd3\x67\x23\x76\xb3\xf3\x14\x6c\xe5\x45\x3f\xd8\xd3\xcf\x8a\x02\xf7\x6c\x00\x81\xad\xc7\x25\xf5\xd4\xd8\xda\xb2\x20\x7f\xf7\x1a\x6a\xed\x13\x85\x21\xdc\x9d\x76\xb2\x47\x2d\x46\x44\xcc\x00\x80\x5b\x49\xb4\x62\xdd\xd3\xc3\xfe\xce\x2f\xdb\xf8\x39\x7b\x4b\xc7\xde\x08\x32\x29\x67\xd4\xca\x55\x58\xb3\x90\xcb\x7d\xc1\x42\x6b\xbf\x45\xb7\x83\xec\x34\xfe\xfa\xa8\x1a\xb8\x46\xd7\xa2\x85\x35\x8c\xd2\x90\x3f\x50\x42\xac\x7f\x12\xaf\xb2\x03\xaa\x93\x7a\xbb\xd0\x61\xb2\xd1\xad\x29\xde\x32\x36\x48\xb6\x7e\x0d\x75\x9d\xbf\x70\x33\x2e\xa1\xae\x87\xfb\x4c\xd3\x4a\xa9\xa9\x55\x52\xb4\x92\xed\x61\xdc\xb3\xb3\x5f\x03\xfa\x65\xc8\xe1\x7b\x5a\x83\xb0\x5a\xa3\x29\x62\x8a\x37\x30\x0c\x1c\x8f\xda\x8f\x04\xe2\xac\xdf\x17\x67\xd7\xad\x23\xf2\x3d\x47\x32\x9c\xa9\x8d\x37\xfd\xf5\xcd\xcd\xdb\x9b\xbd\x10\xe7\x2d\x5b\x61\x55\x0e\x0f\x93\xe9\x41\x5e\xef\xc4\xeb\xb4\xef\x4e\xa4\x55\x72\x45\x86\x42\x98\x7a\x3b\xa7\x7e\x1f\x25\xb3\xfb\x48\xdc\x37\x02\x38\xe4\x32\x87\x71\x49\xa8\xb8\xdc\xf7\x1d\xcc\xef\x09\x0b\xf9\xbf\x93\x34\xba\xbe\x8d\x6f\xd1\x91\x7d\x1c\x3b\x00\xf1\xd3\x11\x37\xdd\x76\x96\x2c\xdf\x5b\xcb\x1f\xa4\x87\xba\x1e\x37\xe0\x83\xbc\x87\xde\x81\x13\x59\x23\x34\xc1\x24\x37\xe0\x66\x03\x6c\xbf\xa1\x56\x3b\x58\xe7\x84\x3f\x20\x4d\x41\x86\xe1\xea\xba\xd3\xef\x66\x33\x82\x5f\x92\xcb\xe7\x68\x63\x0b\x9a\xb5\x6f\xec\x4e\xe4\xa9\xb5\x97\x63\xc7\xf9\x3e\xa5\x24\x53\xa4\xbf\xbd\x0c\xb8\x58\x48\x23\x79\xdd\x05\x00\x74\x96\x4b\x99\xd9\x2a\xf2\xc8\xd2\x9a\xd0\xc5\x00\x24\xc6\x7f\xe0\xff\x3b\x00\x7f\x61\x20\x91\x02\x0a\x00\x00"),
 		},
-		"/k8s/templates/storageclass.yaml": &vfsgen۰CompressedFileInfo{
+		"/bare/k8s/templates/storageclass.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "storageclass.yaml",
-			modTime:          time.Date(2018, 11, 4, 21, 45, 11, 317820289, time.UTC),
+			modTime:          time.Date(2019, 2, 17, 21, 50, 54, 0, time.UTC),
 			uncompressedSize: 556,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x91\xbd\x4e\x03\x31\x10\x84\xfb\x7b\x8a\x55\xfa\x38\xa2\x43\xd7\x22\x5a\x0a\x82\xd2\x6f\x7c\x43\x62\x65\xfd\x23\xaf\x1d\x08\xd1\xbd\x3b\x3a\x1f\x3f\x41\x24\xed\xcd\x7c\xdf\x9e\xc6\x9c\xdc\x06\x59\x5d\x0c\x3d\x69\x89\x99\x77\x30\x87\x7b\x35\x2e\xae\x8e\x77\xdd\xc1\x85\xa1\xa7\xf5\xfc\xfd\x41\x58\xb5\xf3\x28\x3c\x70\xe1\xbe\x23\x0a\xec\xd1\xd3\xf9\x4c\x2e\x58\xa9\x03\x68\x61\xa3\xfa\xa8\xe6\xb5\x8a\x4c\xe1\x82\x0c\x8d\xe3\xf2\x4b\x6c\x9b\x80\x48\x78\x0b\xd1\x49\x40\xc4\x29\x99\x43\xdd\x22\x07\x14\xb4\xb3\x37\xa5\xbf\xc2\x46\xee\x21\xde\xe8\x7e\x65\xf7\x9c\xcb\x55\xa0\x25\x17\xc4\xff\x5b\x2e\x68\xe1\x60\xe7\x7b\xe6\x19\x02\x56\x98\x27\xf6\xb8\xcd\x78\x0e\xbc\xc3\xb0\xdc\x9e\xfe\x52\x6b\xe4\xa3\xb3\x0d\x4c\x39\x1e\xdd\xb4\x29\xf2\xdc\xd9\xb0\x54\xa8\xf9\x1e\xf8\x22\x9f\xea\x2c\x12\xdf\x36\x51\xaa\xc7\xe3\x7b\xe2\x30\xbf\x46\xc9\x15\x5d\xe2\xcc\x1e\x05\xb9\xcd\x55\x4e\x09\x57\x85\x53\x30\xff\x71\x46\x12\x67\xb9\xb8\x18\x96\x37\xeb\x17\xa5\x97\x1f\xf2\x23\x06\xe8\xd5\x7a\x4b\x68\x1c\x3f\x03\x00\x00\xff\xff\xca\x8a\xe7\x09\x2c\x02\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x90\x3d\x6f\x32\x31\x10\x84\xfb\xfb\x15\x2b\x7a\x8c\xde\xee\xd5\xb5\x51\xda\x14\x21\xa2\x5f\x7c\x13\xb0\x58\x7f\xc8\xeb\x23\x01\x74\xff\x3d\xb2\xc9\x07\x51\xb8\xd6\x33\xcf\xb3\xf2\x70\x72\x1b\x64\x75\x31\xf4\xa4\x25\x66\xde\xc1\x1c\xfe\xab\x71\x71\x75\xfc\xd7\x1d\x5c\x18\x7a\x5a\x5f\xdf\x1f\x84\x55\x3b\x8f\xc2\x03\x17\xee\x3b\xa2\xc0\x1e\x3d\x5d\x2e\xe4\x82\x95\x71\x00\x2d\x6c\x54\x1f\xd5\xbc\x8e\x22\x35\x5c\x90\xa1\x69\x5a\x7e\x8a\x6d\x13\x10\x09\x6f\x21\x5a\x05\x44\x9c\x92\x39\x8c\x5b\xe4\x80\x82\x76\x76\x56\xfa\x23\x6c\xe4\x1e\xe2\x8d\xee\x57\x76\xcf\xb9\xdc\x05\x5a\x72\x43\xfc\xbd\xe5\x82\x16\x0e\xf6\xfa\x09\xf3\x0c\x01\x2b\xcc\x13\x7b\xcc\x33\x9e\x03\xef\x30\x2c\xb7\xa7\xdf\xd4\x1a\xf9\xe8\x6c\x03\x53\x8e\x47\x57\x37\x45\xbe\x76\x36\x2c\x23\xd4\x7c\x0d\x7c\x93\xd7\x3a\x8b\xc4\xb7\x4d\x94\xd1\xe3\xf1\x3d\x71\xa8\x64\x4f\x25\x8f\xe8\x12\x67\xf6\x28\xc8\x6d\xae\x72\x4a\xb8\x2b\xac\x41\x35\x11\x65\x24\x71\x96\x8b\x8b\x61\x39\x5b\xbf\x29\xbd\x7c\x93\xe7\x18\xa0\x77\xeb\xe7\x18\xa0\x34\x4d\x1f\x03\x00\xca\x8a\xe7\x09\x2c\x02\x00\x00"),
 		},
-		"/k8s/values.yaml": &vfsgen۰CompressedFileInfo{
+		"/bare/k8s/values.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "values.yaml",
-			modTime:          time.Date(2018, 11, 4, 21, 45, 11, 318033678, time.UTC),
+			modTime:          time.Date(2019, 2, 17, 21, 50, 54, 0, time.UTC),
 			uncompressedSize: 906,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x52\xb1\x6e\x1b\x49\x0c\xed\xe7\x2b\x08\x5f\xe1\x3b\xc0\xd2\x59\xae\x82\x6d\x6d\xc0\x70\xe1\xc4\xb0\x0c\xa4\x08\x52\x8c\x67\xb9\x12\xe3\x59\x72\x43\x72\x24\xac\x83\xfc\x7b\x30\x23\xc5\x4a\x90\x26\x15\x97\xcb\xf7\x1e\xf9\x38\xe4\x38\xe2\x87\x1d\xaa\x52\x8f\x1d\x9c\x9d\x85\xa1\xe4\xfc\xc7\xcf\xa0\x38\x65\x4a\xf1\x5a\x0a\x7b\x07\xab\x10\x92\x8c\x63\xe4\xbe\x55\x55\xc4\x6f\x48\x0f\x48\x1a\xe3\x06\xbb\x00\xa0\x38\x89\x91\x8b\xce\x35\xf3\xb8\xe9\x20\x47\x47\xf3\x00\x30\x95\x9c\x1f\x24\x53\x9a\x3b\xb8\x1b\xde\x8b\x3f\x28\x1a\xb2\x87\x60\x2e\x7a\x14\xf8\x07\x6e\xaf\x1f\x60\x52\xd9\x91\x91\x30\x6a\x25\x9e\xb2\x0e\x5e\xca\x33\x2a\xa3\xa3\x2d\x49\xfe\xdf\x24\x5c\x4c\x7d\xe3\xad\x3d\x72\x1f\xb5\x87\x7f\x59\x78\xb1\x5e\xdf\xfc\x07\x3d\xd9\x4b\x1d\x63\x9e\xb0\x83\xa9\x5f\xd8\x11\xd2\xf0\x8f\xb8\x21\xe1\x98\xe1\xe6\x80\x3a\xba\x75\x12\x7e\x6a\x04\x3d\x02\x7e\x36\x78\x3c\x01\xe0\x55\x18\x2d\xc0\x21\x76\x50\x6c\xb1\x47\xf3\xd5\x22\x5e\x9c\xbe\x9f\x1b\xab\xaa\x83\xd1\x2b\x06\x68\xa1\x83\xab\x5b\xaa\xbb\x35\x29\x9a\x2a\xf9\xdb\xf7\x06\xfc\x88\x50\xac\xc4\x9c\x67\x50\xac\x9b\x46\xee\x81\xc5\xc1\x05\x6c\xc2\x44\xc3\x0c\x3d\x0e\xb1\x64\x87\x37\x36\x44\xee\x2b\x20\x63\xdc\x21\xf8\x96\x0c\xa2\x41\x84\x24\x6c\x89\xa4\x58\x93\x4e\x5b\xa1\x84\x30\x88\x82\x6f\x6b\x1b\xd4\x25\x3c\x35\x70\x36\x01\xe2\xa4\x18\x0d\x0d\xd2\x36\x72\x3a\x44\x75\x03\x2d\x0c\xc2\x80\xbc\x23\x15\x1e\x91\xdd\x60\x4f\xbe\x85\x4c\xee\x19\x9b\xf6\xdb\x28\x17\x60\x25\x6d\x6b\xfb\x7b\x62\xaa\xcf\xb4\x84\xbb\x01\x66\x29\xd0\x0b\xec\x23\xff\xe6\xe4\x17\x5a\xe1\x83\x5b\x6f\xc3\x0d\x92\xb3\xec\x89\x37\x4d\x3d\x13\x57\x48\xec\xbf\x14\x6b\xf5\xb1\x36\x60\x4c\x68\x16\x75\xbe\x68\xfe\x15\x47\x69\xee\x11\x52\xd1\x3c\xc3\xb3\xc6\xb6\x9b\xc1\x51\xe1\xfc\xb4\xea\xf3\xe5\x51\x74\x24\xb7\xc3\xb5\x41\x9a\x4a\x07\xab\xcb\xcb\xf1\x90\x8e\x38\xd6\xe3\x85\xd5\xd5\xbb\x7b\x3a\x1a\xfc\x5a\xd0\xfe\x0e\x1f\x58\x7a\x5c\x63\xc6\xe4\xa2\xed\x65\x83\x4b\x46\x6d\x47\x63\x1d\x7c\xfa\x1c\x42\x1c\x06\x62\xf2\xb9\x95\x7f\x04\x00\x00\xff\xff\x53\xd8\xf8\x00\x8a\x03\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x52\xcf\x4f\x2b\x37\x10\xbe\xfb\xaf\xf8\x44\x0e\xb4\x12\x49\x09\xa7\x6a\xaf\x20\x21\x0e\xb4\x88\x20\xf5\x50\xf5\x60\xbc\xb3\x89\x8b\x77\x66\x3b\x63\x27\x5a\x10\xff\x7b\x65\x27\x0f\x78\x7a\x97\x77\xb2\x67\xfd\xfd\x98\x6f\x67\xd8\x8f\xf4\xe7\x9e\x54\x63\x4f\x1d\xce\xce\xdc\x50\x52\xfa\xe1\xa3\x53\x9a\x52\x0c\xfe\x5a\x0a\xe7\x0e\x6b\xe7\x82\x8c\xa3\xe7\xbe\xbd\xaa\x48\xbe\x89\xda\xee\x2e\x8e\x7e\x4b\x9d\x03\x94\x26\xb1\x98\x45\xe7\x5a\x65\xbf\xed\x90\x7c\x26\xcb\x0e\x98\x4a\x4a\x0f\x92\x62\x98\x3b\xdc\x0d\x7f\x48\x7e\x50\x32\xe2\xec\x9c\x65\xd1\x93\xc0\x02\xb7\xd7\x0f\x98\x54\xf6\xd1\xa2\x30\xa9\xc3\xd7\xaa\xc3\x4b\x79\x26\x65\xca\x64\xab\x28\xbf\x6d\x03\x2d\xa7\xbe\xf1\x36\xd9\x73\xef\xb5\xc7\x2f\x2c\xbc\xdc\x6c\x6e\x7e\x45\x1f\xed\xa5\xb6\x31\x4f\xd4\x61\xea\x97\x76\x82\x34\xfc\x23\x6d\xa3\xb0\x4f\xb8\x39\xa2\x4e\x69\x73\x14\x7e\x6a\x04\x3d\x01\xbe\x19\x3c\x7e\x02\xf0\x2a\x4c\xe6\x70\x3c\x3b\x14\x5b\x1e\xc8\xf2\x7a\xe9\x2f\x3e\xef\xcf\xcd\xa6\xaa\xc3\xe2\x2b\x39\xb4\xa3\xc3\xd5\x6d\x74\x4e\xc9\xa4\x68\xa8\xe4\xb7\xf7\x06\xfc\x8b\x50\xac\xf8\x94\x66\x28\xd5\x3f\x4d\xdc\x83\x25\x23\x0b\x6c\xa2\x10\x87\x19\x3d\x0d\xbe\xa4\x8c\x0f\x36\x3c\xf7\x15\x90\xc8\xef\x09\x79\x17\x0d\xde\xe0\x11\x84\x2d\x44\x29\xb5\xc7\x05\xc2\x4e\x62\x20\x0c\xa2\xc8\xbb\x6a\x43\xba\xc2\x53\x03\x27\x13\x44\x0e\x4a\xde\xc8\x10\x76\x9e\xab\x6a\xd8\x79\xcd\x06\x2d\x0c\x61\x10\xef\xa3\x0a\x8f\xc4\xd9\x70\x88\x79\x87\x14\x73\x4e\x35\xd1\xe2\xb3\x95\x0b\x58\x09\xbb\x6a\x7f\x1f\x39\xd6\x31\xad\x70\x37\x60\x96\x82\x5e\x70\xf0\xfc\x5d\x92\x2f\xb4\xc2\xc7\xb4\xb9\x35\x37\x48\x4a\x72\x88\xbc\x6d\xea\x29\x72\x55\xf6\xfd\xbf\xc5\xda\xfb\x58\x0d\x98\x02\x99\x79\x9d\x2f\x5a\x7e\xa5\x51\x5a\x7a\x42\x28\x9a\x66\x3c\xab\xaf\x29\xfc\x90\x49\x71\xfe\x61\xd5\x9d\xaf\x4e\xa2\x63\xcc\x56\x17\x74\x01\x84\xa9\x74\x58\x5f\x5e\x8e\xc7\x72\xa4\xb1\x2e\x2f\xd6\x57\xbf\xdf\xc7\x53\xc0\xff\x0a\xd9\xcf\xe1\x1d\x4b\x4f\x1b\x4a\x14\xb2\x68\x9b\xac\xcb\x92\x48\xdb\xd2\x58\x87\xbf\xff\x71\xce\x0f\x43\xe4\x98\xe7\x0e\x6f\xef\xee\xff\x01\x00\x53\xd8\xf8\x00\x8a\x03\x00\x00"),
+		},
+		"/bare/template.yml": &vfsgen۰FileInfo{
+			name:    "template.yml",
+			modTime: time.Date(2026, 10, 19, 10, 3, 17, 191481363, time.UTC),
+			content: []byte("\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x41\x63\x63\x6f\x75\x6e\x74\x73\x20\x6f\x6e\x6c\x79\x2c\x20\x77\x69\x74\x68\x6f\x75\x74\x20\x61\x6e\x79\x20\x6d\x6f\x64\x75\x6c\x65\x0a"),
+		},
+		"/generators": &vfsgen۰DirInfo{
+			name:    "generators",
+			modTime: time.Date(2026, 10, 19, 10, 13, 36, 573328901, time.UTC),
+		},
+		"/generators/message": &vfsgen۰DirInfo{
+			name:    "message",
+			modTime: time.Date(2026, 10, 19, 10, 13, 36, 577767122, time.UTC),
+		},
+		"/generators/message/x": &vfsgen۰DirInfo{
+			name:    "x",
+			modTime: time.Date(2026, 10, 19, 10, 13, 36, 577767122, time.UTC),
+		},
+		"/generators/message/x/{{ .Message.Module }}": &vfsgen۰DirInfo{
+			name:    "{{ .Message.Module }}",
+			modTime: time.Date(2026, 10, 19, 10, 13, 36, 578873210, time.UTC),
+		},
+		"/generators/message/x/{{ .Message.Module }}/client": &vfsgen۰DirInfo{
+			name:    "client",
+			modTime: time.Date(2026, 10, 19, 10, 13, 36, 577767122, time.UTC),
+		},
+		"/generators/message/x/{{ .Message.Module }}/client/cli": &vfsgen۰DirInfo{
+			name:    "cli",
+			modTime: time.Date(2026, 10, 19, 10, 13, 36, 581328901, time.UTC),
+		},
+		"/generators/message/x/{{ .Message.Module }}/client/cli/tx_{{ .Message.Type }}.go.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "tx_{{ .Message.Type }}.go.tmpl",
+			modTime:          time.Date(2026, 10, 19, 10, 13, 36, 587598816, time.UTC),
+			uncompressedSize: 1931,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x55\xd1\x6e\xdb\x36\x14\x7d\x26\xbf\xe2\x8e\x08\x06\x69\x50\xa5\x75\x1d\xf6\x60\xac\x03\x6c\xad\x2d\x82\x35\x45\xb1\x26\xd8\x43\xd1\x07\x9a\xa4\x65\xc2\x14\xe9\x91\xd4\xa6\x41\xe0\xbf\x0f\x57\x52\xec\x38\x75\x92\xe6\xc5\x86\xc8\x73\x0e\xc9\x7b\x0f\x0f\xf7\x5c\xec\x78\xa3\x40\x18\x4d\xa9\x6e\xf7\xce\x47\xc8\xe8\x30\xbc\x00\xbd\x81\xf2\x4a\x85\xc0\x1b\x55\xde\x04\x15\x80\xad\x9d\x33\x0c\x98\xb6\x91\x01\xeb\xc6\xbf\x94\x28\x61\x21\x7a\xe1\xec\x3f\x8c\x0e\x03\x28\x2b\xa7\xc1\x46\xc7\x6d\xb7\x2e\x85\x6b\xab\xb0\xdf\xbc\x7c\x55\x09\xb7\xf6\x9c\x51\x4a\x86\xe1\x28\x7c\xe5\x64\x67\x14\xa4\x04\x0c\x87\xdf\xb9\x8f\xbb\x06\x52\xaa\xfa\xea\x2c\x8a\x9d\x0a\x0b\x17\x5a\x17\xe6\xbf\x17\x41\xee\x2a\x61\xb4\xb2\xb1\x12\xce\x46\xd5\xc7\x6f\x85\x77\x51\x9b\xf0\x34\xd8\x49\x25\x18\x25\x41\xee\xe0\x71\x64\xfc\x6f\xaf\x50\x8f\x77\x71\x2b\x5a\xf9\x04\xba\xaf\x10\x77\xd8\xba\xd1\x33\x33\xf6\xeb\x67\x31\x63\xbf\xee\xb4\x91\xca\x33\x9a\x53\x5a\x55\x70\xb7\x84\x9f\xb6\xd8\xd9\x94\xae\xfb\xba\x95\x10\x94\x95\x01\xf8\x09\xe2\x03\x6f\xb1\x11\x25\xdd\x74\x56\x3c\xcc\xcd\x84\x14\xf0\xc3\x58\x8b\xb2\xc6\xdf\x1c\xbf\xd6\x9e\x97\xb5\x6b\x5b\x6e\x25\x0c\x94\x78\x15\x3b\x6f\xe1\xfb\x93\x89\x81\x12\x72\x13\xd4\x02\x00\xd8\x5d\xf9\x5b\x5e\x4a\xc3\x00\x9e\xdb\x46\x1d\xe7\xde\x6a\x65\x64\x40\x7f\xfc\x8a\x94\xa5\x47\x77\xfc\x76\xf0\x19\x2b\x28\x21\xe3\xd1\x16\xc0\x3e\xa1\xf7\xce\x9e\x69\x84\x2d\x7d\x13\x16\x00\xd3\x96\xde\xf4\x5c\x44\x1c\xc9\x86\x01\x8c\xb2\x67\x56\xcc\x91\xf4\x67\x67\xdf\x2c\x00\x2b\x92\x61\x23\x4f\x4f\x5a\x00\xf7\x4d\x80\xcf\x5f\x42\xf4\xda\x36\x39\x28\xef\x9d\xc7\xf3\x13\x12\xfb\x95\x91\x1e\x16\xaf\x61\x6e\x65\xf9\x41\xfd\x7b\xdd\xaf\xa6\x0e\xbd\xf5\xae\xad\xdf\x5f\x66\x79\xf9\x97\x8e\xdb\xb1\x8c\x58\xd7\x1c\x99\xc2\xe8\x3a\xf6\xc8\x9c\x5d\x8c\xcc\xfa\xfd\x65\x3d\x7d\x65\x79\x89\x28\x72\x4a\x3c\x8e\x2d\x85\x70\x9d\x8d\xbf\x2b\x6c\x91\xcf\x66\x0b\x96\xef\x54\xbc\x37\x83\xb4\x9c\x22\x6f\xe3\x5d\x5b\xe0\xe6\xc7\x45\xc7\xe5\x11\x8f\x9b\x5c\x4a\xe9\x55\x08\xd9\xb8\x33\xbd\x19\x41\xdf\xbd\x06\xab\xcd\x74\xcc\xdb\x4e\x2b\xef\xf1\x33\xd1\x43\x0b\x2f\x74\x01\x17\x1b\x54\xfc\xba\xb4\xb7\xf9\xa2\xfe\x86\xf2\x0f\x6d\x25\x60\x84\x68\xdb\xb0\x31\x3a\x08\x39\xb6\x1a\xf9\x58\xe4\xcf\xc3\x00\x17\x1a\x52\xfa\x32\x72\x95\x09\xea\xac\xce\x14\x51\xf7\x55\x0e\x87\x9b\x93\xaa\xfc\xc8\x7d\x50\x2b\xe7\x4c\x76\x2a\x9e\x1f\xd5\x4f\x64\x0f\x59\xf7\xb4\xea\xa5\x8d\xf7\x44\x0b\x78\xf9\x63\x01\xbf\xfc\xfc\x90\x78\xf7\x0c\xf5\x1b\xfd\x6c\x79\xe1\xb4\x0d\x8f\xe9\xcb\xdd\xa4\x5d\x23\xf0\x9e\xf8\x43\xa2\x7c\xf2\xc5\x13\xb2\x4b\x21\x66\x03\xa1\x97\x56\x4a\x6c\x5f\xfd\x74\x7e\x81\xf9\xd5\xf8\x56\x8f\x1d\x18\x77\x5e\x1c\x42\xda\xd0\xa0\x5f\xce\xbe\x1c\x78\x89\xce\x24\x43\x86\xde\x7f\x24\x76\x0a\x38\xc6\xce\x61\xad\xe9\xd6\xcc\xbb\x1a\xdf\x0e\x4c\x84\xbd\x51\x51\x2d\xad\x5c\x79\xc7\xa5\xe0\x21\x5e\xf7\xb5\xd1\xd9\x14\x04\xc5\x7c\xaf\x0a\x4c\x0b\xb9\x2b\xaf\x42\x33\xb4\xa1\x49\x78\xaf\x52\x41\x49\xa2\x89\xfe\x3f\x00\xc1\x95\x55\x6d\x8b\x07\x00\x00"),
+		},
+		"/generators/message/x/{{ .Message.Module }}/client/rest": &vfsgen۰DirInfo{
+			name:    "rest",
+			modTime: time.Date(2026, 10, 19, 10, 13, 36, 587598816, time.UTC),
+		},
+		"/generators/message/x/{{ .Message.Module }}/client/rest/tx_{{ .Message.Type }}.go.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "tx_{{ .Message.Type }}.go.tmpl",
+			modTime:          time.Date(2026, 10, 19, 10, 13, 36, 590166760, time.UTC),
+			uncompressedSize: 1215,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x53\xc1\x6e\xdb\x3a\x10\x3c\x8b\x5f\xb1\xd1\xe1\x41\x0a\x14\xfa\x9e\x87\x1c\x62\x23\x49\xdb\x34\x69\x60\x07\xe9\xa1\x28\x1a\x9a\xdc\x28\xac\x24\x52\x26\xa9\xda\xae\xc0\x7f\x2f\x48\x49\x68\xd0\xba\x4d\x2f\x36\xbd\x1c\xce\xce\xce\xac\x5b\xc6\x2b\x56\x22\x18\xb4\x8e\x10\xd9\xb4\xda\x38\xc8\x48\x92\x2a\x74\xb3\x67\xe7\xda\x94\x90\xa4\xef\x81\xde\xa0\xb5\xac\x44\x7a\xa3\x45\x57\x23\x78\x0f\x69\x28\x5f\xe9\xbb\xaa\x04\xef\x67\xbb\xd9\x41\x54\x4a\x92\xb4\x94\xee\xb9\x5b\x53\xae\x9b\x19\xd7\xb6\xd1\x76\xfc\x3a\xb1\xa2\x9a\xf1\x5a\xa2\x72\x33\xae\x95\xc3\x9d\xfb\x57\x78\xe7\x64\x6d\x5f\x07\x6b\x81\xfc\x75\x94\xd9\xb7\x4e\xcf\x2a\xdc\x07\x46\x2b\x2a\xf8\x3b\xde\xed\x5b\xb4\x29\xc9\x09\x09\x27\x78\x39\xf6\x03\x33\xe0\xfd\x12\x37\x60\x9d\xe9\xb8\x83\x9e\x24\x73\x66\x31\x54\xa2\x64\x3a\xfd\x7a\xfc\x6a\xb5\x3a\x4d\xd7\xcc\xe2\x17\x83\x9b\xf4\x91\xf4\xfd\x09\x18\xa6\x4a\xfc\x49\x77\x29\xb1\x16\x16\xbc\x1f\x22\xb8\x65\x4d\x34\x3e\x9c\xef\x43\x6b\xef\x27\x9e\x50\x7a\xb7\xfa\x70\x1b\x1c\x1f\xa8\x50\x89\xf0\xd0\x13\xf2\xd4\x29\x7e\x40\xe5\x1b\xa6\x44\x8d\xe6\x52\x65\x5c\x70\x38\x8e\x5e\xd1\x45\xf8\x2c\xa0\x5a\x43\xb0\x83\x5e\xe3\x3e\x28\x2c\x80\xd7\x72\xe1\x76\x30\xa6\x44\x17\xef\xdf\x2e\x86\x63\x0e\x61\x49\xe8\x44\x16\x5b\x91\xc4\xa0\xeb\x8c\x82\xd0\x39\xdb\x0e\x88\x25\xda\x56\x2b\x8b\x1f\x8d\x74\x68\x0a\x30\x70\x3c\xd6\x37\x1d\x5a\x97\x07\xab\x92\x6f\xcc\x80\xc1\xcd\x61\x4f\x49\x92\xc8\x27\x40\x63\xe0\xf4\x6c\x74\x73\x89\x4c\x2c\x2f\x56\xf7\x4b\xdc\x64\xdb\x02\x4c\x01\x5c\xf0\x02\xfe\x33\xb8\xc9\xff\x8f\xd0\xa3\x33\x50\xb2\x8e\xe4\xa3\x2a\x92\x24\x9e\x90\x24\x59\x8f\x49\x9c\x9e\x85\x96\x53\x30\x74\xc5\x94\x74\xf2\x3b\x66\xf9\xd0\xef\x68\xc4\xd1\x07\x56\x4b\xc1\x1c\xce\x99\x95\x3c\xdb\xe6\x87\x38\xa5\x7a\xd2\xc5\x24\xb1\x5a\xd3\x2b\x74\xd9\xf4\x3e\xa4\x37\x72\xfe\x2a\x6c\x18\x26\x3a\x73\x61\x8c\x36\x93\x57\x61\xa6\x68\xd2\xca\x31\xd7\xd9\x39\x13\xa3\x5b\xb1\x07\x8d\xd8\x2c\xcf\x7f\xd7\xd1\xd8\x32\x28\x38\xf8\x8f\xa4\xb7\xb8\x7d\x79\x31\x6e\x55\x66\x45\x45\xcf\x39\x3f\x17\xc2\xa0\xb5\x59\x18\x25\xe8\xbf\xeb\xd6\xd7\xb8\xcf\x72\x3a\x5d\xe4\x79\xdf\xff\x71\x51\x8b\xe8\xe5\x8b\x65\xed\xfb\x71\x13\x83\xca\x61\xce\x85\x6e\xda\x1a\x1d\x9e\x2b\x31\x37\x9a\x09\xce\xac\xbb\xdf\x85\x18\xa7\x0c\xe3\xb2\x15\x30\x3a\x57\xc0\xa7\xcf\x41\xdc\x8d\x2d\xfb\xc6\x96\x3e\x86\x9c\x93\xc4\x13\x4f\x7e\x0c\x00\x42\x63\x6e\xc3\xbf\x04\x00\x00"),
+		},
+		"/generators/message/x/{{ .Message.Module }}/msg_{{ .Message.Type }}.go.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "msg_{{ .Message.Type }}.go.tmpl",
+			modTime:          time.Date(2026, 10, 19, 10, 13, 36, 578873210, time.UTC),
+			uncompressedSize: 1903,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x55\xdf\x6b\xdb\x3e\x10\x7f\xb6\xfe\x8a\xab\xe0\x0b\x36\xa4\xce\xf7\x39\x90\x87\x76\x6c\xa3\x2d\x49\x61\x1e\x7b\x19\x63\x55\xac\x8b\xab\xc5\x96\x33\x9d\xdc\x12\x84\xff\xf7\x21\x5b\x69\x9c\xcc\x6d\xb7\xee\x29\xc1\x77\x9f\x1f\xf7\x39\xc9\xde\x8a\x7c\x23\x0a\x04\xe7\x20\x5d\x20\x91\x28\x30\x5d\xd4\xb2\x29\x11\xda\x96\x31\x55\x6d\x6b\x63\x21\x66\x11\x47\x9d\xd7\x52\xe9\x62\xfa\x83\x6a\xcd\x59\xc4\xd7\x95\xe5\x8c\x45\x24\x37\xc0\x0b\x65\xef\x9b\x55\x9a\xd7\xd5\x34\xaf\xa9\xaa\x29\xfc\x9c\x93\xdc\x4c\xed\x6e\x8b\xc4\x59\xc2\xd8\x74\x7a\x24\xb4\x14\x95\x97\x01\x45\x40\xa8\x2d\xac\x76\x90\xa1\x96\x68\x52\xe6\x31\xa3\xbd\x64\x4d\x93\x5b\x70\x2c\xea\x5b\x81\xe4\x26\xbd\xc8\xf3\x0b\x29\x0d\x12\xc1\x9d\xb7\x37\xe3\xd4\x15\xf9\x1d\x73\xee\x1c\x8c\xd0\x05\x1e\xa8\x3e\x28\x2c\x25\xf9\xf9\x22\xaf\xb0\x67\xf6\xff\x3f\x7b\xd9\xb6\xdd\xb3\xf8\x47\xd7\xd9\xed\x12\xda\x36\x50\xa1\x96\x1e\xd8\x76\xb3\x2c\xf1\x71\xcc\xa2\x41\xdb\x18\x4d\x20\x40\xe3\xe3\xd8\x10\x29\x5b\x37\x3a\x7f\x06\x1e\xd3\xd8\x5c\xce\x3d\x3b\xc5\xa4\x93\xb8\x30\xc5\xc9\x10\xce\x05\xb7\xc9\x68\x90\x8e\x45\xbd\xd1\xb1\xaa\x63\x51\xc8\x77\xe6\x57\x23\xd1\x4c\x5e\x49\x72\x18\xe5\x6c\xe0\x68\x32\x8c\x2d\xea\x92\x7b\x10\x06\xbe\x77\xf3\x2d\xa8\x80\xf9\xa8\x7e\x1f\xf0\xa7\xba\xb1\x08\xaa\xda\x96\x58\xa1\xb6\xb4\x07\x85\x00\xe3\x8a\x8a\x31\x74\xd2\x03\xe3\x04\xc8\x1a\xa5\x0b\x70\x61\x27\xfd\x73\x73\x83\x3b\xe8\x05\xba\xa8\xde\xc0\xef\x71\x23\xf4\x7c\xd8\x1c\xd6\xc0\x83\xd4\x47\xb4\x99\x2a\x34\x1a\x7a\xcb\x40\x07\x74\x9c\xc0\xd7\x6f\x27\x87\xfe\xc9\xc0\x69\xc5\x55\x54\xa4\xfd\x22\x5b\xef\xe3\x15\x95\xac\x9b\x67\x30\xd8\xd3\x19\x59\x57\x36\xcd\xb6\x46\x69\xbb\x8e\xf9\x08\xd6\xed\x4f\xcb\x7f\x0f\xaf\x1e\xd5\x00\x09\xbd\xfd\xd1\x68\xf9\x04\x0e\x5e\x5f\xa4\xf0\x6d\x03\x9a\xc3\x31\x0f\xb7\xf2\x8b\x28\x95\x14\x16\x2f\x05\xa9\xfc\x2d\x59\x1f\x11\xf8\x30\xe4\x26\x7d\x6f\x4c\x6d\xfc\x5b\x47\xad\xa1\x44\x1d\x1f\xbc\x26\x30\x9f\xc3\xff\xbe\xb4\xcf\x2a\xf4\x5f\xe9\x07\x4f\x14\x36\x31\x40\xa4\xfb\x98\x13\x16\xb5\x2f\x5f\x2b\x5f\x54\x6b\xc0\x9f\x90\xde\x28\x2d\x81\x8b\x9e\x8d\xfb\xe2\xd0\xcb\x20\x90\xbf\x31\x34\x80\xfd\xee\x0a\x4b\xc2\x63\xf5\xbc\x56\xfa\xa0\x7d\x76\xca\x70\x45\x5d\x74\x71\xf2\xbc\xf8\x3b\xcf\xf0\x07\xd2\xdd\x46\x8f\x5e\x1d\x81\x4e\xab\x92\x1d\x5d\xa8\xcb\x9d\xc5\x7f\xb9\x52\x1d\xbe\xbb\x54\xab\x9d\x45\xef\x7c\x35\x01\x34\x06\x66\x73\xf0\x5f\x81\x74\x21\x0c\xdd\x8b\xd2\x9b\x4e\xba\xfd\xfb\xe2\xd9\x1c\xb4\x2a\x7d\x77\xb4\x15\x5a\xe5\x31\x1a\xd3\xad\x73\x38\xf6\xa2\x21\x9b\xd5\xc6\x5e\x67\xb7\xcb\x78\x95\xb0\x96\xfd\x1a\x00\x9d\xbb\x5e\x97\x6f\x07\x00\x00"),
+		},
+		"/generators/message/x/{{ .Message.Module }}/msg_{{ .Message.Type }}_test.go.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "msg_{{ .Message.Type }}_test.go.tmpl",
+			modTime:          time.Date(2026, 10, 19, 10, 44, 35, 121868348, time.UTC),
+			uncompressedSize: 871,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x92\x31\x6f\xdb\x30\x10\x85\x67\xf1\x57\x9c\x88\x06\x10\x0b\x97\x49\xd7\x14\x1a\x12\xd4\xed\x50\x24\x43\x63\x74\x09\x32\x30\xe2\x59\x21\x2c\x91\x0a\x8f\x6a\x22\x28\xfc\xef\x05\x25\xd9\x35\xd0\x1a\xc8\x64\xf3\xdd\xe3\xf7\x8e\xa7\xeb\x54\xb5\x53\x35\xc2\x38\x82\xbc\x41\x22\x55\xa3\xbc\x71\xba\x6f\x10\x62\x64\xcc\xb4\x9d\xf3\x01\x0a\x96\xf1\x80\x14\x8c\xad\x39\x63\x19\xe9\x1d\xf0\xda\x84\xa7\xfe\x51\x56\xae\x3d\xaf\x1c\xb5\x8e\x96\x9f\x4f\xa4\x77\xe7\x61\xe8\x90\x38\x13\x8c\x6d\x7b\x5b\xc1\x06\x29\x1c\x27\xdc\xaa\x36\xf1\x8b\x00\x1f\x17\xac\xdc\x08\x18\x59\x46\x68\x35\x7a\xb8\x2c\x81\xf4\x4e\x5e\x55\xd5\x95\xd6\x1e\x89\x8a\xfb\x87\xc7\x21\x60\xc1\x67\x03\x17\x82\x65\x2d\xd5\xc9\x78\x8b\x2f\xff\x43\xcf\xc6\x71\x04\xaf\x6c\x8d\x7f\xeb\xdf\x0c\x36\x9a\x20\xc6\xd5\xf4\xe6\xf5\xab\x6a\xbb\x06\xe1\x83\xfc\x8a\xd6\xb5\x10\xe3\x38\x02\x5a\x0d\x31\x0a\xc6\x32\xb3\x05\xef\xfa\x80\x29\xa8\xa5\x5a\xfe\x4c\x87\x42\x7c\x59\xd4\xbc\x84\x49\xf1\x3f\x70\x48\xed\x67\x41\xae\xbd\x77\x7e\x5b\xf0\xde\xe2\x6b\x87\x55\x40\xbd\x78\xcf\x9e\xf9\x6a\xfe\x2b\x58\x16\x27\x74\x18\xba\x3d\x78\x33\x74\x13\x37\x49\x79\x09\xfc\xf8\x49\xa9\x06\x31\xf2\xd3\x09\x69\xdc\x73\x40\x18\xba\x03\x1e\xbd\xdf\xe3\x7f\xa9\xc6\x68\x15\xf0\x5a\x91\xa9\x52\x4e\xaa\xe5\x25\x58\xd3\x9c\xa6\x62\x92\x2e\xe1\xec\x37\x5f\x01\x7a\x7f\xe0\x92\xa9\x2d\x7a\xda\xb3\xbf\x63\xb8\x9b\x95\x04\x6e\xd0\x16\x8b\x41\x40\x5e\xc2\x67\x78\x7b\x83\x7c\x51\xee\x2f\x1e\xe4\xfa\xb9\x57\x0d\x2d\xdf\x47\x9c\x4e\x5f\xae\xcc\xf1\xcb\xe1\xd0\x42\x4a\x39\xca\xbe\x1e\x02\x52\x21\x04\x94\x25\x5c\x1c\x23\x0b\x6e\xdd\x74\x19\xd2\xfa\x10\x9f\x00\xd3\xea\xc8\xbb\xa9\x01\x98\x66\xf0\xae\x71\x95\xff\x8c\xab\xe0\x87\x6e\x95\x4d\x1e\xe7\xe1\xc5\x84\x27\xd7\x07\xd8\x2f\x2a\xcb\x22\x8b\xec\xcf\x00\x8a\x7b\x13\x46\x67\x03\x00\x00"),
+		},
+		"/module": &vfsgen۰DirInfo{
+			name:    "module",
+			modTime: time.Date(2026, 10, 19, 10, 12, 46, 854473850, time.UTC),
+		},
+		"/module/app.go.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "app.go.tmpl",
+			modTime:          time.Date(2026, 10, 19, 10, 3, 58, 388057732, time.UTC),
+			uncompressedSize: 3868,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x56\x4d\x73\xdb\x38\x12\x3d\x13\xbf\xa2\x97\x87\x14\xe9\xe2\x52\x77\x6d\xe9\xa0\x38\xd9\xc4\x6b\x5b\x9b\xb1\x67\x7c\x49\xa5\x26\x20\xd8\x92\x39\x22\x01\x06\x80\x46\x52\x69\xf4\xdf\xa7\x1a\x04\x29\x52\xa2\x1d\xd7\xe4\x10\x8b\xc0\xeb\xcf\xf7\x00\x74\xcd\xc5\x9a\xaf\x10\x78\x5d\x33\x56\x54\xb5\xd2\x16\x22\x16\x84\x28\x85\xca\x0b\xb9\x9a\xfc\x61\x94\x0c\x19\x0b\x32\x5e\x41\xb8\x2a\xec\xf3\x26\x4b\x85\xaa\x26\x42\x99\x4a\x19\xff\xe7\xdf\x26\x5f\x4f\x32\x6e\x90\xd7\x75\xc8\x82\xd7\x71\x42\xe5\x28\x42\x16\x98\x7c\xfd\x13\x8f\x76\x5f\xa3\xf9\xa9\xbf\xdd\x84\x6f\xec\xf3\x1b\x60\x19\x97\x6b\x82\x1d\x0e\x90\x7e\x52\x5f\xd6\x2b\x38\x1e\x27\xbb\x09\x7d\x3e\x71\x6d\xd2\x4a\xe5\x9b\x12\xe1\x78\x0c\x59\xc0\x33\x51\x0c\xb2\xb3\x28\x73\xd4\x55\x21\x6d\xff\x27\xc1\xba\x34\x45\x25\xdf\x60\x52\x16\x19\xe5\x55\x55\xd4\xd7\x20\xcf\xaa\xb7\xda\xe4\xd9\x59\x8d\xaf\x60\x4b\xb5\x0a\x59\x60\x2b\x97\xda\x1b\x02\xf8\x12\x62\xc6\x84\x92\xc6\x49\x80\xd7\xf5\x82\x57\x08\x33\x08\xa9\x43\xee\x37\xb5\x26\x66\x6c\x32\x81\xfb\xfd\xbc\xae\x61\x59\xec\x2a\x64\x64\xec\x17\x8c\xd5\x1b\x61\xe1\xc0\x82\xab\x8c\x57\xe9\x7b\x6e\x70\x5e\xd7\x2c\x10\xb9\x80\x2b\x47\x7c\x7a\x4d\xff\x33\x16\xac\x71\x7f\xcf\x0b\x09\xdd\xbf\x2b\x93\xaf\xd3\xdb\xa7\x47\xab\x34\xde\xe2\xde\x21\xe6\x42\xa8\x8d\xb4\xaf\x20\xfe\x8b\x78\xad\xca\x12\x85\x2d\x94\x1c\x45\x9c\xf3\xfb\x17\xd8\xc2\x3a\x9e\x2f\x3d\xb2\x80\x37\x11\x6f\x11\x6b\xd4\x3e\x2e\xe9\x2b\xf5\xa9\x34\x1b\x2c\x58\xf6\xe3\x7a\xb4\xc3\x0d\x12\x6a\xd1\x24\xbd\x81\x4b\x00\xa0\xb5\xb4\x05\x5c\x8a\xb0\x85\x5f\xee\xb4\x46\x47\xc7\xc4\x02\xb7\x7d\x32\x96\x1b\x29\xba\xb5\xa8\x54\xab\x15\x6a\x28\xd5\x2a\xbd\x73\x3f\x13\xc8\x33\xc8\xb3\x2a\xfd\xf0\x3e\x86\xab\xc6\xf0\xd0\xf0\x33\x9d\xc1\x3d\x5f\xa3\xe3\x27\x8a\x59\x90\xd1\xde\x74\x06\x44\xe4\x02\xb7\x9e\xcb\xc8\xeb\x22\x81\xb2\x73\x98\x34\x95\x7f\xc0\x25\xdf\x94\xf6\xd7\xdd\x07\x24\xaa\x75\x24\x72\x11\xc7\x8c\x05\x7f\x72\x4d\x17\x0c\xcc\xe0\x9d\x8b\x78\x60\x41\xe0\xdd\x4d\x81\xc2\x24\x2c\xa0\x14\xa6\xae\x31\x22\x17\x09\x63\x41\x2b\x90\x69\x27\x10\x20\x72\x17\xb8\x3d\xf1\x15\x85\x15\x2f\x64\x18\x93\xfd\x49\x2d\xd3\x97\xe1\x5c\x88\x0e\x3d\x20\x6a\x3a\x86\x5e\x22\x76\xe8\x97\x55\x34\x6a\x7a\xc9\x9a\xf3\x74\x64\x2c\xe0\x75\x9d\x0e\x55\x36\x6b\xfa\xb7\xc0\xed\x40\x64\x11\x0b\x1c\xd8\x35\xa4\xf9\x79\x2a\x92\xd2\x72\x56\x5f\xb4\xb2\xca\x75\xb3\xdb\x88\x7d\x94\x31\x8d\x9e\x62\x8d\x08\x35\x6a\xc3\x81\x0f\x36\xc0\xc4\x8d\xd7\x9e\x96\x49\x1c\x72\xdd\xaa\xa3\xe7\x63\x50\x9f\xb7\xbb\x6c\x49\xe7\xe5\x72\x8b\x7c\xf6\xfc\xbd\x4a\x40\x02\x3e\xed\xb6\xee\x07\xb5\xb1\xa8\xa3\x38\x65\x41\x30\xcf\x73\xf7\x19\x85\x94\x6a\x98\x74\x19\x7f\xe6\x32\x2f\xbd\xfb\x53\x49\xf1\xd0\x68\x24\x31\xb7\xa1\x6f\x71\x9f\x8c\x1d\xcd\x33\xc7\x97\x88\x36\x8c\x4f\xf5\x97\x0d\xea\xfd\x68\xbe\xff\x24\x34\x79\x2b\xde\x1a\xfa\x11\xed\x8d\x2c\xec\xf5\x33\x2f\xa4\xb7\x29\x4e\xdf\x9e\xb4\x47\xb4\x73\x69\xb1\xab\xa9\xd5\x69\x7f\xed\x9c\xef\x04\x5e\x10\x5f\x17\xfa\x9e\xc0\xee\x9c\x99\x9b\xf9\xd3\x5d\x2b\x74\x7f\xe2\x5f\x10\xfb\x88\x22\x7b\xeb\xaf\xc8\x83\x05\x14\x17\xb5\x86\xe9\xcc\xa5\x76\xa7\x78\x7e\xc7\x2d\x1a\xfb\x84\xda\x14\x4a\xb6\x1a\xa3\xfb\x26\x66\x41\xb1\x04\x42\xff\x6b\x06\xb2\x28\xe9\x31\x0b\x44\x25\xd3\x8f\xbb\xc2\x46\xa8\x75\xfa\x51\x6b\xa5\xa3\x38\x6e\x8e\xb3\x46\xbb\xd1\x92\xfc\xfa\x0b\xf9\x13\x4a\x34\x85\x79\xb4\xdc\x62\xff\x85\x1c\xac\x9f\x1e\x4a\x5f\xa2\x81\xaf\xdf\xdc\xc9\xec\x1d\x65\xf8\x4e\xd3\xd7\x34\xf4\xdd\x35\xe1\x77\x0a\xe1\x6e\x78\xca\xd8\xdf\xdf\x31\xf4\x68\x8b\x84\xdd\xb9\xfb\xe8\x5a\x49\x8b\x3b\x9b\x80\xc6\x1f\x40\x63\x4a\xfa\x80\x3f\x36\x68\x4e\x9c\xc7\xed\xb2\xa9\x95\x34\xd8\xad\x53\x52\x86\x92\xfc\xdf\xe3\xff\x17\xd4\x32\x8d\x3f\xd2\x79\x5d\xbb\x82\xde\xef\x2d\x1a\xc6\x82\x55\xbf\x98\xe9\x0c\x24\x6e\xa3\x7e\x81\xf1\xa0\xe1\x22\x17\xe9\x6f\xb2\xe2\xda\x3c\xf3\x92\xdc\x46\x5d\x80\x04\xfa\xae\x46\x9b\x5f\x73\x59\x08\xea\xbc\xef\xf8\x52\x69\xf8\x3d\x01\x2e\xdc\x9b\xa5\xb9\x5c\xe1\xc0\x49\xfb\x54\x1b\x67\xcd\x85\x68\x17\x16\x9b\x2a\x73\xf7\xcd\x85\x64\xd3\x4f\x68\x17\xb8\xb3\x03\x20\xf5\x32\xf6\x0a\x1b\xa2\xe9\x54\x34\x0b\x84\x49\xe0\x1d\x17\xe2\x4c\x0e\xa3\xad\x3d\x1c\xbd\x46\x3e\xee\x68\xd2\x6e\x9b\x3a\x97\xf9\x13\x2f\x8b\x9c\x5b\xa5\x0d\xa0\xdb\x33\x60\x9f\x11\x5c\x9b\x40\x2d\xdd\x07\xaf\xeb\xb2\x10\x9c\x06\x0e\xa0\x1e\xf0\xb6\x6a\x58\x16\x25\xa6\x23\xc2\x78\x25\x4c\x14\x43\x44\xea\x4a\x1f\xf8\xf6\x1e\x8d\xe1\x2b\x4c\xe0\xeb\x37\x3f\x32\xa6\x9e\xcb\x0e\x9f\xd0\x89\x50\x3a\xa6\x96\x92\xc4\x3c\xb1\x0b\xdc\x7a\xa1\x45\x56\x6f\x30\x69\x24\xf5\x19\x79\x8e\xfa\x70\x8c\x47\x84\xd2\x17\x09\xd1\xe3\xfb\x68\xa6\x23\xfa\x3f\x1c\xdd\x93\x39\x42\xc0\x8d\x45\xcd\x6d\x8b\x33\x0d\x0b\x54\x7f\x44\xaa\xe8\xcf\x6b\x31\x64\x4a\x35\x87\xb8\x58\x82\xf7\x92\x80\x5a\x53\x32\xa4\x8d\xe8\xea\x3c\x6c\xfc\x1f\xda\x26\x8b\x41\xf6\xad\x47\xd3\x08\x08\x65\x1e\x8d\x6e\x27\x70\xe5\xc3\x90\x78\x8e\x2c\x68\x35\xb1\xe4\xa5\x41\x16\x1c\xfd\x2d\xe8\x9c\x26\x70\x76\x4c\xee\x4f\x87\xe4\x46\xe6\x28\xed\x20\x48\x02\x61\x98\x40\x08\x10\x8e\x9e\x14\x1f\x48\x16\x65\x42\x6b\xce\x39\x75\x90\x05\x93\x09\x9c\xb8\x07\xae\x11\xa4\xb2\x50\x71\xc9\x57\x98\x43\xb6\x3f\x17\x58\xda\xbf\xd8\x7c\xe8\xd6\xad\xd7\x70\x37\x2c\xf6\x27\xcf\xde\x04\x39\x18\xf8\x29\x3d\x1a\x03\x69\xd0\x9c\x41\xb3\xb1\xc0\x2d\xcd\x99\xae\xfd\x0f\xb8\x2a\x8c\x45\xed\xc0\x6e\x70\x6c\x86\xe6\xd1\x8d\xcb\xa7\x6d\x14\x66\xf2\x71\xf3\x26\x7a\xb7\xa3\xf7\xb5\x55\x7e\xcb\xd7\x2c\x72\xc1\x8e\xec\xef\x01\x00\xaa\x24\xd5\xa6\x1c\x0f\x00\x00"),
+		},
+		"/module/cmd": &vfsgen۰DirInfo{
+			name:    "cmd",
+			modTime: time.Date(2026, 10, 19, 10, 3, 47, 890918811, time.UTC),
+		},
+		"/module/cmd/{{ .Name }}cli": &vfsgen۰DirInfo{
+			name:    "{{ .Name }}cli",
+			modTime: time.Date(2026, 10, 19, 10, 12, 46, 926974202, time.UTC),
+		},
+		"/module/cmd/{{ .Name }}cli/main.go.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "main.go.tmpl",
+			modTime:          time.Date(2026, 10, 19, 10, 38, 5, 535629368, time.UTC),
+			uncompressedSize: 2215,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x55\xc1\x6e\xe3\x36\x10\x3d\x93\x5f\x31\x25\x8a\x42\x02\x5c\x1a\x45\x6f\x06\x7c\xc8\x7a\x8d\x6c\x81\xcd\x36\x0b\xa7\x7b\x29\x7a\xa0\xc9\xb1\x43\x48\x22\x15\x92\x0a\x14\x18\xfa\xf7\x82\xb2\x64\x48\x91\x1d\xfb\xb0\x27\x13\xe2\x7b\x33\x6f\xde\x0c\xc7\xa5\x90\x99\xd8\x23\x14\x42\x1b\x4a\x75\x51\x5a\x17\x20\xa1\x84\x59\xcf\x28\x25\xa2\x2c\x81\x1d\x0e\xc0\xef\xed\x63\xb6\x87\xa6\x61\x94\xb0\xbd\x0e\xcf\xd5\x96\x4b\x5b\xcc\xa5\xf5\x85\xf5\xdd\xcf\xef\x5e\x65\x73\x99\x6b\x34\xe1\x46\xd8\x3c\xc3\x37\x7f\x2b\xd6\x95\xf2\x56\x68\xa8\x19\x25\xa2\x0a\xcf\xb2\x50\xf0\x31\xa3\x9e\x47\x5c\x4f\x94\xb9\x66\x94\x6c\x85\xc9\x6e\x61\x46\xdc\x98\x19\xad\xfa\x21\x9c\xe7\x85\x55\x55\x8e\xd0\x34\x6d\x9c\xa1\x83\xf3\x7a\x3e\x45\x8d\xa3\x0c\xf3\xfa\x72\xf7\xc7\x9f\x73\x69\xb7\x4e\xbc\xbb\x09\x68\x14\xba\x42\x9b\x30\x3c\xe6\x7a\xeb\x63\x34\x46\x53\x4a\xa5\x35\x3e\x80\x0f\xd6\xe1\x9d\x94\xb0\x04\x26\xa4\x64\x94\xbe\x0a\x17\x9b\xec\xac\x0d\xab\x42\xc1\x12\x7e\x6b\x13\xf0\x95\x2d\x0a\x61\xd4\x81\x12\xf2\x8f\xc7\x05\xc0\xb1\xf9\xdf\x44\x11\x45\xc6\xa8\x33\x4a\xc8\xe6\xd9\xba\xb0\x18\x5d\xc1\xaa\xd5\xcf\x66\x94\x34\x94\x7c\xc6\x9d\xa8\xf2\xb0\xfa\xfa\xd7\x17\x5b\x20\x2c\xc1\x7a\xbe\xae\x4b\x61\xd4\xda\xbc\x26\xec\xd7\x2f\x7f\x3f\xac\xe7\xfc\x5d\xe4\x34\x0a\xde\x55\x46\xb6\xb3\x98\xa4\x70\x68\xc7\x8f\x6f\x30\xac\xac\xd9\xe9\x7d\x92\x52\x72\x94\xb9\x36\x62\x9b\x63\x27\x76\x63\x5d\xd0\x66\x0f\x4b\xd8\x89\xdc\x23\x25\x52\x49\x58\x2c\x21\x72\x1f\x44\x86\x2b\xab\x50\x26\x29\x3d\x95\xcb\xef\x94\xea\xb8\xc9\xd1\x76\x7e\x4c\xb0\x2a\x54\x92\xa6\x94\xb8\x52\x0e\x30\x3e\xe9\x78\x31\xc4\x4b\x85\xee\x2d\x5a\xb6\xf8\xc0\x33\x00\xd6\xe2\xa2\x1b\xe4\x2e\xd7\xc2\xa3\x5f\xc0\xbf\xff\xf9\xe0\xb4\xd9\x1f\xd8\x0b\x6b\x06\x36\x02\xb0\xef\x11\x1d\x6b\xf0\xd5\x56\x76\x59\x23\xb9\x19\x64\x1c\xaa\xa6\xa4\xd5\xf8\x29\xb7\x32\xeb\xbf\xa5\xb3\xee\xeb\x0f\x91\x6b\x25\x82\x75\xc3\x9b\x94\x92\x50\x8f\x8a\xea\xe3\xce\x40\x2a\x99\x9e\xcf\xd3\xb9\xf3\x55\x1b\xfc\xe4\x50\x64\x1f\xc3\xee\x31\x9c\xa2\x53\xd2\x3f\x3f\x7e\x8f\xe1\x4e\x4a\x5b\x99\x38\x6a\x49\x3f\x8b\x6d\xda\x19\x4c\x41\x9f\x51\x5a\x85\x2e\x89\xaa\x5a\xe5\x9c\xf3\x94\x9e\x7b\x59\xdf\x6f\xe9\x05\x9b\xf2\x46\x33\x7c\xb2\xbe\xf7\x1d\x76\xd6\x41\x78\x46\x98\x12\xe1\x78\xea\x86\xfc\xb2\xa0\xeb\xde\x4c\xb9\x9d\x09\xd1\xa2\x58\xf9\xa9\xf0\xb3\x86\x5f\x4e\x1d\x9d\x0a\xf5\x55\x53\x42\x3d\x32\xe1\xc9\x09\xe3\x85\x0c\xda\x1a\x7f\x6e\x06\x43\xfd\x4e\x40\x57\xd5\xa3\xf5\xa3\xb2\xba\xbd\xc9\x37\x68\xd4\x53\x3d\xa9\xe5\x9c\x67\x4f\xd7\xc5\x5e\xe9\xe0\x48\xfc\x4f\xe8\xe2\xd3\x8d\xc5\x4e\x99\xc7\xd2\xc3\x99\xca\xa7\x0e\x5e\xc8\x7b\x61\x4d\x51\x72\x9a\x83\xd8\xb7\x36\x5a\x3c\xbc\x7f\xa0\xf1\x9b\x43\x1f\x36\xe8\x5e\xd1\x0d\x64\x9c\x43\x5e\xce\x95\xe1\x9b\xef\x3b\xe1\xbb\xfd\x41\x09\xd6\x28\xab\x60\x5d\x7c\x6f\x32\xd7\xfc\xd1\x61\x29\x1c\x3e\x08\x6d\x62\xa6\x2e\xd4\x0c\xd8\xb7\x0d\x9b\xc1\xf8\x3f\x20\xa5\x04\x5d\xcb\xec\xa3\xf0\x75\x7b\xc0\xb8\xd5\xf5\x0e\xe2\xed\x2f\x4b\x30\x3a\x8f\x7b\x9f\x94\xc2\x68\x99\xa0\x73\x29\x25\x0d\x6d\xe8\xff\x03\x00\x47\x9f\xe0\xdf\xa7\x08\x00\x00"),
+		},
+		"/module/cmd/{{ .Name }}cli/routes.go.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "routes.go.tmpl",
+			modTime:          time.Date(2026, 10, 19, 10, 12, 46, 927190192, time.UTC),
+			uncompressedSize: 938,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x92\xc1\x8e\x9b\x30\x10\x86\xcf\xf1\x53\x8c\x38\x85\x88\xda\xcf\x50\xa1\xaa\x8a\xda\x43\x95\x56\xbd\x1b\x33\x21\x96\xc1\x46\xf6\x20\x39\x8a\x78\xf7\xca\x90\x26\xbb\xac\xb2\x70\xc1\x30\xf3\xfd\x33\xbf\xf8\xdd\x4b\x65\x64\x83\xd0\x49\x6d\x19\xd3\x5d\xef\x3c\xc1\x9e\xed\xb2\x46\xd3\x65\xa8\xb8\x72\x9d\x50\x2e\x74\x2e\xdc\x8f\x2f\xa1\x36\x42\xb5\x1a\x2d\x09\xe5\x2c\x61\xa4\x6c\x23\x6e\xf0\x1a\xb6\xb2\xbe\x57\x5b\x51\x8a\xeb\xa4\xab\x31\xcd\x33\x78\xad\x64\x40\x58\xa1\xfd\xb5\x27\xf7\xdf\xae\x1c\xe8\xe2\x31\xd0\x8a\x28\x8a\x04\x3e\xec\x63\x48\xbf\xa5\x92\xd6\x6c\xd2\x26\x70\xa1\xbd\xdd\x80\xff\x95\x3e\xf0\xce\xd5\x43\x8b\x30\x8e\xa9\x01\x59\xaa\x7f\x77\xbf\x4c\x03\xe3\x28\xa2\xf8\x88\x2d\xe6\xbc\x5d\xdd\x38\xaf\xdb\x56\x8a\x6e\x88\x19\xcb\x19\x13\x02\x3c\x36\x3a\x10\xfa\x93\x1b\x08\xc3\xe3\x33\x00\x5d\x10\x4e\xdf\x7e\xff\x01\x3f\x77\xdc\x79\x2a\xc9\xbe\x6f\xb5\x92\xa4\x9d\xe5\xec\x3c\x58\xb5\x98\xb0\x57\xad\x2e\x29\xc2\xfd\x6e\xf0\xf2\xe7\xb1\x9c\x5f\x0b\xf0\x70\xe8\x86\xc8\xa7\x55\xbe\x00\x55\x2b\x38\x4c\xd1\xf0\x32\x3d\x0b\x30\x15\xdc\x23\xe2\x3f\xe6\x33\x87\xdb\x14\x5b\xe0\xa7\xf7\x6b\x92\x7e\xda\xc4\x8f\xb6\x46\x4b\x39\xdb\xf9\x5e\x2d\xa9\xd9\x4c\x01\x3e\x67\x3b\x8a\x2f\xbb\x93\x97\xfc\x99\xf5\xe7\x60\x01\x81\x9c\xc7\xaf\x4a\xe5\xcf\x88\xd7\x24\xa6\xca\x5f\x65\xba\x45\x3a\xb2\x7f\x03\x00\x61\xed\x54\x74\xaa\x03\x00\x00"),
+		},
+		"/module/template.yml": &vfsgen۰CompressedFileInfo{
+			name:             "template.yml",
+			modTime:          time.Date(2026, 10, 19, 10, 12, 46, 854473850, time.UTC),
+			uncompressedSize: 181,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\xcc\xb1\x8d\xc3\x30\x0c\x46\xe1\x5e\x53\xfc\x03\xf8\x16\x50\x77\xc5\xb5\x57\x24\x59\x80\x96\x68\x44\xb0\x44\x0a\x24\xe5\xf9\x03\x04\x69\xd2\xbf\xf7\x55\xf6\x62\x6d\x46\x53\xc9\xf8\x2d\x45\x97\x84\x6f\x08\x3d\x59\x10\x46\xe2\x07\x9b\x6f\x20\xdc\xfe\xee\x0f\x38\xdb\xc5\x06\x92\x0a\x42\x59\x1e\x3a\x30\xb4\xae\xce\x08\xc5\xbe\x5a\xaf\x58\x53\x25\xed\xe4\x9c\x61\xec\x91\x2e\xb2\x46\x7b\x67\xcf\x09\xf8\x81\xd0\xe0\xfc\x99\x12\x00\x4c\xd3\x31\x23\xe3\x9f\x06\x43\x0f\xc4\x93\xbf\xe9\x77\x55\xf9\xa0\xd5\x23\xe3\xbc\xd2\x6b\x00\xdf\xa3\x97\x5e\xb5\x00\x00\x00"),
+		},
+		"/module/x": &vfsgen۰DirInfo{
+			name:    "x",
+			modTime: time.Date(2026, 10, 19, 10, 3, 47, 890918811, time.UTC),
+		},
+		"/module/x/{{ .Vars.module }}": &vfsgen۰DirInfo{
+			name:    "{{ .Vars.module }}",
+			modTime: time.Date(2026, 10, 19, 10, 3, 47, 906216236, time.UTC),
+		},
+		"/module/x/{{ .Vars.module }}/client": &vfsgen۰DirInfo{
+			name:    "client",
+			modTime: time.Date(2026, 10, 19, 10, 12, 46, 841325945, time.UTC),
+		},
+		"/module/x/{{ .Vars.module }}/client/cli": &vfsgen۰DirInfo{
+			name:    "cli",
+			modTime: time.Date(2026, 10, 19, 10, 3, 47, 913328850, time.UTC),
+		},
+		"/module/x/{{ .Vars.module }}/client/cli/query.go.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "query.go.tmpl",
+			modTime:          time.Date(2026, 10, 19, 10, 3, 47, 915282599, time.UTC),
+			uncompressedSize: 738,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x91\xd1\x8b\xd4\x30\x10\xc6\x9f\x93\xbf\x62\x0c\x28\xad\xd4\xd4\xc3\xb7\xc5\x13\xa4\x1e\x8b\x28\x72\xde\xa1\x3e\x1c\xf7\x90\x4d\xa7\xdd\xb0\x4d\xb2\x4c\xa6\xba\xcb\xd2\xff\x5d\xd2\xae\xca\x79\x82\x50\x5a\x66\xe6\xcb\x97\xdf\x37\xdd\x1b\xbb\x33\x3d\x82\x1d\x9c\x94\xce\xef\x23\x31\x14\x52\xa8\xce\xb3\x92\x52\xa8\xde\xf1\x76\xdc\x68\x1b\x7d\x9d\xf6\xdd\xc5\xab\xda\xc6\x0d\x99\x3c\x3a\x9d\x40\x7f\x35\x94\xb4\x8f\xed\x38\x20\x4c\x13\xa8\xdc\x5b\xc7\xeb\x5d\x0f\xd3\x54\x1f\xea\xc7\x12\xf5\xd0\xd2\xc6\xe4\x63\x3a\x7f\x5e\xa4\x76\x57\xdb\xc1\x61\xe0\xda\xc6\xc0\x78\xe0\xff\xcb\x63\x8b\x56\xc9\x52\xca\xba\x86\x35\x72\xe3\x5b\x20\xe4\x91\x42\x02\xde\x22\x60\x60\x3a\x42\xec\xc0\xc0\x0e\x8f\x5a\x76\x63\xb0\x67\x5d\x61\x5b\x0b\xcf\x67\x03\xdd\xe4\x77\x99\xab\x0d\x19\xdd\x44\xef\x4d\x68\xe1\x24\xc5\xe2\x05\xcf\x1e\x0c\x4e\x52\x88\x2f\x09\x57\x00\xa0\x7a\x64\x78\xbd\xc3\xe3\x1b\x55\x49\x21\x6e\xb7\x91\x78\x05\x6a\x8d\x3c\x5f\xff\xdd\x0c\x23\xfe\xbe\x7e\x96\xbc\xa5\x3e\xad\x00\x16\xc3\xab\x83\xb1\x9c\x3b\xc5\x45\x99\x87\x37\x63\xb8\x5a\x41\x86\x2c\xac\x6f\xff\xe2\xa9\xc0\x50\x9f\xe0\xee\x3e\x31\xb9\xd0\x97\x80\x44\x91\x32\xa5\x10\x76\x70\x0d\x1f\x60\x75\x09\xe7\xcd\xe9\x4f\xf8\xa3\xf9\xf8\xbe\x59\xaa\xa2\xd4\xdf\x1c\x6f\xe7\x98\x39\x77\x29\xf3\x21\xc2\x54\x65\x93\xf9\xd8\x6c\xa0\x3f\x8f\x48\xc7\x2c\x7d\x67\xd8\x14\x9d\x67\x7d\xbb\x27\x17\xb8\x2b\x94\x1d\x13\x47\x5f\x3f\x4d\xcb\xa3\x2a\x78\xfc\x7b\xf5\x4d\x1c\x19\xe9\x03\x1e\xff\x39\x9d\xdd\xd7\xc8\x4b\x92\xbb\x97\xf7\x65\x05\xc1\x0d\x65\x86\x71\xdd\x8c\xf2\xe4\x32\x77\x96\x50\xbf\xb6\x8f\x44\xb9\x9c\x66\xe8\xcc\x74\x9d\x91\x86\x50\x2c\x8b\x28\x08\x53\x59\xca\x3f\xfa\xe0\x06\x29\xc4\x54\x49\x31\xc9\x49\xfe\x1c\x00\xf8\x9e\xe4\x26\xe2\x02\x00\x00"),
+		},
+		"/module/x/{{ .Vars.module }}/client/cli/tx.go.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "tx.go.tmpl",
+			modTime:          time.Date(2026, 10, 19, 10, 3, 47, 913328850, time.UTC),
+			uncompressedSize: 1062,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x93\x41\x6f\xd4\x3e\x10\xc5\xcf\xf1\xa7\x98\x7f\x0e\x7f\x25\x28\x24\x14\x6e\xab\xb6\xd2\x36\x94\xaa\x52\x8b\x10\x5b\xe0\x50\xf5\xe0\xb5\x67\xb3\x56\xe2\x78\x65\x4f\x20\xab\x55\xbe\x3b\x9a\x24\x14\x2d\x45\x14\x2e\x89\x6c\xbf\x37\xfe\xcd\xb3\xbd\x93\xaa\x96\x15\x82\x6a\x8c\x10\xc6\xee\x9c\x27\x48\x44\x14\x57\x86\xb6\xdd\x3a\x57\xce\x16\x61\xb7\x39\x79\x53\x28\xb7\xf6\x32\x16\x22\x3a\x1c\x20\xff\x2c\x7d\xc8\xad\xd3\x5d\x83\x30\x0c\x10\xf3\xdc\x95\xfb\x50\x57\x30\x0c\x45\x5f\x3c\x95\xc4\xc7\x25\x95\x0b\xd6\x85\xf9\xf7\x32\xe8\xba\x50\x8d\xc1\x96\x0a\xe5\x5a\xc2\x9e\xfe\x56\xde\x91\x69\xc2\xf3\x62\xa7\x51\xc5\x22\x0a\xba\x86\x3f\x2b\x69\xbf\x43\xae\x27\x3b\xda\x2a\xab\x9f\x51\xf7\x05\xeb\x1e\xd1\x1b\x33\x3b\xa9\x5f\xff\x93\x93\xfa\x75\x67\x1a\x8d\x3e\x16\xa9\x10\x45\x01\x2b\xa4\xbb\xbe\xb4\x1a\x02\x52\x00\xda\x22\x7c\x95\x4d\x87\xe0\x36\x20\xa1\xc6\x7d\x2e\x36\x5d\xab\x1e\x65\x89\xd2\x0a\x5e\x8c\x5d\xe6\x25\x7f\x53\x1e\xad\xbd\xcc\x4b\x67\xad\x6c\x35\x1c\x44\xe4\x91\x3a\xdf\xc2\xff\x47\x0b\x07\x11\x45\x9f\x02\x2e\x00\x20\x0e\x48\x70\x5a\xe3\xfe\x1c\x4e\xc7\xdd\xce\xe3\x4c\x44\xd1\x6a\xeb\x3c\x2d\x20\x5e\x21\xfd\x06\x64\x94\x2c\x7d\x15\x16\x00\x53\xe1\xcb\x5e\x2a\xe2\x99\xe4\x75\xca\x8b\x1f\xbb\xf6\x72\x01\x8c\x9b\x70\xa0\xc7\x5c\x19\x48\x5f\x05\xb8\x7f\x08\xe4\x4d\x5b\xa5\x80\xde\x3b\xcf\xb4\x51\x44\xfd\x45\xa3\x3d\x2c\xce\x60\x8e\x34\x7f\x8f\xdf\xee\xfa\x8b\x29\xa9\x77\xde\xd9\xf2\xe6\x3a\x49\xf3\x2f\x86\xb6\x63\xd3\x9c\x42\xca\x4e\xd5\x98\x92\x7a\x76\xce\xb7\x89\x9d\xe5\xcd\x75\x39\x8d\x92\x34\x67\x55\x74\x6c\xfc\x39\xb7\x54\xca\x75\x2d\xbd\x45\x0e\xd4\x27\xf3\x55\xc8\xaf\x90\x7e\x59\x61\x5b\x2a\xd8\xb7\xf1\xce\x66\x0c\x3f\x6e\x3a\x6e\xcf\x7a\x86\x5c\x6a\xed\x31\x84\x64\x24\x33\x9b\x51\xf4\xdf\x19\xb4\xa6\x99\xda\xfc\x71\x2e\xe8\x3d\x0f\x87\xb1\x9e\x0d\x15\x57\x7a\xfa\x8c\xb8\x93\xdb\x50\xad\x90\x12\x4e\xee\xfe\xd5\xc3\x14\xe1\xfd\xc9\x43\x06\x4c\x31\xf1\xcc\x35\xc7\xd7\xc1\x59\xef\x1a\x24\x5c\xb6\xfa\xc2\x3b\xa9\x95\x0c\x7c\xbb\x1a\x93\x4c\x11\x67\x33\x71\xc6\xe7\xa0\xeb\xfc\x36\x54\x07\x1b\xaa\x81\x89\x87\x4c\x44\x83\x18\xc4\xf7\x01\x00\x67\x79\x14\x05\x26\x04\x00\x00"),
+		},
+		"/module/x/{{ .Vars.module }}/client/rest": &vfsgen۰DirInfo{
+			name:    "rest",
+			modTime: time.Date(2026, 10, 19, 10, 12, 46, 845325945, time.UTC),
+		},
+		"/module/x/{{ .Vars.module }}/client/rest/rest.go.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "rest.go.tmpl",
+			modTime:          time.Date(2026, 10, 19, 10, 12, 46, 850964681, time.UTC),
+			uncompressedSize: 1954,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x54\x5f\x6f\xdb\x36\x10\x7f\x16\x3f\xc5\x95\xc0\x06\xa9\x50\xa9\xf7\x0c\x79\x48\xbc\x24\x1d\xb2\x64\x99\x1d\xa4\x0f\x45\xb1\xd0\xd4\x59\xe6\xf4\x87\x32\x79\xaa\xad\x19\xfa\xee\x03\x29\xb9\xc3\x1c\xb7\x29\x30\x60\x42\x10\x4b\xe4\xf1\xee\x7e\x7f\x8e\xad\x54\xa5\x2c\x10\x2c\x3a\x62\x4c\xd7\xad\xb1\x04\x31\x8b\xf8\xaa\x26\xce\x22\xde\x20\x65\x6b\xa2\x96\x33\x16\xed\xf7\x20\x9e\xa4\x75\xa2\x36\x79\x57\x21\x0c\x03\x70\xbf\x76\x63\x1e\xca\x02\x86\x21\xdb\x65\x2f\x43\x7c\x92\x42\xd3\xba\x5b\x0a\x65\xea\x4c\x19\x57\x1b\x37\xfd\xbc\x73\x79\x99\xa9\x4a\x63\x43\x99\x32\x0d\xe1\x8e\xbe\x37\xbc\x23\x5d\xb9\xd7\x83\x4d\x8e\xea\xf5\x28\xdb\xb7\x64\xb2\x12\x7b\x9f\xd1\xe5\x25\x7c\x3b\x9e\xfa\x16\x8f\x6b\x17\xc6\xea\xaa\x92\x59\xdd\xed\x38\x4b\x18\xcb\x32\x98\x63\xa1\x1d\xa1\x9d\x9b\x8e\xd0\x81\x9d\x3e\x1d\xd0\x1a\x61\x7e\xb5\x78\x04\x3b\xee\x98\x55\x58\x1a\x29\x13\x6c\xd5\x35\xea\xe8\x70\xac\x2a\x3d\xa3\x1d\x4c\x24\x89\xd9\xaf\xbf\xcc\xc6\xd7\x14\x2c\xbc\xad\xbb\x9d\x08\x55\x6c\x0a\x2a\x57\xf0\x36\xe0\x16\x33\xff\x3f\x85\x72\x09\x1e\x9a\xb8\xc5\x7e\x29\x1d\x26\xb0\x67\x91\x15\xef\x65\x93\x57\x78\xdd\x35\x2a\xe6\x27\x64\xcb\x3e\xcb\xaa\x43\x97\xed\x4b\xec\x07\x9e\x42\x81\x34\x9e\xb0\xd7\x4d\xac\x72\x95\xc2\xd8\x52\x92\x88\x3b\xa4\xb5\xc9\x5d\xcc\x6f\xae\x1e\x79\xf2\xfd\xb9\x79\x0a\xee\x45\xd6\x72\x79\x2a\xf3\xc3\x6f\x0b\x9f\x7a\x60\x23\x39\xc7\xcd\x1c\x01\xfe\x2a\x59\x09\x78\x2b\x4f\xed\x59\xdf\x5f\xe0\x02\xa9\xb3\x0d\xf8\xcc\xf1\x76\x8c\x98\xa3\x6b\x4d\xe3\xf0\x83\xd5\x84\x36\x70\x3c\xad\x6f\x3a\x74\x14\x28\x8c\x4a\xec\xe1\xec\x1c\x3c\xf9\xde\xf2\xb1\x4d\x3e\xf2\x12\x7b\xfe\x89\xb1\x28\xb2\xe8\x52\x40\x6b\x7d\xc4\xd8\x8f\xf8\xbd\x43\xdb\x7f\xd0\xb4\xfe\x59\x92\x8c\x57\x35\x89\x45\x6b\x75\x43\xab\x98\xab\xce\x91\xa9\xb3\x1f\xdc\xf8\xc7\x53\x78\xc9\xda\xa4\xf0\x2d\xf6\x27\x77\x43\xf6\x1b\xa4\xd4\x8b\x9d\xa4\xd0\xe8\x2a\x61\x51\xa4\x57\xa1\x8b\x37\xe7\x7e\x21\xb4\x1d\x85\xd1\x11\x01\xda\x95\xb5\xc6\x1e\xc0\xc6\xdb\x74\x44\xbf\x20\x49\x9d\xbb\x37\x74\x6d\xba\x26\x0f\x30\x44\x88\x8c\x13\x9f\x72\x22\x8c\x45\xd1\xe0\x91\x6e\xc5\x7b\x94\x39\xda\x38\x11\x0b\xa4\x98\x07\xae\x1b\x7a\xf7\xd8\xb7\xc8\x53\xe0\xb2\x6d\x2b\xad\x24\x69\xd3\x64\x7f\x3a\xd3\x78\x8b\x44\xdb\xb1\x7e\x6c\xd1\x25\x2c\x1a\xd8\xc0\x98\x9f\x2a\x6f\x88\x39\x6e\xc0\x91\xed\x14\xf9\x76\x2f\xa5\x43\xbf\x32\x36\x7d\xf8\x7a\xf6\x89\xce\xb8\xb7\xf3\x1f\x16\x37\xfc\x99\x45\xb7\xd8\x83\x7f\x1c\x59\xdd\x14\x30\x3d\x53\xa0\x97\xe5\x99\x45\x4f\xde\xd3\x5f\x8b\x09\xa6\xe4\xcf\x5f\x4c\xe6\xbe\x6d\xb2\xa3\xa9\xfa\xdf\x5c\xf7\x59\x5a\xb0\x9e\xa2\xc0\xd4\x3f\x0a\x9f\x9d\x4f\x1c\xcd\x51\xe6\xfe\x76\x99\xe3\xc6\x2b\x3a\x5e\x09\x29\xfc\x68\x71\x93\xfc\xf4\xc2\x0c\xff\xd6\x72\x39\xf1\x7b\x76\xee\x6b\x1c\xe8\x16\x0b\xd9\x68\xd2\x7f\x61\x3c\x39\xea\xcd\x14\x27\x9e\x64\xa5\x73\x49\x78\x29\x9d\x56\xf1\x36\x39\x95\x53\x37\x2b\xf3\x65\x14\xca\xa5\xb8\x41\x8a\x0f\xe7\xef\x65\x8d\xff\xcd\xa5\x97\x32\x9f\xe8\x79\xcd\xa7\xb5\x2b\xfc\x30\x9e\x18\x9d\x7b\xdc\xde\xb9\xc2\x9b\xd7\x83\x0e\x03\xe6\x5f\x82\x5b\x52\x70\x79\x29\x2e\x94\xba\xc8\x73\x8b\xce\xc5\x1e\x8e\xc7\xf0\xd0\x2d\x6f\xb1\x8f\x13\x71\xd8\x48\x42\xd1\x51\x83\x99\xa9\xdb\x0a\x09\x2f\x9a\xfc\xd2\x1a\x99\x2b\xe9\xe8\x71\xe7\x55\x39\x48\x12\xae\x84\x14\x26\x22\x52\xf8\xf8\xc9\xd7\xb9\x73\xc5\xbe\x76\xc5\x10\x34\x4b\x58\x34\xb0\x81\xfd\x3d\x00\xed\xb0\x2b\xd4\xa2\x07\x00\x00"),
+		},
+		"/module/x/{{ .Vars.module }}/codec.go.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "codec.go.tmpl",
+			modTime:          time.Date(2026, 10, 19, 10, 3, 47, 897292246, time.UTC),
+			uncompressedSize: 237,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x8f\xb1\x6a\xc5\x30\x0c\x45\xe7\xe8\x2b\x84\xa7\xa4\xa4\xf6\x47\x74\xee\xd2\x40\x77\x57\x56\x1c\x93\x38\x0e\x96\x33\x19\xff\x7b\x69\x1a\x78\x3c\xde\x24\x8e\x90\xce\xe5\x1e\x96\x56\xeb\x19\x6b\x45\xfd\x6d\xb3\xe8\x98\xdc\xb9\x31\xb6\x06\x10\xe2\x91\x72\xc1\x1e\x3a\xe5\x43\x59\xce\x1f\x4d\x29\x1a\x4a\x12\x93\xdc\xe3\x5d\xdc\x6a\x28\x39\x26\x05\x03\x80\x31\xf8\xc5\x3e\x48\xe1\xfc\xf1\xb7\xc4\x7c\x93\x60\x59\x18\x23\x8b\x58\xcf\x82\x69\xfe\xe7\x2b\x4b\xc3\x7c\xee\xf4\xfc\xd8\x93\x23\x7c\xbb\xc4\xfa\x32\x0d\x58\xa1\x23\x47\xfa\x71\xb6\x53\xe6\xc2\xfd\xa7\xf8\x89\x4b\x6d\x23\xaa\xd7\x12\x66\xe2\xa2\x46\xdc\xc3\x36\x40\x83\xdf\x01\x00\xa9\xd9\xf4\x08\xed\x00\x00\x00"),
+		},
+		"/module/x/{{ .Vars.module }}/handler.go.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "handler.go.tmpl",
+			modTime:          time.Date(2026, 10, 19, 10, 3, 47, 906216236, time.UTC),
+			uncompressedSize: 825,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\x41\x8b\xdb\x3e\x10\xc5\xcf\x9a\x4f\x31\x7f\x43\x16\x1b\xfc\x57\xee\x29\x3d\x95\xd0\x42\x48\x0b\x9b\xee\xde\xbd\xf6\xd8\x31\xb2\x25\xaf\x46\x6a\xd6\x0d\xfe\xee\x45\x52\xb2\xcd\x86\x9e\x2c\xcd\x8c\xe6\xbd\xf7\xc3\x53\x55\xab\xaa\x23\x3c\x9f\x51\x3e\x57\x96\xe5\x68\x1a\x3f\x10\x2e\x0b\x40\x3f\x4e\xc6\x3a\xcc\x41\x64\xed\xe8\x32\x00\xc1\x8d\xc2\xac\xeb\xdd\xd1\xbf\xc8\xda\x8c\xeb\xda\xf0\x68\xf8\xf2\xf9\x9f\x1b\xb5\x76\xf3\x44\x9c\x41\x01\xb0\x5e\xe3\x77\x3a\x7d\xab\x74\x33\x90\x45\x4b\xce\x5b\xcd\xe8\x8e\x84\xc7\x4b\xcd\xb4\xf1\x3a\x12\x73\xd5\x11\xbf\xdf\xa3\x03\x09\xad\xd7\xf5\xcd\x8a\x5c\xe1\x8e\x68\x22\x5b\x20\x37\x4a\x5e\x17\x9f\x41\xa4\xdd\x18\xe6\xf3\xda\xbd\xc5\xf6\x17\xa3\x1d\xbd\xb9\x12\x47\xee\x62\x61\xcf\x5d\x7a\xf8\x48\xec\x07\x87\x67\x10\x82\x4f\xbd\xab\x8f\x71\x64\xf3\x39\x7c\x64\x1e\xfc\x17\xb1\x59\x57\x4c\xb8\xe7\xee\x40\x6e\x03\x42\x5c\x55\x92\xf9\x54\x0f\x6a\x25\xaa\x28\x52\x80\x10\x0d\xb5\x95\x1f\xd2\x38\x59\xbb\x4f\x7b\xdb\xd1\xc9\xc3\x64\x7b\xed\xda\x3c\x7b\xd2\x96\x6a\xd3\xe9\xfe\x37\x35\xff\x80\x1e\x04\x31\x78\xd8\xe0\xea\x57\x16\x17\xcb\x9f\xf3\x44\x79\x51\xdc\x78\x08\x31\xb6\xd6\x3e\x69\xa5\xcd\x49\x3f\xd2\xab\x27\x76\x79\x52\x2c\x2e\x01\xf3\xf0\x60\x01\xb1\xc0\x02\x09\xe5\xbd\xf3\x8f\x9c\xae\x74\xa3\xe6\x25\xf7\x3d\xb0\xbe\x45\xd2\xce\xce\x25\x1a\x15\x92\x29\xf9\xf5\x0a\x21\x18\xdd\xd1\x5c\x7c\x0a\xad\x87\x07\xfc\x2f\x0e\xca\x1f\x27\x4d\x56\x6e\x5f\x7d\x35\x70\x1e\x66\x62\x21\x01\xbe\x0f\x53\x79\x77\x34\x36\x80\xc9\x3f\x20\x5b\x31\xf6\x8c\xe6\xa4\xa9\xc1\x97\x19\x57\x7c\xe1\xb2\xa3\xb9\xc4\x1b\x99\xe2\x36\xfa\x02\x42\xc9\xc3\x9d\xbb\x12\xb7\x61\x3c\x88\x3f\x57\x83\xa7\x4d\x6c\xc4\x63\x09\x42\x44\x6f\xa9\x16\x8f\x25\x88\xa5\x78\xff\xbd\xfe\xa2\x38\x2f\xb0\xc0\x9f\x01\x00\x5f\x67\x9d\xc0\x39\x03\x00\x00"),
+		},
+		"/module/x/{{ .Vars.module }}/keeper.go.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "keeper.go.tmpl",
+			modTime:          time.Date(2026, 10, 19, 10, 3, 47, 903929554, time.UTC),
+			uncompressedSize: 981,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x91\x3f\x6f\xdb\x30\x10\xc5\x67\xde\xa7\x38\x68\x28\xa4\x42\xa5\x77\x03\x19\x92\xa0\xc8\x60\xa4\x1d\x8c\x7a\x29\x0a\x98\xa2\xce\xb6\x2a\x89\x34\xc8\x53\x6c\xd9\xf0\x77\x2f\xc8\x50\x71\xda\xb4\x41\x35\xe8\x1f\x1f\xdf\xfd\xde\xe3\x5e\xe9\x56\x6d\x09\xcf\x67\x94\x2b\xe5\xbc\xec\x6d\x3d\x74\x84\x97\x0b\x40\xd3\xef\xad\x63\xcc\x41\x64\xdb\x86\x77\x43\x25\xb5\xed\x67\xda\xfa\xde\xfa\xf4\xf8\xe4\xeb\x76\xa6\x6d\x4d\x3a\x03\xe1\xeb\x16\xdf\x57\xf2\xb8\x27\x9f\x41\x01\x30\x9b\xe1\x67\xc3\x6e\xc4\xc6\xa3\xc2\x27\xd5\x0d\x84\x9e\xad\xa3\x1a\xab\x11\x79\x47\xf8\x0c\x22\x21\xec\x49\x5a\xcf\x6e\xd0\x8c\x67\x10\xab\xb4\xc1\x35\x66\x8b\xd3\xb5\xfe\xe9\xad\x99\x67\xd1\x2c\x5b\x83\xf8\x7a\x30\xe4\xd0\xd7\xad\xbc\xd5\xfa\xb6\xae\x1d\x79\x3f\x89\x6c\x58\xcb\xd6\x70\x89\x28\x0b\xa2\x3d\x39\xec\x95\x51\x5b\xf2\x71\x7c\x84\x41\xbb\x79\xcb\x92\xc4\x57\x98\x28\x5d\xd0\x18\x47\x2d\xd3\x07\x08\x5d\xeb\x40\x85\xf8\x31\x16\x24\xef\xc3\x3d\x0d\xfc\x42\x87\x64\xe3\x88\x07\x67\x42\x09\x86\x0e\xc9\x5b\xc2\x66\x30\xfa\x2a\xca\xff\x3a\xa1\xc4\x30\xe1\xb5\x79\x31\xb1\x9d\x41\x3c\xfb\xa6\x1f\x67\x10\x2f\x94\x73\x9c\xde\x4a\x10\x01\x72\x1e\x18\x31\x98\x95\x20\x2e\x09\xf0\x81\xf8\x05\x2d\x34\x40\xf1\x00\xec\x06\x15\xb6\x34\x26\xc0\xbc\x4d\xfe\x05\x3e\x10\xe7\x9a\x8f\x11\xf0\xde\x1a\xa6\x23\x97\x41\x99\xce\xa8\xc0\x3c\x1e\x61\x89\x95\xb5\x5d\x11\x5a\x7b\x52\x2e\xb9\xc6\x15\x10\xd5\x09\xe7\x37\xa8\xf9\x28\x17\xab\xd8\x62\xde\xca\x09\xb5\x90\x61\xc0\xf7\x1f\xd5\xc8\x94\xb7\x34\x16\x05\x88\x66\x83\xd5\x09\x6f\x6e\xd0\x34\x5d\x30\x9c\x22\x47\xd3\x12\x37\xaa\xf3\x14\x02\x89\x56\xea\x5a\xcb\xc7\xc1\xf3\x37\xd3\x2b\xe7\x77\xaa\xbb\x6b\x8c\x72\xe3\x9d\x72\x94\x57\xa7\x12\x3f\xc4\x3d\x05\xfc\x61\xc1\x6e\xa0\xd4\xc7\x92\x18\x3d\xf1\xff\x95\xb1\x7c\xbf\x8c\xf2\x75\xee\xd8\xc5\xbf\x42\x2f\x7f\x0f\x5d\xe2\x35\xca\xe3\x9b\x20\x64\xd8\x8d\x45\x01\x17\xf8\x35\x00\x66\x1a\xaf\x5b\xd5\x03\x00\x00"),
+		},
+		"/module/x/{{ .Vars.module }}/msgs.go.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "msgs.go.tmpl",
+			modTime:          time.Date(2026, 10, 19, 10, 3, 47, 900565353, time.UTC),
+			uncompressedSize: 1450,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x53\xdf\x6b\xc3\x36\x10\x7e\xb6\xfe\x8a\x9b\xa0\x60\x43\xe6\xec\x39\x90\x87\x16\xc6\xd8\x4a\x5a\x68\xba\xbe\x8c\xb1\x2a\xf6\xc5\xd1\x6c\x4b\x9e\x4e\x4e\x30\xc1\xff\xfb\x38\x59\x4e\xfa\x6b\xd0\xe5\x25\x92\xef\xbb\xef\xbe\xbb\xef\xd4\xa9\xa2\x56\x15\xc2\xf9\x0c\xf9\x8b\x72\x94\xb7\xb6\xec\x1b\x84\x71\x14\x42\xb7\x9d\x75\x1e\x52\x91\x48\x34\x85\x2d\xb5\xa9\x96\x7f\x93\x35\x52\x24\x72\xdf\x7a\x29\x44\x42\x65\x0d\xb2\xd2\xfe\xd0\xef\xf2\xc2\xb6\xcb\xc2\x52\x6b\x29\xfe\xfd\x48\x65\xbd\xf4\x43\x87\x24\x45\x26\xc4\x72\x09\x4f\xb6\xf7\xe8\xee\x71\x00\x4d\xe0\x0f\x08\x8e\x3f\x80\xdd\x87\x4b\x8b\x44\xaa\x42\xba\xdc\x83\x94\x5c\x14\xd6\x90\x7f\x93\xbb\x06\xf9\x59\xae\x0c\x05\x36\x54\x6d\xd1\x03\xa1\x9f\xf8\x8f\xaa\xe9\x91\xf9\x14\xd4\x38\xe4\xf0\x7c\x40\xd8\x6b\x47\x1e\x54\x51\xd8\xde\x78\xf0\x96\xd1\x53\x1c\xec\xc9\x10\x68\x9f\x0b\x56\x7d\x21\xf3\xae\x2f\x3c\x9c\x45\xc2\xc2\x81\xef\xda\x54\x30\xff\x5e\x79\x24\x2b\x59\xe3\x20\x5f\x45\xf2\x12\x0a\x7e\x0d\x09\x62\x18\xf4\x78\x32\xe8\x80\xca\x3a\xbf\x2d\x8a\xdb\xb2\x74\x48\x34\x83\x2c\xc7\xe4\xab\x18\x43\x3f\x0f\x78\x8a\x2a\x1c\xfa\xde\x19\x02\x05\x06\x4f\x51\x5a\x2e\xf6\xbd\x29\xae\xa0\xb4\xc6\x61\x11\x7b\x9e\x24\x2c\xc0\x7e\x51\x2b\x9b\x5b\x3b\x8b\x64\xe2\x8d\x1f\xce\x22\xe1\x26\x57\x00\x3c\x8e\x85\x48\xa6\x7e\x56\x13\x27\xdf\x83\xf4\xd5\xc4\xba\x10\xc9\xc8\x3a\x8f\xca\xc1\x5f\xa1\xc4\x86\x2a\x58\xcf\x5c\xe3\xd5\x72\xd0\x6d\xd7\x60\x8b\xc6\xd3\x8c\x8b\xda\xd3\x96\xaa\x98\x90\x4d\x16\xa7\xd9\x3c\xe1\x33\x44\x71\x57\xeb\x27\xce\xe7\xa1\xfb\x2e\x25\x43\xbf\x60\x94\x84\x5e\x46\xb6\x5f\xd0\x6f\x75\x65\xd0\xd1\x37\x39\xaf\x09\x69\x06\x7f\xfc\xf9\xc1\xc7\x4b\x8d\x8f\x91\x73\x4b\x55\x1e\xe6\x37\x72\xe5\xcf\xbc\xdb\x20\xf2\x8d\xda\x8b\x3b\xfb\xd6\xe7\xdb\xce\x69\xe3\xf7\xa9\x8c\xe3\x0d\x3e\xdd\x1c\x17\x10\x2d\xe2\x63\x74\xe7\xe6\x38\xca\x05\x70\xb9\x7b\x5e\x08\x3e\x04\xd0\x74\x0c\xa0\x2c\x2e\xd8\x8b\x6a\x74\xa9\x3c\xde\x29\xd2\xc5\x37\xfb\x7f\x97\xc3\x72\xcb\x3a\xff\xd9\x39\xeb\xf8\x8d\xe8\x3d\x34\x68\xd2\x6b\x21\x58\xaf\xe1\x27\x8e\xcc\xcd\x44\xf8\xaf\xe6\xc8\x3c\x71\x38\xd7\x84\x7c\x1e\x43\xc6\xeb\xf5\x96\xef\x1e\x87\xff\x64\xfb\xdd\xd4\xc6\x9e\xcc\x13\xfe\xd3\x23\xf9\x94\x9f\x23\x14\xca\x18\xeb\x61\x87\x80\x6d\xe7\x07\x39\x11\xc6\x3c\xa3\x1b\xf1\xce\xff\xbb\xc1\xe3\xff\xdc\x80\x90\x12\x76\x60\x37\x78\xe4\x1e\x77\x0b\x40\xe7\x60\xb5\x06\x7e\xcd\xf9\x46\x39\x3a\xa8\x86\x9b\xcb\xc2\x68\x38\xf8\xc3\x1a\x8c\x6e\x18\x9d\x74\xca\xe8\x22\x45\xe7\xde\x49\xe3\x01\x6d\x7a\xf2\x5b\xeb\xfc\x6f\xdb\xc7\x87\x74\x97\x89\x51\xfc\x3b\x00\x15\x13\x24\x9f\xaa\x05\x00\x00"),
+		},
+		"/module/x/{{ .Vars.module }}/querier.go.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "querier.go.tmpl",
+			modTime:          time.Date(2026, 10, 19, 10, 3, 47, 909230466, time.UTC),
+			uncompressedSize: 1030,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x53\x5f\x6f\x9b\x30\x10\x7f\xb6\x3f\xc5\x95\x27\x90\x18\xb4\xaf\xd1\xb2\x97\x69\x9a\xba\x69\x9d\xb6\x69\x7b\x89\xf2\x40\xcc\x01\x96\xc1\x26\xf6\xa1\x86\x46\x7c\xf7\xc9\x86\x34\x89\xb6\x56\x7d\x4a\xee\xb8\xdf\xbf\xe3\xe8\x0b\xa1\x8a\x1a\xe1\x78\x84\xec\x4f\x61\x5d\xd6\x99\x72\x68\x11\xa6\x89\x73\xd9\xf5\xc6\x12\xc4\x9c\x45\xb5\xa4\x66\xd8\x65\xc2\x74\xb9\x30\xae\x33\x6e\xf9\x79\xe7\x4a\x95\x0b\x53\xa2\x88\x38\x73\xa5\x82\xd7\x27\x69\xec\xd1\x45\x9c\x15\x3b\x21\xaf\x46\x09\x75\x89\xb6\x93\x9a\x2e\xff\xfa\xb1\x13\x26\xe1\x3c\xcf\xe1\xc7\x80\x76\xfc\x8c\x04\xd2\x01\x35\x08\x7b\x5f\x83\x45\x1a\xac\x96\xba\x0e\x3d\xd4\x64\x47\x30\x15\x14\xa0\x70\x5c\x79\x98\x18\x1c\x99\x2e\xff\x37\x64\x5e\x23\xe5\xef\x15\x8e\x1f\xb8\x30\xda\xd1\x59\x60\x0d\x51\x8d\x14\x05\xd5\x07\x7c\xf4\x7d\x89\x76\x91\x3a\x8b\xfb\x9e\xa9\x42\x39\x93\x66\xbc\x1a\xb4\xb8\x80\xc4\x0a\xbe\x22\xf6\x68\x13\x70\xa5\xca\x4e\x44\x47\xce\x66\x2e\xf0\xf3\xb1\xa0\x43\x78\xfc\xd1\x68\xc2\x03\xa5\xd0\x17\xd4\xc0\x66\xeb\xc8\x4a\x5d\xa7\x60\x71\x0f\x7e\x1d\xd9\x4f\xdc\x0f\xe8\xc8\xd3\x8c\x09\xc4\x9b\xed\x6e\x24\x4c\x03\xf6\x93\xb5\xc6\x26\x70\xe4\x8c\xb9\x47\x49\xa2\x09\x24\x9b\xdb\x6d\x68\x89\xc2\xe1\x73\xbc\x15\x67\xec\xa4\xbf\x5f\x7a\xde\xc3\xac\xbb\xb9\x5b\x6d\x53\x50\x09\x67\xac\xc4\xaa\x18\xda\xab\x79\x2d\xdb\x67\xbd\xdf\x5a\x69\xf3\xa8\x17\x53\x71\x34\xcc\xf5\x7f\xce\x69\x79\x55\xa8\xcb\xde\x48\x4d\x91\x27\x9f\x38\x9b\xf8\xc4\xe7\x8d\x5d\xda\x78\x75\x15\xe7\x7d\xbe\x90\x5e\x56\xd0\xa2\x8e\x3d\x2c\x81\x9b\x35\xdc\x85\xfc\x6f\x70\x8f\x87\x1e\x05\x61\x39\x5f\x8e\xb7\x38\x71\x16\xce\x29\x05\xa3\x60\xb5\x06\x95\x5d\x2f\xea\x76\x9b\x70\x26\x2b\xb8\x31\xea\xad\x22\xda\x80\x1b\x44\x73\xa1\xb0\x7b\x4a\x01\xad\xf5\xfc\xe1\x53\xca\xbe\x15\xd6\x35\x45\xfb\xe5\xd7\xf7\x87\x7b\x5d\xa2\xa6\x58\x65\xa2\x14\x29\x04\x2f\xb3\xa2\x07\xdc\xac\x7d\x9c\x97\x84\xef\x35\xa1\xd5\x45\x1b\xa3\xb5\xbe\x36\x36\x4e\xe6\x48\xcb\xf0\xee\x29\x05\x2d\x5b\x3e\xf1\xbf\x03\x00\xc2\x7f\x22\xf2\x06\x04\x00\x00"),
+		},
+		"/rest": &vfsgen۰DirInfo{
+			name:    "rest",
+			modTime: time.Date(2026, 10, 19, 10, 4, 9, 791237131, time.UTC),
+		},
+		"/rest/cmd": &vfsgen۰DirInfo{
+			name:    "cmd",
+			modTime: time.Date(2026, 10, 19, 10, 4, 9, 654734559, time.UTC),
+		},
+		"/rest/cmd/{{ .Name }}cli": &vfsgen۰DirInfo{
+			name:    "{{ .Name }}cli",
+			modTime: time.Date(2026, 10, 19, 10, 12, 33, 690652115, time.UTC),
+		},
+		"/rest/cmd/{{ .Name }}cli/main.go.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "main.go.tmpl",
+			modTime:          time.Date(2026, 10, 19, 10, 38, 5, 478504764, time.UTC),
+			uncompressedSize: 1554,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x53\xc1\x6e\xe3\x36\x10\x3d\x73\xbe\x62\x4a\x14\x85\x04\xb8\x34\x8a\xde\x0c\xf8\xe0\x38\x46\x52\x20\x49\x53\x38\xdd\xcb\x62\x0f\x34\x39\x76\x08\x49\xa4\x42\x52\x81\x02\x43\xff\xbe\xa0\x2c\x07\x72\xd6\x9b\xf5\x49\x02\xf9\xde\xbc\x37\x8f\x33\xb5\x54\x85\xdc\x11\x56\xd2\x58\x00\x53\xd5\xce\x47\xcc\x80\x71\x17\x38\x00\x93\x75\x8d\x7c\xbf\x47\x71\xe3\x1e\x8b\x1d\x76\x1d\x07\xc6\x77\x26\x3e\x37\x1b\xa1\x5c\x35\x55\x2e\x54\x2e\x0c\x9f\x3f\x83\x2e\xa6\xaa\x34\x64\xe3\x85\xb0\x69\x41\x6f\xe1\x52\xac\xaf\xd5\xa5\xd0\xd8\x72\x60\xb2\x89\xcf\xaa\xd2\xf8\x39\xa3\x9d\x26\xdc\x91\xa8\x4a\xc3\x81\x6d\xa4\x2d\x2e\x61\x26\xdc\x29\x73\xcc\x08\xf5\xf6\xaf\xbf\xa7\xca\x6d\xbc\xfc\x70\x13\xc9\x6a\xf2\x95\xb1\x71\xfc\x5b\x9a\x4d\x48\xd5\x38\xe4\x00\xca\xd9\x10\x31\x44\xe7\x69\xa1\x14\xce\x91\x4b\xa5\x38\xc0\xab\xf4\xe9\x79\xbc\x73\x71\x59\x69\x9c\xe3\x1f\xbd\x80\x58\xba\xaa\x92\x56\xef\x81\xb1\xff\x03\xcd\x10\x0f\xcf\xf6\x20\x2b\xc2\xae\x4b\x55\x27\xc0\xd8\xfa\xd9\xf9\x38\x3b\xb9\xc2\x65\xef\x9f\x4f\x80\x75\xc0\xae\x69\x2b\x9b\x32\x2e\xef\xfe\xb9\x75\x15\xe1\x1c\x5d\x10\xab\xb6\x96\x56\xaf\xec\x6b\xc6\x7f\xbf\xfd\xf7\x7e\x35\x15\x1f\x2a\xe7\xc9\xf0\xb6\xb1\xaa\x9f\xa2\x2c\xc7\x7d\x3f\x38\x62\x4d\x71\xe9\xec\xd6\xec\xb2\x1c\xd8\xc1\xe6\xca\xca\x4d\x49\x83\xd9\xb5\xf3\xd1\xd8\x1d\xce\x71\x2b\xcb\x40\xc0\x94\x56\x38\x9b\x63\xe2\xde\xcb\x82\x96\x4e\x93\xca\x72\x78\x6f\x57\x2c\xb4\x1e\xb8\xd9\x21\x76\x71\x10\x58\x56\x3a\xcb\x73\x60\xbe\x56\x23\x4c\xc8\x06\x5e\x2a\xf1\xd2\x90\x7f\x4b\x91\xcd\x3e\xc9\x0c\x91\xf7\xb8\x94\x06\x5b\x94\x46\x06\x0a\x33\xfc\xfa\x2d\x44\x6f\xec\x6e\xcf\x5f\x78\x37\x8a\x11\x91\xff\x97\xd0\xa9\x87\xd0\x6c\xd4\xa0\x9a\xc8\xdd\x48\x71\xec\x1a\x58\xef\xf1\xaa\x74\xaa\x38\x9e\xe5\x93\xe1\xf4\x8b\x2c\x8d\x96\xd1\xf9\xf1\x4d\x0e\x2c\xb6\x27\x4d\x1d\xeb\x4e\x50\x69\x95\x9f\xd7\x19\xd2\xb9\x33\x96\xae\x3c\xc9\xe2\x73\xd8\x0d\xc5\xf7\xea\xc0\x8e\x8b\x23\x6e\x28\x2e\x94\x72\x8d\x4d\xa3\x96\x1d\x67\xb1\x97\x9d\xe0\x8f\xa0\x6b\x52\x4e\x93\xcf\x92\xab\xde\xb9\x10\x22\x25\x1f\xdb\x5f\xc5\xce\x63\x7b\x32\x9e\x4f\x5e\xda\x20\x55\x34\xce\x86\x73\xc9\xc6\xf6\x7c\x1f\x8f\x2e\x9c\x34\x32\xec\xb1\x58\x93\xd5\x4f\x89\xd3\x7b\x1b\x59\x3b\x33\x57\xc0\xde\x93\x4a\x96\x7a\xa9\xf4\xf3\x31\xd1\x74\xe6\x29\xc4\x35\xf9\x57\xf2\xa3\xda\xe7\x90\x3f\xd7\x2a\xe8\x2d\x1c\x13\x09\xc3\x83\x03\xa3\x96\x54\x13\x9d\x4f\xdb\xa0\x4a\x23\x1e\x3d\xd5\xd2\xd3\xbd\x34\x36\x29\x0d\xa5\x26\xc8\x1f\xd6\x7c\x82\xa7\x4b\x9b\x03\x23\xdf\x33\x8f\x55\xc4\xaa\xff\xa1\xb4\x86\x66\x8b\xe9\xf6\xb7\x39\x5a\x53\xa6\x45\x65\xb5\xb4\x46\x65\xe4\x7d\x0e\xac\x83\x0e\xbe\x0f\x00\x05\x0c\xea\xe8\x12\x06\x00\x00"),
+		},
+		"/rest/cmd/{{ .Name }}cli/rest.go.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "rest.go.tmpl",
+			modTime:          time.Date(2026, 10, 19, 10, 12, 33, 690652115, time.UTC),
+			uncompressedSize: 2179,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x54\xc1\x6e\xe3\x36\x10\x3d\x4b\x5f\x31\xd5\xa1\x95\x0a\xaf\x94\x74\xd1\x2d\x60\x20\x87\x8d\x77\xdb\x06\xcd\x6e\x17\xb1\x8b\x1c\x8a\x1e\x68\x72\x24\x13\x26\x39\x02\x49\xa5\x0e\x82\xfc\x7b\x31\x94\xed\xb5\x53\x37\x49\x73\x50\x3c\xe4\xf0\xbd\xc7\x37\x33\xec\x85\x5c\x8b\x0e\xc1\x0a\xed\xf2\x5c\xdb\x9e\x7c\x84\x32\xcf\x0a\x0a\x45\x9e\x67\x45\xa7\xe3\x6a\x58\xd6\x92\x6c\x23\x29\x58\x0a\xdb\x7f\x6f\x82\x5a\x37\xd2\x68\x74\xb1\x78\x5d\x5a\x23\xc9\x45\xdc\xbc\x3a\x7d\x8d\xf7\xe1\xe5\x5c\x52\x28\x9f\x64\x75\xe4\xb5\x31\xa2\xb1\xc3\xe6\xc9\x4e\xe8\xdb\xf3\xb7\x8d\xa4\xa5\x17\x27\x77\xee\x74\x8f\xbe\xc8\x33\x69\x1d\x1c\xee\x46\x74\x0a\xbd\xd5\x2e\x1e\xfe\x34\x7a\xc9\x5a\xac\x25\xf7\x04\xed\x99\x7c\x43\x5d\x91\x67\xbe\x97\x01\xfd\x1d\xfa\x57\xd0\xf8\x5e\x36\x46\x2f\x9b\xf1\x40\x91\x57\x79\x2e\xc9\x85\x54\xa5\xd6\x88\xee\x5a\x87\x88\xee\xbd\x52\x1e\x76\x7f\x17\x50\x18\xa1\x14\x5f\x85\x33\x3e\x89\xcd\xef\x3d\xba\x19\x39\x87\x32\x6a\x72\x01\x2e\xa0\xb0\x62\xf3\x86\x7a\x74\x09\xb1\x69\xc0\x63\x88\xf3\xc4\x31\xb3\x0a\x42\x14\x3e\x06\x10\x70\xf3\x71\xbe\x80\xad\x58\xdc\xf4\x14\xb4\xeb\x20\xae\x10\x3c\x0d\x11\x03\x50\xcb\x51\xde\x34\x20\xfa\xde\x68\x29\x98\x60\x02\x1e\x3b\xd6\xe5\x51\xc1\xf2\x7e\x1f\xdd\xa4\x33\x75\xde\x0e\x4e\x1e\x13\x96\x52\x49\xf8\x3e\x95\xb3\x9e\xf1\xb7\xe2\x68\xe9\x45\x3d\x23\x6b\x85\x53\xf0\xc0\x75\x51\x30\xbd\x80\x6f\x8f\x36\x1e\xf2\x2c\xfb\x23\xe0\x14\x00\x0a\x86\x7c\xb3\x35\x6a\x92\x67\xd9\x7c\x45\x3e\x4e\xa1\x98\xf3\x6d\x40\x80\x21\x29\xcc\xe1\x95\x52\xd6\xcd\xe0\x3e\x4e\x81\x35\x95\xcc\x70\xcc\x3b\x01\xe1\xbb\x00\x7f\xfe\x15\xa2\xd7\xae\xab\x00\xbd\x27\xcf\x6a\xb2\x6c\xbd\x9c\x70\xc8\x9a\xb8\x5b\xeb\x5f\x30\xfe\x86\xf7\x97\x22\x60\x59\xf1\xbe\x6e\xd3\xf6\x37\x17\xe0\xb4\x19\x8f\x64\x1e\xe3\xe0\x1d\xaf\x73\xf8\xc8\x1f\x69\xf4\x2c\x6e\x18\x65\x3b\x22\xf5\x67\xfc\x7b\x76\x7d\x35\x1b\xa3\xb2\xaa\x6f\x75\x5c\x25\x57\xd8\xa6\x2a\xe7\x43\x9e\xf3\xed\xb0\xe1\xdc\x64\xab\x1f\x39\x8f\xad\x2e\x47\xec\x09\xf8\x09\x48\x25\x27\xb0\x5e\x8e\xc7\x0d\x75\x1d\x26\x0c\x43\x1d\x63\x2c\x3e\x5d\xa7\xa5\x72\x1b\xcf\xef\x9d\xbc\xf5\x3a\xa2\x2f\x29\xd4\xf3\xa8\x68\x88\xd5\xa8\xa4\x2c\x2c\xa9\xc1\x60\x31\x39\x76\x3c\xf1\x1b\x2e\xb4\x43\xbf\x77\x66\xdf\xeb\x75\xaa\xc2\xaf\x8b\xc5\x97\xb1\xcd\x4a\x4e\xcf\xd2\xcc\xb1\x73\xf3\xe4\x6f\x79\xdc\xd2\x55\x52\x3e\x8a\xe5\x52\x65\x5f\x47\xa7\x9e\x91\x6b\x75\xf7\xf0\xef\xee\x9e\xc2\x1e\xf4\xca\xc5\xf2\xf4\x08\x54\x8f\x09\xef\xff\xd4\x69\x94\x51\x5f\xb9\x96\xca\xe2\x70\x2e\xd2\xb0\xa0\x2a\x46\x6b\x9b\x06\x6e\x85\x8e\xd0\x92\x47\xbe\x36\xaf\x49\xeb\xea\x85\x17\xfd\x5c\x77\x4e\x98\x32\xf5\x5a\xb5\x65\xda\x39\x56\xcf\x0c\xed\x3a\xe7\x71\x5b\xcb\xd4\x2b\x4e\x9b\x3c\xcb\x58\xef\x63\x9e\x86\xa0\xfe\xd9\x88\x2e\x94\x55\x7d\xd2\xb3\x09\x14\x51\xf6\xd3\xa6\x49\xdd\xbe\xa2\x10\xa7\xe7\x6f\xcf\x7f\xe2\x6a\x2d\x56\x08\xfc\x30\x60\x08\x2c\x8f\x27\x77\x77\x87\x48\x30\x0a\x01\x72\x45\x75\x4c\xf3\xdf\x2e\x4e\xe0\xfc\xec\xec\x6c\x8b\xec\x06\xbb\x44\xcf\x2f\x82\x15\x1b\x6d\x07\x0b\xfc\xbe\x80\xfc\x9a\x5e\x54\x27\xf5\x8f\x2f\x7e\xba\xd5\x6c\x25\xb4\xbb\xfa\x30\x81\x82\xf5\xa6\x08\xae\x3e\x30\xe6\x62\xff\x24\x82\x23\x85\x2f\x43\x7d\x26\x85\x27\xbc\xf8\xe1\xdd\xbb\x1f\x93\x19\xef\xb7\x46\x8c\x2f\x58\x02\x85\x48\x3b\xb9\x10\xe9\x29\xc5\x25\x91\x39\x24\x58\xf8\x21\xc4\x91\xa5\x15\x26\x30\x59\x5a\xda\x41\xa0\x82\x76\x30\x66\x44\x2e\x15\xb9\xef\x22\xdc\xa1\xd7\xed\x3d\xf4\x9e\xa8\x1d\x6b\xe0\x31\xf4\xe4\x02\x86\x8a\xf9\xc6\xce\xbd\xd4\x4e\x7d\x61\x8a\x93\xd6\x1c\x6a\xba\x26\x5a\x0f\xfd\x89\xb4\xea\x79\xb0\x51\xf6\xf3\x48\x9c\xf3\x02\xcc\x81\x05\xcf\x63\xed\x13\x2b\x1e\x92\x6d\x63\x4b\xab\xf2\xc7\xfc\x9f\x01\x00\x50\xf5\xbf\x26\x83\x08\x00\x00"),
+		},
+		"/rest/cmd/{{ .Name }}cli/routes.go.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "routes.go.tmpl",
+			modTime:          time.Date(2026, 10, 19, 10, 12, 33, 691556440, time.UTC),
+			uncompressedSize: 807,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x91\xd1\x6e\xb3\x30\x0c\x85\xaf\x9b\xa7\xb0\x7a\x55\x2a\xfe\xe4\x19\x7e\xa1\x5d\x54\xdb\x55\xb7\x17\x08\xc6\xa5\x51\x20\x89\x12\x23\xa5\x9a\xf6\xee\x13\xd0\xb5\x5b\xa5\x0d\x6e\x30\xe0\xef\xd8\x47\xc7\x41\xa3\xd5\x2d\x41\xaf\x8d\x13\xc2\xf4\xc1\x47\x86\x9d\xd8\x6c\x5b\xc3\xe7\xa1\x96\xe8\x7b\x85\x3e\xf5\x3e\x5d\xcb\xbf\xd4\x58\x85\x9d\x21\xc7\x0a\xbd\x63\xca\xbc\x5d\x89\x5b\xba\xa4\xb5\x6c\x0c\xb8\x16\xe5\xbc\x4c\xfa\x86\xc6\x79\x96\x2e\xb5\x4e\x04\x0b\x74\xbc\x04\xf6\x5f\x76\xf5\xc0\xe7\x48\x89\x17\x44\x59\x8d\xe0\xcd\x3e\xa5\x31\x96\x5a\x3b\xbb\x4a\x3b\x82\x0f\xda\xef\x92\xd6\x47\xd3\x75\x5a\xf5\x43\xde\x8a\x42\x08\xa5\x20\x52\x6b\x12\x53\x3c\xfa\x81\x29\xdd\x3e\x13\xf0\x99\xe0\xf8\xf4\xfa\x06\x71\xee\xf8\xd3\xf4\x4b\x87\xd0\x19\xd4\x6c\xbc\x93\xe2\x34\x38\x7c\x98\xb0\xc3\xce\x54\x9c\xe1\x7a\x53\x59\xbd\x1c\xaa\xf9\xb5\x84\x08\xfb\x7e\xc8\x72\x5a\x15\x4b\xc0\x06\x61\x3f\x45\x2a\xab\xf1\x59\x82\xad\xe1\x1a\xad\x7c\x9e\x6b\x01\xef\x53\xdc\x49\x1e\x7f\xae\x19\xf5\xd3\x26\x79\x70\x0d\x39\x2e\xc4\x26\x06\x7c\xa4\x66\x33\x25\xc4\x42\x6c\x38\xff\xda\x9d\xbc\x14\xf7\x1b\xfd\x0d\x96\x90\xd8\x47\xfa\x8f\x58\xdc\x4f\xb3\x24\xb1\x75\x21\x3e\xc4\xe7\x00\x8d\xed\xa6\xa2\x27\x03\x00\x00"),
+		},
+		"/rest/template.yml": &vfsgen۰FileInfo{
+			name:    "template.yml",
+			modTime: time.Date(2026, 10, 19, 10, 4, 9, 796054916, time.UTC),
+			content: []byte("\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x41\x63\x63\x6f\x75\x6e\x74\x73\x20\x61\x6e\x64\x20\x74\x6f\x6b\x65\x6e\x20\x74\x72\x61\x6e\x73\x66\x65\x72\x73\x2c\x20\x77\x69\x74\x68\x20\x61\x20\x52\x45\x53\x54\x20\x73\x65\x72\x76\x65\x72\x20\x69\x6e\x20\x74\x68\x65\x20\x43\x4c\x49\x0a\x62\x61\x73\x65\x3a\x20\x62\x61\x6e\x6b\x0a"),
+		},
+		"/staking": &vfsgen۰DirInfo{
+			name:    "staking",
+			modTime: time.Date(2026, 10, 19, 10, 53, 39, 551317957, time.UTC),
+		},
+		"/staking/app.go.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "app.go.tmpl",
+			modTime:          time.Date(2026, 10, 19, 10, 49, 12, 517365351, time.UTC),
+			uncompressedSize: 9701,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x5a\x5f\x73\xdb\xb8\x11\x7f\x26\x3f\xc5\x9e\x66\x2e\x25\x33\x2c\xdd\x67\xb5\xea\x8c\x1d\xa7\xbe\x34\xb6\x93\x46\xbe\xf4\x21\x93\xb9\x81\xc8\x95\x84\x88\x02\x18\x00\xb2\xe5\xf1\xf8\xbb\x77\x16\x04\x41\x90\xa6\x64\xf5\xf2\x90\x88\xc0\xfe\xc3\xfe\xf9\xed\x82\x4c\xcd\x8a\x0d\x5b\x21\xb0\xba\x8e\x63\xbe\xad\xa5\x32\x90\xc4\xd1\x04\x45\x21\x4b\x2e\x56\x67\x3f\xb4\x14\x93\x38\x9a\x2c\xb7\x86\xfe\xd1\x52\x99\x49\x1c\x47\x0b\xb6\x85\xc9\x8a\x9b\xf5\x6e\x91\x17\x72\x7b\x56\x48\xbd\x95\xda\xfd\xf3\x57\x5d\x6e\xce\x16\x4c\x23\xab\x6b\x62\x3a\x4a\x57\xc8\x12\x8b\x49\x1c\xe9\x72\xf3\x8a\x44\xf3\x58\xa3\x7e\x55\xde\xfe\x8c\xed\xcc\xfa\x04\xb2\x05\x13\x9b\x49\x1c\x95\x5c\x1b\xf5\x8a\xe6\xfd\x99\xa5\xe2\x8b\x9d\xe1\x52\x9c\x20\x7b\x25\xef\x4f\xa0\xda\x72\x61\x4e\x20\xab\x99\x62\xdb\x53\x0e\xae\x2b\xa6\xd7\x5c\xac\x4e\x21\x35\x6c\x83\x93\x38\x62\x8b\x82\xf7\x0e\x6f\x50\x94\xa8\xc8\xb2\xf0\x27\x91\x79\xff\x17\x5b\x71\x02\x4b\xc5\x17\xa4\x73\xbb\xb5\x0e\x2b\x17\xdb\x53\x79\xca\xc5\xc0\xfe\x23\xb4\x95\xa4\xc3\x9a\xad\x35\xed\x04\x05\xee\x08\x69\x1c\x17\x52\x68\x9b\xea\xac\xae\x6f\xd9\x16\x61\x06\x93\xa7\x27\xc8\xed\xef\xe7\xe7\x49\x9c\xc6\xf1\xd9\x19\xdc\x3c\x9e\xd7\x35\x2c\xf9\x7e\x8b\x31\x31\xbb\x05\x6d\xd4\xae\x30\xf0\x14\x47\x6f\x17\x6c\x9b\x5f\x30\x8d\xe7\x75\x1d\x47\x45\x59\xc0\x5b\x9b\xd1\xf9\x3b\xfa\x3b\x8e\xa3\x0d\x3e\xde\x30\x2e\xc0\xff\x79\xab\xcb\x4d\xfe\xf1\xeb\xdc\x48\x85\x1f\xf1\xd1\x52\x9c\x17\x85\xdc\x09\x73\x84\x62\x4e\x01\x03\x38\x44\x61\x86\x24\x56\xcb\x9d\x62\x42\x73\x14\xa6\x2f\xca\xa5\xc9\x01\x51\x64\x30\xf7\xb6\x1c\xa0\xb8\xa4\x7a\x38\x4c\x61\x86\x24\x47\xcc\xb9\x92\xf7\x00\x87\x45\x6d\xf0\xf1\x5f\x88\xef\x64\x55\x61\x41\xd5\x37\x4a\xf1\xd9\x96\xc8\x41\x19\x66\x48\x72\xc0\x9c\x38\x62\x4d\x20\x3e\x22\xd6\xd8\x1a\x4f\x78\x92\xbb\x08\x35\x1b\x71\xb4\x0c\x4d\x72\xd4\x96\xae\x67\x6b\x4b\x4d\x50\xd3\x13\x09\x00\xb4\x96\xb7\x04\x9a\x42\x37\xa0\xb0\x6b\x1d\x85\x0b\x5a\x48\xd4\xae\x79\x22\xca\xf1\x81\x14\xa0\x35\x4f\x60\x61\x6c\x40\x61\xd7\x3c\xc5\x4a\xde\x0f\x25\xc0\x4a\xde\xfb\xfd\x06\x8c\xfa\x24\xcd\x5a\x4b\xf2\x6c\x0b\xe7\x16\x1f\xc2\xda\x59\xee\x44\xe1\xd7\x92\x4a\xae\x56\xa8\xa0\x92\xab\xfc\xda\xfe\xcc\xa0\x5c\x40\xb9\xd8\xe6\x97\x17\x19\x50\xe7\x38\xaf\xeb\x4f\x35\xf9\x56\x43\x9e\xe7\xc4\x9d\x84\x95\x96\xa6\xf0\xb6\x11\xff\xd4\x14\xdd\x74\x06\x37\x6c\x83\xb6\xe8\x92\x34\x8e\x16\xb4\x37\x9d\x01\xf1\xdc\xe2\x83\x63\x4b\x5c\xb1\x67\x50\x79\xb5\x59\x13\xb7\x4b\x5c\xb2\x5d\x65\xee\xf6\x97\x48\xf5\xab\x92\xa2\x2c\xd2\xa1\x2d\x79\x9e\xa7\x71\x1c\xdd\x33\x45\x0d\x13\x66\xf0\xc6\x1a\xf1\x14\x47\x91\xd3\x30\x05\xd2\x9c\xc5\x11\x59\x35\x25\xe7\x40\x51\x16\x59\x1c\x47\x2d\x10\x4c\x3d\x10\x00\x25\xe1\x2d\x3e\x74\x99\x9a\x4c\xb6\x8c\x8b\x49\x4a\xfc\x1d\x2a\x4c\x0f\x93\xb3\xa2\xf0\xd4\x16\x21\xa6\xc7\x84\xdb\x8c\x6a\xe8\xcd\x0b\x06\x47\xff\xa2\x28\x92\x89\x69\x97\xfe\x08\x04\x38\x94\x98\xc2\x31\x85\x94\x7b\x9e\xdc\xe2\xc1\xf4\x18\xb9\xcd\xc4\xce\xbe\x3e\xc3\x29\xf6\x05\x02\x02\x9c\x9b\xf6\x04\x84\x0a\xdb\x02\xf2\x2c\x57\xf2\xde\x2b\x1c\x67\xa1\xce\xde\x52\xf7\x6a\x7d\x3a\x46\xbd\xc4\xce\x5d\x0d\x00\x4d\x8f\xc8\x76\x8d\xde\x3b\xa0\xcf\x71\x8a\x03\x02\x09\xcf\x71\x1c\xb1\xba\xce\xfb\x88\x36\x6b\xb2\xfd\x16\x1f\x7a\x80\x96\xc4\x91\x25\xb6\xb9\xda\xfc\xec\xf2\x8f\xcc\xb1\x5c\x9f\x95\x34\xd2\x26\xba\xdf\x48\x9d\x96\x31\x3c\xec\x74\x8d\x80\x62\xd2\xaa\x03\xa7\xac\x47\x93\x36\x52\x03\xdc\xa4\x52\x16\x9b\xb6\x96\x03\x19\xbd\xf3\x39\xbe\x1e\x48\xcd\x5a\x7c\xa2\x6c\x1b\xd7\xdd\x38\xba\x79\xec\x1c\x4f\x67\x3b\x3b\x83\xbb\x35\x82\x34\x6b\x54\xb0\x95\xe5\xae\x42\x0d\x1b\xc4\x1a\x18\x28\x5c\xa2\x42\x51\x20\x18\x09\x66\x8d\x60\xcb\xc3\xee\xa2\xca\xe0\x61\x2d\x35\xc2\x5a\xca\x8d\xb6\x72\x98\x42\xd0\x68\x40\x5a\x8e\x35\x3e\xda\x15\x56\x55\x50\x28\x64\x06\xcb\xbc\xdf\x07\xa6\xb3\x46\x60\x60\xf7\x68\x94\x6c\x19\x77\xb6\x37\x8f\x71\x34\x70\x60\x06\x43\xc7\xe4\xf3\xdd\x42\xd7\xac\xc0\xa4\x51\xe3\x10\xb0\x39\x3b\xad\xa7\xad\x94\x2f\xb8\xe2\xda\xa0\x22\x74\x1d\xe1\xf0\xcb\xc4\xe0\x22\x10\x74\xa2\x59\xd3\x82\x5e\x39\xc5\x0d\x77\x89\x76\xd0\x4a\x2b\x65\xdc\xc8\x37\x81\xdf\x32\x38\x90\x8f\x9d\x71\x61\x17\x9c\x81\x7d\x7a\xcd\x3c\x8b\x45\xc7\xed\x6b\xe4\x8c\x1b\x38\x8c\xc5\xa9\x06\xff\x59\xf7\xb7\xc0\xe6\x0f\xd9\x2e\xbc\x76\xce\x16\x33\xc7\xbd\x3a\x7e\x70\x2f\xfb\xff\xca\xa0\x01\xd3\xd8\x29\xba\x59\x64\x66\x67\x90\x57\x6c\xbf\x92\xf7\x63\x11\x3a\x66\x3a\x49\xfd\x13\x11\x3b\x78\xaa\x40\xde\xd8\x81\x02\x19\x30\x83\xb7\xc1\x63\x3e\x47\xf3\x1b\x41\x05\x85\xe5\x16\x1f\xa8\x8a\xb9\x58\x35\x4b\x83\x9c\xcd\x9b\xd5\xb4\x39\x58\xeb\xfd\xfe\x5e\x1a\x60\xf3\x17\xb9\x33\xa8\x92\x34\x8f\xa3\xe8\xbc\x2c\xed\x63\x32\xa1\xc3\x4d\x32\x8f\xaa\xbf\x31\x51\x56\x0e\x1a\xbb\x73\xa7\x03\x26\x6b\xf1\x24\xeb\x80\x29\x64\x0b\x8e\x33\xe4\xb3\xd6\x4f\xb2\xae\xd6\x42\xbe\xe0\x68\x2f\xf4\xb9\xc3\x91\x4a\xf7\xf3\x85\x56\xb7\x3e\x2e\x80\xda\x75\xd6\x26\x4f\xc8\xe6\x73\x2b\x6d\xdd\xf4\x9f\x1d\xaa\xc7\x71\x5f\xf5\xa4\x10\x1d\x7f\x29\xe5\x15\x47\x85\x5c\x81\xa3\x9a\x18\xd2\xac\xd9\x9a\x31\x47\xf3\x41\x70\xf3\x6e\xcd\xb8\x70\xf4\xbc\x7b\x76\x89\x34\x47\x73\x81\x2b\x2e\x2e\x2a\x59\x6c\x1c\xd5\x22\x58\xe8\xc8\xde\x8b\x32\x24\x42\x51\xbe\x20\x39\x17\x06\xbd\x6b\xda\xe9\x20\x5c\x1b\x76\xd9\x83\x88\xe5\x0f\x71\x43\xc4\x76\x40\xd1\x1f\xce\xbf\x5e\xb7\xf5\xea\x46\xe0\x03\x23\x46\xd8\xce\xc6\x1b\xc3\x10\x88\x07\x80\xf5\x12\x07\x86\x73\x45\xb0\xee\x7a\xbe\xaf\xcd\xc0\x64\x3f\x62\x25\xfd\x81\x60\xd8\x64\xfd\xa3\xed\x0e\x74\x76\x54\xb6\x6f\xd3\xfa\xb5\x64\xe5\x35\x33\xa8\xcd\x57\x54\x9a\x4b\x91\x38\xc5\x74\x09\x48\xe3\x88\x2f\x81\xa8\x7f\x99\x81\xe0\x15\xbd\x49\x88\x8a\xad\xc8\xdf\xef\xb9\x49\x50\xa9\xfc\xbd\x52\x52\x25\x69\xda\x0c\x72\x0a\xcd\x4e\x09\xd2\xe7\xae\x57\x61\xb0\x9b\xd2\x40\x6d\x67\x90\x7b\x56\xf1\x92\x19\xa9\x34\x3c\xac\x79\xb1\x86\x2d\xd7\x1a\x4b\x58\x10\xad\x06\xa9\x40\xf3\x95\xc0\x12\xcc\x03\x2f\x30\xa3\xab\x9a\x7f\xb7\xe5\x44\x28\x7c\x60\xaa\xd4\x20\x97\xf6\xb1\x56\x78\xcf\xe5\x4e\x37\x22\x80\x89\xd2\x36\x74\x0d\x02\x1f\xc0\xc8\x0d\x0a\x9d\xc7\x74\x43\x03\x3a\xa1\xbb\x97\xa5\x3d\x0b\x93\xc2\xec\xed\xc0\xfb\x4e\x0a\x83\x7b\x93\x81\xc2\x9f\xc0\x16\x05\xcf\xbf\xe0\xcf\x1d\xea\x20\x9b\xd3\x76\x5d\xd7\x52\x68\xec\x36\xc8\x47\x86\xad\x34\x39\xd8\x83\x41\xb7\xdd\x68\xb1\x92\xc7\x80\x31\x75\x97\xdf\x63\x1c\x21\x10\xc5\x11\x1d\x72\x84\xba\x3f\xdd\xa4\x41\x70\xc6\xcd\xa6\xc8\xde\xb1\x95\x9e\x02\x19\x9f\xdf\xc9\x8f\x5f\x3f\x33\xae\x08\xbf\x29\xb6\x4d\x38\xbb\xb2\x04\xc3\xaa\x8a\xbb\x48\xd4\x4a\xd6\x52\xb3\x4a\x5b\xaf\xef\xea\x92\x99\x61\x98\x69\xa4\x1c\x73\x7f\x27\xf1\x75\xe7\xb7\x18\x31\x70\x7d\xbb\x1c\x3a\x9e\x30\xb0\x5d\x0f\x3d\xd2\x01\x61\x1c\x79\xdb\x7e\x77\x06\xfb\x49\x76\x8c\x33\xec\x1a\x07\x9c\xd9\xb2\x91\x2b\xbf\x0e\x84\x4f\xe1\x7e\xb0\x92\x79\x87\x87\x7f\xe8\x00\xad\xc3\x5f\x7a\x2b\x40\xd8\xd7\xdd\xe5\xe1\x79\xe0\x2f\xbf\x4e\x0e\xd3\x86\x19\xfc\xf7\xfc\xd3\x2d\x79\x4d\xe1\xcf\xfc\xbc\xae\xe7\xb4\x76\xf1\x68\x50\xbb\x97\x08\x2b\x14\xa8\xb9\xb6\xeb\x70\x15\x3c\xf4\x90\xa4\x28\x8b\xfc\x77\xb1\x65\x4a\xaf\x59\x45\x22\x13\x2f\x3c\x83\x37\xa1\x8c\x51\x58\xa9\x99\xe0\x05\x61\x8a\xc5\x92\x96\x60\x3a\x03\xe7\x4a\x0c\x15\x77\x57\xa3\x9e\xdc\xbf\x1f\x17\x6a\xef\x37\xef\xf7\xf4\xf5\x00\x4b\x70\xbd\xc2\xdd\x93\xcc\x1a\xb9\x6a\xd7\x40\xec\xb6\x0b\x54\x74\xd3\x91\xca\xe4\xf3\x8a\x17\x38\x37\x6c\x51\x61\x12\xea\x6b\xdf\xb6\xe9\x0c\x28\x56\x09\xcf\xe0\x07\x70\x61\x52\x58\x48\xd9\x18\xe0\xf2\x64\x94\xeb\x1b\xff\xde\xfe\xbe\xb5\xfa\xe0\x1f\x07\x08\x7f\x0c\x08\xe3\xe8\x39\x8d\xa3\xa5\x54\xf0\x47\x06\x2b\x56\xd8\x17\x4b\x8a\x89\x15\x8e\x0b\xb0\xa6\x38\x32\x22\xcf\xef\xa4\xdb\xa2\x97\x50\xf4\x36\xb1\x2f\x1f\x66\xf0\xa2\x9b\xe6\x57\x68\x6e\x71\x6f\x7a\x84\x94\x85\xa9\x6b\x56\x7d\x6a\x6a\xd8\x4e\x45\x83\x47\x45\xd1\xc5\xe0\x93\x00\x66\x71\xb9\xa0\x71\x21\xf3\xb7\x52\x2e\x56\x0e\xaa\x5b\x54\x77\x42\xb5\xbf\x84\x56\x92\xae\xab\x3b\x61\x78\x65\xc3\x69\xd6\xfe\xcc\x60\x5f\x30\x30\xdb\xe8\x35\x94\x58\xe1\x8a\x12\xd6\xac\x71\xdb\xde\x59\x2f\x99\x61\xe4\xab\x9e\x97\xe6\xed\x8e\x4d\xcb\x0a\x45\x3f\xc8\x57\x28\xee\xf6\x3a\x85\x7f\xc2\xdf\xac\x1f\xbd\xa0\xfc\xb3\x94\x55\x7e\x4d\x06\xdd\x35\x46\xcf\xa0\xea\x9e\x0e\xa5\x4a\xc0\x6f\xdb\x75\x7e\x21\x45\x79\x89\x42\x6e\xad\x83\x3a\x5c\xd2\x59\x5b\x02\x96\x25\xa7\xc2\x75\x45\x30\x8a\x4b\x81\xe8\x13\x2a\x2c\x8e\xec\x6b\x8f\x51\xa1\x63\xb7\xbc\xbe\xcf\xce\x77\x66\xed\x14\xf9\x26\x37\x6e\x5f\xaf\xbd\x0d\xa4\xb4\x53\x11\x49\xea\x5b\x4f\x00\x3e\x2a\xcf\x23\xf8\x40\xd4\x95\xbc\x77\xac\xd4\xf5\xc6\x79\xbb\x7e\x38\x60\xa6\xe1\xcd\x71\xdb\xe6\x3a\xce\x1e\xf4\xdd\x01\xbf\x1d\xac\x1a\x01\xa7\xe5\x50\x5b\xba\x94\x5a\x07\x6a\xb7\x61\xb1\x81\xb3\x20\x6c\xf6\xcd\x7b\xaa\xb9\x29\xef\xf6\xb4\xd8\x21\xe4\x38\xfa\xae\x48\x40\x06\x6f\xcc\xfe\x25\x2e\xf6\x93\x21\xa2\xb4\x23\xac\xd2\xad\x34\xf7\x9a\x38\xbf\xc4\x8a\xdf\xa3\xba\xdb\xb7\x80\x9b\xdf\xec\xb4\xb9\x69\x30\xfe\x82\x0b\xa6\x1e\xaf\x51\xac\xcc\xfa\xb3\xc2\x25\xdf\x63\x99\x98\x3d\x0d\x83\xd6\xba\x5f\x14\xea\xfc\x83\xfe\xf4\x31\x49\x7b\x4a\x69\xf9\x5a\xae\xbc\x62\x52\xde\x25\x3d\xcc\x86\x79\x4d\x3d\xa9\x7a\x3c\x17\xe5\x17\x0b\xa6\xbe\xb5\xce\xd1\xb8\x5e\xea\x60\xc8\xa1\xcb\x5d\x7f\xbe\x74\x48\xe2\x9c\x0b\x4b\x5e\x61\x06\x7c\x09\x4c\x3c\x66\xb0\xdd\x69\x03\x0b\x0b\x12\x20\x05\xb6\xd4\x56\x8e\xcd\x47\xf7\x36\x2d\xf7\x71\xa5\x26\xe9\x4d\x08\x42\x7a\x60\xfb\x97\x99\x5d\xee\xec\x71\xbe\x68\xfc\xbf\xdc\x9a\x66\x84\x5e\x26\x93\xa1\x91\xb0\x66\x1a\x7e\x2d\x83\xa3\x74\x40\xd9\x9a\x05\xbf\x96\x93\x6c\x4c\x6f\x36\xd4\x6a\xa3\x42\x9e\xa6\x4f\xe3\xf9\x5c\x2a\x93\xd8\xa9\xe0\xeb\x60\x2c\x19\x0a\x4a\x4f\x60\x09\xd5\xb8\xe4\xe6\x19\xd9\xdd\xa5\x76\x47\xd2\x1c\x9f\xd2\xe3\x9e\x55\xf9\xfb\x9f\x3b\x56\x0d\x74\x7e\xe3\xdf\xfb\x09\x13\x7a\xc9\x0b\x22\xd7\x8c\x84\x16\xb8\x16\x7f\x31\xc0\x3a\x8d\x2d\x55\xe8\xb7\x49\x06\x3c\x0d\x13\xf0\xf9\xc0\x4c\xe7\x87\xa5\xde\x50\x17\x8e\x73\x7e\x5c\xf3\xb3\x45\x3b\x42\x9d\x8b\xb2\xe3\x00\xb4\x7b\xba\x35\xc5\x60\x6b\x17\xab\xeb\x8a\x17\x8c\xba\x16\x10\x2e\xb0\xde\x79\xc6\x86\xe6\x23\x6a\x92\x14\x12\xfa\xbf\x10\xf9\x17\xf6\x70\x83\x5a\xb3\x15\x66\xf0\xed\xbb\xfb\xf4\x9c\x3b\x4c\xf3\xf4\xb6\xc3\x48\x65\xdd\x4d\x03\xa5\x2b\xff\x5b\x7c\x70\x63\x65\x62\xd4\x8e\x6e\x90\xe4\x93\xdf\x90\x95\xa8\x9e\x9e\x09\xe4\x7c\x5f\x9e\xce\xe0\xdb\x77\x27\xd6\xf5\xb8\xa7\xe7\x91\x57\xfa\xf9\x07\x83\x8a\x99\xf6\x9d\xbc\x83\x55\x3a\x5b\x42\x83\x49\xf8\xf1\x32\x18\x9f\xbc\x1a\x6b\x17\x8a\x32\x69\x57\x32\xfa\x4c\xd7\xd7\x4b\x7b\x69\xda\x0d\x5d\x4b\x56\x69\xb4\xa3\x52\x1c\x39\x97\x5a\x34\xa6\xac\x74\x9c\xf6\x99\x42\xeb\x44\xb8\x69\xdc\x2b\xa1\x1d\xd7\xe9\xdc\x0e\xd9\xd9\xf8\xff\x45\x7f\x18\x7b\xd3\x40\x53\xbe\x9f\x2f\xa6\xdd\xf7\xd2\x03\x22\x02\xd4\xa3\x7b\x57\xd4\x36\xa6\x69\xf7\x8d\xf4\x00\x67\x70\xd7\x23\x46\xdf\x91\xa6\xdd\xb7\xd3\x03\x9c\xe1\x75\x92\x58\x5d\x27\x75\xf7\x12\x6a\xc4\x07\x18\x7d\x2b\xb6\xa6\x86\xbd\x7c\xda\xdd\x7c\x0f\xf0\xf6\xc7\x02\x2b\xa0\x69\x78\xed\x75\xe8\xdb\xf7\x41\x1a\x3f\x3d\xdb\x42\xa3\xcc\xb2\x51\xcb\x86\xdd\xcf\x75\x25\xba\x6f\x7c\x10\x25\xbd\x16\x09\x83\x9e\xc1\x64\x92\xc1\x04\x60\x32\x3a\x1b\xb9\x94\x11\xbc\xca\x68\xcd\x0a\xb7\xea\xdc\x46\xa7\xd5\x86\x28\xff\xaf\xe2\x06\x83\xb2\x1b\x8f\x20\x89\x72\xd0\xe0\x3f\xfb\x86\x5f\x9a\x83\x6f\xc1\xbd\xff\x8f\x41\x26\x51\xcf\xa7\x4f\xc6\x33\x68\x36\x6e\xf1\x81\x86\x75\x5b\x28\xe1\x1b\xdd\xc2\x7e\x02\x8e\x23\x7a\x1b\x3a\xba\xd1\x18\x3c\xb6\x63\x23\x3f\xce\xd3\x86\x6f\x6c\x93\x52\x62\x94\xa9\x1c\x37\xa0\xb1\xdf\xef\xa8\xc7\xda\x48\xb7\xe5\xbc\x5b\x94\x45\xfc\x1c\xff\x6f\x00\x63\x90\xd6\x72\xe5\x25\x00\x00"),
+		},
+		"/staking/cmd": &vfsgen۰DirInfo{
+			name:    "cmd",
+			modTime: time.Date(2026, 10, 19, 10, 48, 32, 869453511, time.UTC),
+		},
+		"/staking/cmd/{{ .Name }}cli": &vfsgen۰DirInfo{
+			name:    "{{ .Name }}cli",
+			modTime: time.Date(2026, 10, 19, 10, 54, 52, 961476105, time.UTC),
+		},
+		"/staking/cmd/{{ .Name }}cli/main.go.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "main.go.tmpl",
+			modTime:          time.Date(2026, 10, 19, 10, 54, 52, 965476105, time.UTC),
+			uncompressedSize: 3449,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x56\x4d\x6f\xe3\x36\x10\x3d\x93\xbf\x82\x15\x8a\x42\x02\x5c\x19\x45\x6f\x01\x7c\x48\x1c\xc3\xbb\xc0\x66\x9b\xc6\x9b\xed\xa1\xe8\x81\x26\xc7\x0a\x2b\x89\xa3\x25\x29\xaf\x83\xc0\xff\xbd\xa0\x2c\x69\x25\x57\xce\x32\x9b\x4b\x64\xcd\x7b\x6f\x3e\x34\x33\x64\xc5\x45\xce\x33\x60\x25\x57\x9a\x52\x55\x56\x68\x1c\x8b\x29\x89\xd0\x46\x94\x12\x5e\x55\x2c\x7a\x79\x61\xe9\x1a\xef\xf3\x8c\x1d\x8f\x11\x25\x51\xa6\xdc\x53\xbd\x4d\x05\x96\x73\x81\xb6\x44\xdb\xfe\xfb\xd5\xca\x7c\x2e\x0a\x05\xda\x05\xc2\xe6\x39\x3c\xdb\x50\xac\xa9\x44\x28\xd4\x1d\x22\x4a\x78\xed\x9e\x44\x29\xd9\xeb\x8c\xc3\xdc\xe3\x3a\xa2\x28\x54\x44\xc9\x96\xeb\x3c\x84\xe9\x71\x63\xa6\x54\xd6\x99\x10\x6a\x03\x54\xdb\xda\x29\xd4\x63\x89\x0c\xf7\x21\x02\x19\xee\xc7\x3c\x5b\x70\xfb\xa4\x74\x16\x42\xee\xb0\x67\x0a\x8e\xe7\x10\x44\xf7\xc0\x31\x77\x48\xb1\xd5\xee\xb7\xdf\xe7\x02\xb7\x86\x9f\x59\x1c\x68\x09\xa6\x54\xda\x0d\x1f\x0b\xb5\xb5\x5e\x2d\xa2\x09\xa5\x02\xb5\x6d\x3a\xd0\x3a\x34\x70\x2d\x04\x6b\xff\x16\x2c\xe2\xc2\x77\x40\x63\x58\xe3\x7e\x60\xc8\x70\xdf\x19\x36\x6d\x6a\x27\x46\x97\x68\x6f\xf5\x91\xf7\x34\xeb\x7f\x45\x94\x7c\xa9\xc1\x3c\x3f\x60\xed\x5a\xfb\x37\x53\x42\xe9\x9e\x1b\x1f\x8e\x41\x74\xcb\x52\xb2\x05\xfb\xa5\xc9\x2c\x5d\x62\x59\x72\x2d\x5f\x28\x21\x8f\x16\xae\x18\x3b\x0d\xca\x47\x5e\x02\x3b\x1e\x7d\x3a\x33\x4a\xc8\xe6\x09\x8d\xbb\x1a\x99\xd8\xb2\x29\x5c\x34\xa3\xe4\x48\xc9\x2d\xec\x78\x5d\xb8\xe5\x87\xf7\xef\xb0\x04\xb6\x60\x68\xd3\xd5\xa1\xe2\x5a\xae\xf4\x3e\x8e\x7e\x7e\xf7\xc7\xdd\x6a\x9e\x9e\x29\x27\xbe\x52\xbb\x5a\x8b\x66\x6e\xe3\x84\xbd\x34\xa3\x9a\x6e\xc0\x2d\x51\xef\x54\x16\x27\x94\x9c\xc2\x5c\x69\xbe\x2d\xa0\x0d\x76\x83\xc6\xf9\xda\x2c\xd8\x8e\x17\x16\x28\x11\x52\xb0\xab\x05\xf3\xdc\x3b\x9e\xc3\x12\x25\x88\x38\xa1\x7d\xba\xe9\xb5\x94\x2d\x37\x3e\x7d\xef\xf4\xe4\x60\x59\xca\x38\x49\x28\x31\x95\x18\x60\x6c\xdc\xf2\xbc\x44\x53\x55\x5f\xb2\xab\x57\x6a\xc6\x58\xd4\xe0\x7c\x35\xc8\x75\xa1\xb8\x05\x7b\xc5\xfe\xfe\xc7\x4f\x87\xce\x5e\xa2\x2f\xd1\x71\x50\x46\xc6\xa2\x3f\x3d\xda\xe7\x60\xeb\xad\x68\xbd\x7a\xf2\x71\xe0\x71\x18\x35\x25\x4d\x8c\x37\x05\x8a\xbc\x7b\x97\xcc\xda\xb7\x9f\x79\xa1\x24\x77\x68\x86\x96\x84\x12\x77\x18\x25\xd5\xe9\xce\x98\x90\x22\x99\xf6\xd3\x56\xe7\x83\xd2\x70\x63\x80\xe7\xaf\xc3\xd6\xe0\x7a\x75\x4a\xba\x55\x95\xae\xc1\x5d\x0b\x81\xb5\xf6\xad\x16\x77\x13\xd0\xb8\x9d\xb1\xff\x83\x6e\x41\xa0\x04\x13\xfb\xa8\x7c\xe4\xfd\x04\x7b\xa1\x65\x29\x9b\x5a\xdd\x42\x01\x19\xf7\x7b\x26\xfe\x36\x02\x8d\x64\x00\xc5\x06\x73\x1e\xf5\x16\xb5\x54\x3a\xfb\x01\x7f\x13\xdc\x70\xc7\x0f\x20\xdf\xee\x71\x48\x0a\x77\xd5\xb7\xcb\xdb\x19\x3f\xe0\x64\xb2\x2a\x67\xab\x2a\x50\x6a\x9c\xee\x9b\x34\xee\xb9\xe1\x65\x78\xf4\xf7\x88\xc5\x24\xf8\x74\xac\x8d\xa0\x06\x2b\xb4\xbc\x85\xaf\x71\x1f\x02\xb6\x41\xe8\xcf\xe8\x20\x18\x18\x26\x79\x0b\x15\x5a\xe5\xde\x82\x9d\x12\xee\x0e\xa4\x33\xc6\x46\x65\x5a\xe9\xec\xbd\xde\x61\x5b\xbd\x16\xd7\x33\x93\x34\x4d\xfd\x56\x75\x87\xef\xad\xd4\xc8\x1d\x46\x47\xcf\x27\xc3\xb5\xe5\xa2\xf9\xf6\x53\x5b\xd3\x1d\xa6\x77\xd4\x3d\xda\xd1\x92\x6a\x6f\x45\xe9\x06\xb4\xfc\xe4\x39\x71\x97\x54\x67\x59\x83\xbb\x31\xc8\xa5\xe0\x3d\xb3\xc7\x0c\xb6\x97\x4f\x76\x60\x0e\xd9\x6c\xa7\xec\x2f\x86\x3a\xdc\xba\xa1\xe9\x9c\x75\xef\xd2\x00\x77\xd0\x4f\x4c\x7c\xa1\xc9\x57\x52\xb9\xef\x82\xda\x81\x85\x4b\xf6\x7e\x1c\x61\x72\x54\xce\xd0\xa7\x3d\x30\x89\xec\xae\x9b\x2d\xf2\x2f\xe5\x9e\xa4\xe1\x5f\x1f\xe0\x2b\x37\xd2\xc6\x17\x60\x1b\x70\x1d\xf2\x5a\x4a\x13\x5f\xee\xcd\x47\xfd\x2f\x57\x45\x3c\xd9\xe9\x9b\x7a\x5b\x2a\xd7\xcd\xe5\x34\xa6\x1b\x9a\x49\x63\x33\xa4\x67\xdd\x3d\x71\xed\xa0\xa4\x3f\x48\xbd\x44\xf3\x79\xfd\xc3\xf9\xa7\xf7\x2a\x17\x15\x72\x78\xb6\xdd\xa8\xd8\xf6\x94\xa7\x04\x0e\x20\x6a\x87\xc6\x5f\x81\x44\xa1\xd2\x7b\x03\x15\x37\x70\xc7\x95\xf6\xed\xdd\x4a\xcd\x58\xf4\x71\x13\xcd\xd8\xf8\xa6\x96\x50\x02\xa6\x61\x76\x2a\xe9\xaa\x79\x00\x7f\xf7\x52\x3b\xe6\xad\x3f\x2d\x98\x56\x85\xbf\x9d\x91\x8a\x6b\x25\x62\x30\x26\xa1\xe4\x48\x8f\xf4\xbf\x01\x00\x0f\xed\xe4\xda\x79\x0d\x00\x00"),
+		},
+		"/staking/cmd/{{ .Name }}d": &vfsgen۰DirInfo{
+			name:    "{{ .Name }}d",
+			modTime: time.Date(2026, 10, 19, 10, 54, 34, 514817538, time.UTC),
+		},
+		"/staking/cmd/{{ .Name }}d/gentx.go.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "gentx.go.tmpl",
+			modTime:          time.Date(2026, 10, 19, 10, 57, 14, 817429684, time.UTC),
+			uncompressedSize: 5117,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x58\x6d\x6f\xdb\x38\x12\xfe\x2c\xfd\x8a\x59\x01\x6d\xa5\x42\x91\xd3\xde\xe2\x0e\xf0\x5e\x0e\x68\xed\x4d\x36\xdb\x4d\x2e\xa8\x7b\xbb\x1f\x7a\xc5\x2e\x2d\x8e\x64\x9e\x25\xd2\x47\xd2\x8d\x7d\x85\xff\xfb\x61\x28\x52\x7e\x89\xd3\xb8\xd8\xa0\x71\x2d\x72\x38\xf3\x70\x5e\x9e\x19\x65\xc1\xca\x39\xab\x11\x5a\x26\x64\x1c\x8b\x76\xa1\xb4\x85\x34\x8e\x92\xaa\xb5\x49\x1c\x25\x42\x0d\x84\x5a\x5a\xd1\xd0\x83\x32\xf4\xb9\x60\x76\x36\xa8\x44\x83\xf4\x25\x89\xe3\x88\x2d\x16\x90\x7c\xf9\x02\xc5\x95\xba\x9b\xd7\xb0\xd9\x90\x54\x2d\xec\x6c\x39\x2d\x4a\xd5\x0e\x4a\x65\x5a\x65\xfc\x7f\x67\x86\xcf\x07\x65\x23\x50\x92\xfe\xb2\x99\xe3\xda\xc0\x29\xd2\x03\x92\x4c\xe2\xa8\x66\x82\x5d\x4b\x61\x9f\x3a\xd4\xf2\x01\x89\x0e\x84\x14\xf6\x69\x40\x8a\x63\xf9\xb4\x94\x5e\x2f\xac\x0a\x40\xbe\x2e\x6b\x50\x7f\x46\x9d\xc4\x91\xe1\xf3\x27\xa0\xda\xf5\x02\x9f\x56\xb8\x1a\xb0\xa5\x9d\x9d\x20\x66\x2c\x9b\xe3\x81\x9c\x59\x54\xaf\xfe\x32\x28\xd5\x54\xb3\xa3\x3b\x9f\xc5\xc2\x81\x2d\xab\x7a\x0f\xac\x45\xc9\x51\xb7\x42\xda\xdd\xaf\xa5\x92\x95\xa8\x0f\x14\x3d\x22\xea\x5c\x76\x92\x68\x23\xa6\x86\x32\xe3\x1b\x84\x55\xdb\x2a\x99\xc4\x91\x6d\x9d\x0f\x4f\x80\xee\x7d\x9d\xc5\x71\xa9\xa4\x71\xa9\x5e\x35\xac\x1e\xb9\x84\xfc\x49\xb5\x08\x17\x90\xcc\x54\x8b\x67\x7d\x8e\xd2\xfe\x9b\x56\x2d\xa5\x05\xfa\xb9\x80\x84\xb9\x27\xbf\x75\xa3\xa4\x98\xa3\xf6\x5b\x6d\xf7\x94\xc4\x59\x1c\x0f\x06\x50\xa3\xfc\xb0\x1a\xb5\x1c\x8c\xa8\xa5\x01\x46\x0b\x68\x84\x01\xab\x99\x34\xac\xb4\x42\x49\x68\xd9\x5c\xc8\x1a\xec\x0c\x41\x2a\x8e\xc0\xe0\x33\x6b\x04\x67\x56\x69\x50\x15\xad\x93\xaa\x72\xc6\x84\xf4\xcf\xbd\x1a\x2a\xc3\x1c\xee\x85\x9d\x01\x03\x83\x4d\x05\x1c\x1b\xac\x99\xd3\xab\x2a\x60\x12\x58\x59\x12\x5a\x50\x15\x69\xa1\x32\xbd\x65\x2d\xc2\x66\x53\x36\xa2\x80\x0f\x33\xec\x25\xda\xa5\xb1\x30\x45\xa8\x96\x92\x23\x07\x21\x1f\x98\x2a\xe2\x6a\x29\xcb\xfe\x56\x69\x69\x57\xf0\xb2\xcb\xf4\x62\xa4\xa4\xc5\x95\xcd\xa1\xe4\x25\xbc\x74\x05\x55\x8c\xe8\x33\xa3\xa7\xa9\x66\xc5\x48\xb5\x2d\x93\x1c\xbe\xc4\x51\xd9\x72\x18\x5e\xc0\xf3\xbd\x8d\x2f\x71\x14\xfd\xcb\xe0\x10\x00\x92\x1a\xa5\x5d\x25\x79\x1c\x45\x93\x99\xd2\x76\x08\xc9\x15\x4a\xd4\xcc\xe2\x23\x4e\x2c\x35\x32\x1b\xdc\x78\xe8\x3f\xe7\x57\xa7\xed\x17\x25\xeb\x21\xfc\xf1\xa7\x95\x05\xa7\xc7\x47\x9c\x4e\x87\xce\xce\x24\x6b\x7b\xd7\x16\x71\x4c\x8e\xde\x35\x21\x0c\xdc\x6b\x61\x2d\x4a\xb0\x0a\xba\x8a\x1a\xb8\x5b\x77\x9f\x67\x7f\x27\xcc\x20\xf8\x3f\x8a\xff\x18\x25\x81\x1c\xb7\xd0\x42\x5a\xe4\x45\x7c\xbd\x0d\x16\xe3\x14\x2b\xab\xf6\x62\xb5\x63\xc8\x04\x44\x61\x8f\x52\xa6\xf8\x83\x7c\xf1\x46\xd7\x66\x08\x5d\x08\x6e\x15\x3d\xd1\xea\xfb\xa5\xfc\x71\x48\x39\x50\xa6\xbf\x1f\x44\x2e\x87\xdf\xe1\xe3\x27\x63\xb5\x90\x75\x06\xa8\xb5\xd2\x14\xcc\x28\xea\xe0\x53\x44\x4b\xbb\xa2\x4c\xa8\x44\xbd\x5d\x2f\x26\x68\xdf\x2b\x65\x53\xc7\x32\xc5\x15\xda\x89\x53\x91\x52\x06\x52\xcd\x5d\x36\xac\xce\x32\x3a\x40\x77\xbe\x1e\xe7\x54\x00\x77\xcb\xe9\x3b\x5c\xe7\x64\x86\x14\x07\xda\x2f\xe8\x43\xb0\x46\xfc\x0f\x6f\x15\xc7\x5f\x43\x70\x2e\x45\x83\x26\xed\x0c\x3a\x55\xa2\x72\x47\xbf\xbb\x00\x29\x9a\x0e\x66\xa4\xd1\x2e\xb5\xa4\x75\x7a\xdc\xd0\x87\x2b\xab\xeb\x31\x99\x38\x02\x0f\xa5\x2d\x08\xdd\xa8\x93\x0a\x8a\xc3\xa1\x8b\x0b\x48\x12\xaf\xbb\x46\x39\x56\x65\x8f\xd7\x53\x52\x41\x99\x66\x84\x19\xab\xf2\x52\xab\x96\x50\x7a\x90\x61\xc7\x2d\x75\xb7\x3f\x8a\xf9\x00\x74\x87\xba\x87\x7d\x41\x31\x1f\xab\xb2\xf0\x08\xfb\x7b\x75\x1c\xd5\xc3\x31\x7c\x5e\xdc\x31\x6d\x70\xa4\x84\x7c\x10\x88\x2d\xc5\x65\xdf\xe2\x3b\x4f\x76\xce\x77\x21\x0e\x9e\x0e\xfb\x48\x90\xf0\x7c\xda\xe3\xe8\x1a\x3e\x99\x7e\x87\xeb\xb7\xcc\x20\x79\x65\x2c\xf4\x51\x48\x5b\x56\xfe\x26\x58\xae\xf2\xbe\x1e\x4f\xa2\x40\xa7\x72\xc1\x8c\x59\xcc\x34\x33\xb8\x03\x91\xac\xd2\xb9\x3b\x66\xcc\xbd\xd2\x3c\xad\x5a\x5b\x4c\x5c\xf5\x55\x69\x12\x56\xa9\x6e\x89\xd6\x3b\x26\x78\xf1\xcc\xbc\x18\x26\x39\x90\xf1\x2c\x0f\x4a\xde\x2e\xab\x0a\xf5\xc4\x72\x21\xd3\x6f\xba\x83\xa3\xd8\x1e\x92\xc4\xfb\x2b\x5a\x48\x4b\x5e\xe6\x40\xee\x24\x33\x39\xec\xa2\xf7\x19\x91\x83\x8f\xca\x5e\x11\x75\xd9\x70\x32\x00\x5a\xe4\xc2\x99\x0e\x83\x5e\xf1\x33\x25\x8e\x4f\x5d\xaa\xe6\xb1\xd0\x39\x24\x7e\x14\xc8\x03\x63\xef\x9a\x20\x5f\xba\x0e\x5d\xfc\x28\xcd\x52\x23\x85\x99\xd3\xa9\xf3\xbf\x9d\x9f\x67\x3f\x9c\xe4\x07\x32\xff\x10\x86\xd3\xb2\x17\x14\x67\xfd\xec\x99\x71\x64\x49\x61\x70\x44\xb2\xe7\xf2\xe1\x05\x74\xa3\x6c\xf1\x9b\x16\x16\x5d\xdd\x91\xda\x1c\xbc\xb3\xcf\xff\xfa\xfd\xf7\xa7\xc2\x6a\x6d\x71\xe9\x6d\x2b\x53\x4c\x2c\x47\x4d\xee\xb8\x3a\xd2\x4c\x76\x68\xfe\x99\xf9\xb7\x4c\x72\xd7\x4a\xb3\xa0\xe6\x8e\xb4\x34\x32\xed\x68\x35\x75\x58\x3a\xdc\xde\xae\x14\x4d\x1c\x45\x9b\x3c\x76\x81\x29\x5b\xee\x18\xc9\xa4\x59\x71\x84\x46\x73\x18\x63\xc5\x96\x8d\x25\x72\xa4\xca\xc9\x21\x21\x52\x7d\x61\x80\xa6\x1a\xe0\x42\x63\x69\x95\x5e\x27\xd9\x51\x5d\xfb\x65\xd7\x6b\x1b\xfd\x72\xed\x95\x75\x89\x7d\xaa\xba\x83\x92\xcb\x21\x49\x08\x10\x15\xa8\x6f\x4b\x73\x5c\x87\xaf\x61\x0e\x09\xdd\x94\x9a\xb9\xda\x6f\xc1\x5f\x41\xfd\xc6\x13\xde\x5e\x5a\x3c\xe3\xcf\x4c\x92\x03\xef\x7c\x32\xa1\xc9\x38\x07\xb6\x58\x14\x63\x94\xaa\xcd\xf2\x30\xcb\x11\x84\x52\x09\x69\x82\x71\xe4\xa7\xda\x7e\xd8\x25\xfc\x2d\x5d\x41\x9e\x09\x7e\xd8\x80\x77\x72\x23\x77\xb7\x53\x12\x0f\x65\x28\x43\x60\xba\x0e\xc0\xbf\x72\x6d\xcf\xb7\x47\x3c\xdb\x03\xef\xac\x04\xb2\xf6\xbb\x94\x14\x47\x2c\xdc\x30\x3d\x27\x2b\xef\xf1\xbf\x4b\xa1\x91\x1f\x46\x30\x8b\x43\x5a\x96\x2d\x8f\x37\x6e\xc8\x3d\xe4\x7e\xe8\x24\x4c\x67\xe7\x18\xa2\x5d\x10\xc3\x30\xe0\x9e\x9d\x05\x88\x94\x84\x8f\x83\x56\xda\x7d\x9f\x29\x63\x9d\x76\x3f\x94\x3e\xd2\x81\xe0\x65\x59\xd5\x7e\x24\xc9\xa0\x2b\x32\x62\x3d\x51\xf5\xca\x8f\xb4\x8a\x1d\xcf\x66\x3f\xf4\x82\xdf\xf5\xed\xde\xfb\xc0\x6f\x50\x65\x12\xe9\x79\x76\xf4\x07\x1f\x8a\xef\xef\x87\x53\x74\x11\xba\x47\xcf\xf6\xca\x14\x3f\xf9\xb5\xd4\xf3\xd1\x45\xc7\x47\xcf\x9f\xf7\xd2\x0f\xb5\x87\x1d\xa7\xd7\xaf\x25\xbd\x57\x12\x1f\xac\xd0\x4a\xfa\x20\x3d\x31\x01\x3f\xf2\x32\xa2\xa4\x41\x69\x96\xc6\xd5\xef\x4e\xa3\xa1\x86\x88\x9c\x12\x2b\x14\x77\x48\x80\xf9\xd4\x07\x6a\xb7\x99\xed\xbd\x28\x50\x67\x23\x7d\xa6\x78\x87\xeb\xa9\x6b\xc9\x4f\xb7\x39\x1f\xd2\x3c\x8e\x7a\x14\xd0\xbd\x74\x16\xfb\xcd\xcf\x8d\x40\x34\xfd\x64\x90\x7e\xfc\x34\x5d\xdb\xae\xe3\x2b\x9d\x91\x13\x85\xac\x54\x1f\x80\xf9\x94\x52\x21\x25\xe3\x59\x1c\xda\xc7\x4e\x4b\xf0\xce\x95\xa2\x71\x47\xc8\xe1\x71\xd4\x9a\xda\x50\xa7\xfa\xf8\x89\x0c\xdd\x98\x9a\x9a\x87\x7b\x17\x2f\x6e\xf1\xfe\xc6\xd4\x23\x7a\xa9\xd8\x0e\xac\x29\x51\x3c\x89\xfe\xca\x9a\x37\x9c\x6b\x34\x26\x25\x14\x64\xba\x43\x9e\x66\x45\xd8\xc8\x32\x1a\xcd\xb7\x57\xcc\x77\x46\xbc\x38\xda\xb1\x33\x46\x53\x6a\xb1\xa0\xb7\x98\xb4\xdd\xa3\x06\xf7\x9b\xed\x4b\xd3\x5c\x2f\x8c\x11\x4a\xde\x98\x3a\x25\x30\x4e\x45\xf9\x9b\xb0\xb3\x3b\x8d\x65\xfa\x2a\x87\x57\x59\x0e\x47\x76\x5e\x3f\xba\xf3\x2a\x87\xd7\x1d\x5e\xfa\xd8\xc4\x51\x85\x48\x8e\xa1\x3f\x5f\x90\x96\x89\xe5\x97\x88\xe9\xeb\x73\xfa\xc9\xe2\x38\x1a\x0c\xe0\xc3\x36\xf5\x1c\x05\x8b\xcf\xa8\xbb\x34\xa2\x71\xdf\xcd\xb6\xc0\x34\x86\xf4\x72\x13\xd7\x6e\xcb\x90\xcb\x76\x8a\x1a\xce\x8b\x38\x22\x11\x66\x97\x1a\x73\x58\xf8\x04\xd8\x86\x75\x22\x6a\x99\x3e\x4c\x2a\x07\x6d\x62\x39\x6d\xbf\x5d\x5b\x7a\x91\x08\x69\x76\xee\xfe\x55\x88\x39\x50\x84\x9d\x13\x4f\x4d\x8a\xc8\xae\x0e\x2e\xfe\x61\x95\x76\x5a\x9c\xc2\x8f\x9f\x76\x0d\x3b\xd0\x94\x34\xf4\x1b\x75\x71\xa6\x77\xe1\x70\x0d\x5a\xed\xe5\x86\xb0\xbd\x67\x98\x0e\x1c\xb6\x2d\x3f\xd3\xbb\x77\x71\xc3\xb4\x99\xb1\xe6\xe7\xc9\x3f\x6f\xaf\x25\x47\x69\xa9\xea\x72\xb0\xab\x2c\xde\xc4\xff\x1f\x00\x62\xb7\x45\x53\xfd\x13\x00\x00"),
+		},
+		"/staking/cmd/{{ .Name }}d/main.go.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "main.go.tmpl",
+			modTime:          time.Date(2026, 10, 19, 10, 57, 14, 817055309, time.UTC),
+			uncompressedSize: 5071,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x58\x5f\x73\xdb\xb8\x11\x7f\x06\x3f\xc5\x96\xed\xb5\xe4\x0d\x4d\x5d\xae\x6d\xda\x51\xc7\x0f\x8a\xe5\xe4\xdc\x9e\x7c\x9e\x28\x6d\x1f\xd2\x4c\x0e\x02\x96\x12\x2a\x12\xe0\x00\x90\x2d\xd5\xa3\xef\xde\x59\x10\xa4\x25\xc5\x89\x6d\x3d\x48\x24\xb0\xd8\xff\xfb\xdb\x85\x5a\x2e\xd6\x7c\x89\xd0\x70\xa5\x93\x44\x35\xad\xb1\x1e\xb2\x84\xa5\xa8\x85\x91\x4a\x2f\x47\xff\x75\x46\xa7\x09\x4b\xab\xc6\xd3\x8f\x32\xf4\x6d\x5c\x9a\x24\x8c\xb7\x2d\xa4\xf7\xf7\x50\xbe\x33\x37\xeb\x25\xec\xf7\xb4\xb5\x54\x7e\xb5\x59\x94\xc2\x34\x23\x61\x5c\x63\x5c\xfc\x39\x73\x72\x3d\x5a\x70\x87\xbc\x6d\x9f\xa4\x13\xb5\x42\x4d\xf2\x96\x5c\xf1\x2b\xad\x3c\x3c\x41\xdf\xc8\x11\x91\x8e\x94\x56\xfe\x69\xee\x46\xa2\x78\x9a\xca\xee\x5a\x6f\x46\x6b\xdc\xb9\x27\x69\x1d\xda\x5b\xb4\x69\xc2\x9c\x5c\x3f\xa1\xaa\xdf\xb5\x78\xca\xd0\xb5\xd5\xab\x3f\x8e\x84\x59\x58\xfe\xe8\xce\xad\x6a\x03\x77\xbe\x10\xea\x88\xbd\x47\x2d\xd1\x36\x4a\xfb\xc3\x47\x22\x7b\x54\xce\xe3\xe4\xb5\x5a\xb8\x91\xa8\xd5\x0b\x88\x4d\xd3\x84\xac\x90\x8b\xe6\x19\xfa\x04\x01\x72\xf1\x7c\xfe\xb5\x59\xa6\x09\xf3\x4d\xb0\xe1\x19\x02\xa2\xad\x79\x92\x08\xa3\x5d\x48\xe0\xd1\x08\x96\xa8\xd1\x29\x37\xdf\xb4\x6d\xbd\x03\xe5\xc0\xaf\x10\x78\x63\x36\xda\x83\xa9\x40\x18\xa5\x1d\x3d\x84\x65\x21\xc2\xba\xb0\xc8\x3d\x4a\x58\xec\x80\x52\xa9\x4c\xd8\x31\x97\x73\x78\xf5\xc3\xf0\x49\xd8\x68\x04\x12\x2b\xbe\xa9\xfd\xdc\xf3\x35\x7e\x4d\x88\xc4\x1a\x97\x3d\xdf\xc8\x10\xbc\xe5\xda\x71\xe1\x95\xd1\xae\x4c\xd8\x11\x9f\x03\x31\x49\x9e\x24\xa3\x11\x4c\xbb\xed\x6b\x23\xf1\x27\xd3\x20\x54\x6a\xdb\x60\x72\xcb\xed\x17\x3b\xe7\x60\x5c\x79\xb9\x6d\xb9\x96\x97\xfa\x36\x4b\x7f\xf7\xd3\x2f\xb3\xcb\x51\x49\x75\x7a\xcd\x1b\x84\xfd\x5e\xa6\x47\x3c\x2f\x7e\xbe\x0a\x07\xa3\xf2\x2b\x7a\x96\xca\xa2\xf0\xc6\xee\xc8\x88\x83\xa3\xa2\x56\x05\xdc\xad\xd0\x22\xb9\xd7\x6f\xa1\x52\x5a\x3a\x62\xa6\xbc\x03\xaa\x95\xf2\x50\xa9\x9e\xf5\xd3\x3a\x51\xfe\xe5\x49\x52\x6d\xb4\x08\x60\x94\xe5\x70\x1f\x10\xa6\x9c\xa3\xbf\x30\xba\x52\xcb\x2c\x4f\x98\x90\x02\xc6\xe7\x40\xeb\x33\xbe\xc6\x0b\xaa\xe4\xb0\xee\xb7\xb4\xde\xd5\x61\x79\x8d\x77\xbd\x02\x46\x7b\xdc\xfa\x40\x42\xe5\x55\x5e\x6a\xbe\xa8\xf1\xc2\x34\x0d\xd7\x72\x6e\xac\x57\x7a\x09\xe7\x50\xf1\xda\x61\xc2\xac\x31\xfe\xa2\x91\xc4\xea\xf7\xa1\x1c\xcb\x48\x79\x9f\x30\xf6\x4f\x87\x63\x38\xfe\xa4\x07\x16\xc8\xb4\x48\x18\x9b\xaf\x8c\xf5\xe3\xaf\x12\xc1\xa4\x6d\x61\xca\xb1\x31\x1a\xb2\x4e\xdb\x3c\x9c\xbb\x41\xeb\x94\xf3\xa8\xfd\x8d\xc5\xf7\x1b\x7d\x39\xee\x8d\xf9\x62\xe7\xad\xce\x84\xdf\xe6\x45\xc2\xf6\xc9\xa0\x72\x39\x91\x32\xea\x9a\x51\xe6\x5e\x34\x92\xa8\x0a\x10\x52\xe4\xf9\xa3\x64\x4b\xd4\x1f\xb6\x27\x74\x09\x8b\x52\x1f\xe8\xdc\xb0\x5f\x40\xe4\x52\xf4\xba\x4d\xda\x96\xd0\xf9\x7e\x4f\x26\x68\xbc\x9b\xb4\x6d\x01\xb8\xa5\x2e\x32\x69\xdb\xb9\xe7\x1e\x27\x5a\x7e\x98\xfd\x8b\xd7\x4a\x72\x6f\xac\xcb\x93\x50\x35\xad\xc5\x96\x5b\x04\xae\x25\x70\x29\xa1\xaa\xf9\xd2\x25\x0c\xb7\x28\x36\xde\x58\x0a\x80\xa8\x55\x79\xd3\x91\xbd\xe1\x0e\x49\xd1\x41\x7c\x3a\x9b\xa4\xc5\x69\xe6\xe7\x09\x43\x1b\x8e\xf6\x6c\xca\xcb\xf0\x80\x14\x7e\x55\x01\xed\xfe\xe6\x1c\xb4\xaa\x29\xb7\x48\x8d\x15\xd7\xb2\x46\xb8\x53\x7e\x05\xbf\xfd\xeb\x5f\x7e\x48\x18\x6b\xb9\x56\x22\x43\x6b\x73\xf2\xef\x3e\x14\x4a\x74\x68\x80\x04\xc5\x6b\xf5\x3f\xec\x4a\xa5\x2f\xe5\x4a\xd5\x58\x84\x95\xdb\xde\xd0\x60\x19\xad\x68\x23\x91\x0a\xa3\x24\x46\x1f\x4e\x0e\x41\xb5\xd1\xd2\x01\x07\x8d\x77\x3d\x06\x51\x7d\x19\x87\x8f\xe1\x04\x34\x7c\xdd\x89\x26\x66\x81\x33\x89\xa8\x94\x75\xfe\x40\x74\xc4\x34\xb1\xe2\x4a\x97\x41\xa6\x43\x61\xd1\x9f\x82\x9d\x0a\x85\xdb\x5a\xa5\x3d\xca\xb2\xab\xbd\x83\xdc\x81\xef\x63\x94\x63\x11\x85\x1c\x80\xef\x05\xd5\x5c\x19\x2a\x2f\x87\xef\x8f\xaa\x84\xdc\x2a\x9e\x28\x9f\x94\x24\x1c\x96\x4a\x7a\x35\x78\x75\xb0\x59\x84\x82\x2f\xa0\xb5\xea\xf6\xec\xc1\xb0\xce\xcf\x24\xa8\xfd\xb1\x3d\x0b\xf6\xd3\x52\xe0\x36\xb1\x4b\x37\x06\xe8\xf4\xb9\x36\xf4\x4a\xcb\x5d\x29\x91\x69\xd9\xe7\x13\x6d\x0b\xf8\x0c\x1f\x3f\x39\x6f\x95\x5e\xe6\x94\x1c\xc6\x92\x01\x8c\x75\xd2\xc9\x0a\xe1\xb7\x65\x07\x3e\x0f\xeb\x04\x48\xef\x8d\xf1\x59\xe8\xca\xe5\x3b\xf4\xf3\xc0\x22\xa3\x84\xa5\x3c\x7c\x5b\xf3\x25\x95\x1c\x63\x21\x02\x57\x53\xe2\xf4\x08\x31\x6a\x5f\x12\xed\x45\x47\x15\x4e\xa8\x0a\xfa\x43\xe7\xe7\x90\xa6\x9d\x42\x03\xa3\x73\xa8\x1a\x5f\xce\x43\xc8\xaa\x2c\xf5\xe8\xfc\x59\xd8\x3b\xfb\xee\x36\x2d\xa0\xeb\xcb\xe5\x7b\x82\x36\x6f\xb3\xd7\x9d\x16\x7b\xfa\x6a\x8c\x56\x6b\x0c\xd5\x31\xf8\x73\xd6\xad\x65\x9d\x5d\x54\x99\x8c\x91\x53\xaf\xa6\x05\x65\xd3\xcd\x66\xf1\x0f\xdc\x15\x10\x8b\xaa\x1f\xc5\xca\x87\x78\x51\xe5\x0d\xa5\xfd\x56\xd5\xe8\x06\x5e\x8c\x3d\x52\x71\x8c\x59\xf4\x1b\xab\x89\x25\xbd\x12\x7e\x31\x16\xeb\x62\x8d\xbb\xd3\x04\x35\xba\xde\x41\xad\x6e\xd1\x81\xd2\xd0\x60\x63\xec\x6e\x1c\x3a\x4d\x4c\x68\xe5\x22\x83\x98\xc4\xe0\x0d\x58\x14\xe6\x16\x2d\x84\xee\x1d\x82\xe6\x3c\x68\xde\x60\x01\x2d\x77\xae\x5d\x59\xee\xa8\x23\xa5\x83\x1f\xd2\xe2\xf0\x85\x38\xae\x17\x64\x71\x68\x67\xd7\x78\x97\xc9\x45\x43\x3d\x65\x86\xcd\xf4\x4d\xd6\x39\x55\xe9\xca\x14\x51\x8d\xc1\x45\xeb\x45\x79\x11\x06\x88\x99\x26\x84\x57\x22\xeb\xe4\x06\x46\x97\x7a\x59\x2b\xb7\x3a\xd4\x22\xee\xcc\x51\xb4\x3f\xfe\xf9\xf5\xfa\xd5\xb3\xdd\xc6\x18\xe3\x52\x06\x99\x4e\xae\xcb\x89\x10\x13\x29\x2d\x3a\x97\x91\x5e\x94\x93\x5d\xf0\xb2\xbc\xec\x37\x02\xb8\x33\x1a\x67\x3e\x6c\x07\x85\x35\xde\xbd\xa3\x85\x2c\xa0\xfb\x7a\x51\x7c\xe1\xa8\xa2\xcf\xc7\x02\x62\x0a\x1d\xe5\x06\x49\xbf\xc6\xbb\x2b\xed\x5f\xff\xe9\xc2\x28\x9d\x51\x67\x9e\xa2\x36\x4d\x71\x34\x19\xe5\x2f\x31\x2d\xa2\x40\x68\x1f\x7d\xb7\x7f\x68\xe9\xef\x0e\x76\xb3\xfc\x94\x9e\x7c\x41\xb9\xe3\xe0\x1c\x3e\x7e\xa2\x93\x91\x3e\xae\x77\x42\xbb\x6f\x16\x7d\x33\xa6\x16\x64\x09\x2d\x18\x63\x64\x05\x01\x49\x30\x2d\xbc\xdc\x7f\xcb\xc8\x5e\x76\x18\x0f\xf3\xd0\x07\x19\xdb\x17\x8f\xda\x52\x06\x5f\x77\x9a\xd1\xb5\xaa\x7c\xcf\xef\x66\xe8\x1c\x5f\xe2\x7d\x88\x4b\x38\xc1\x63\xe3\x1c\x82\xd4\x01\xee\x8c\x5b\xb7\xe2\xf5\xdf\xe7\xbf\x5c\x5f\x69\x89\xda\x77\x31\x3b\xe4\xff\x6c\x1f\x47\x31\x51\xf6\x83\x20\x79\x24\x26\x6b\x78\xfb\xb1\x83\xc7\x88\x92\xf7\x69\x97\xf0\xe9\x38\x66\xfe\xfe\x25\x61\xf5\xe6\x86\xaa\x94\x22\xea\xbc\xdd\x08\x1f\x49\x23\xfe\xd1\xa8\xd4\x89\xe9\x07\x27\x80\x5f\xc9\x4d\xe3\x34\xa4\xe0\x67\x25\xd3\x5f\xc3\x01\x82\x9d\xab\x29\x7c\xe3\x00\xa1\xd8\x03\xfd\x64\x30\x15\x4e\xdc\xde\xd3\xf3\xb6\xfd\xdc\x74\xde\xe8\x64\xec\x8f\x70\x37\x84\xb3\x87\xc6\x84\x1d\x7b\x6f\xb0\xcf\x6c\xfc\xf3\x62\x16\x1d\xf1\x12\xdf\x11\xec\xbf\x8d\xb0\x6f\x5c\x39\xf7\x12\xad\x2d\x20\xfd\xce\xfd\x47\xa7\x45\x74\x43\x66\x36\x3e\xcf\x0f\x31\x75\x40\x35\x07\x34\x6d\x1d\xdc\x6e\xfc\xea\xd1\x09\x83\x6e\x22\xa1\xa0\xa6\x26\x0c\xda\xf1\x0e\xd6\x17\xd1\xd4\x88\xa3\x98\x8d\x61\x80\x87\xde\xd3\x21\x77\xc7\x30\x64\xf1\x60\x42\xb4\x74\x7c\x4e\x5d\x7e\x6a\x44\x19\x3b\x07\x4d\x88\x17\xa6\x69\x6b\xf4\x98\xe5\x7f\x7b\x96\x3b\xe2\x52\x64\x34\xe7\xb7\x38\xe9\x5b\x4f\xaf\x2a\xb5\xa3\x0e\xab\xf7\x71\x52\x16\x8d\x0c\xfd\xd6\x65\x79\xf9\x48\xcb\xfe\x62\x9e\x2c\x20\xa5\x90\xff\xc1\x9d\x5c\x89\xd2\xfc\x6b\xbc\x4e\x3a\x7a\x01\x29\xb5\x96\xde\xcd\x34\xaa\x74\xee\x3a\x53\xb2\x00\x55\x41\x8d\x95\x87\x45\xcd\xf5\x1a\xee\x54\x5d\xc3\x02\xc1\x72\x2d\x4d\x53\xef\xfa\x58\x7d\x45\x18\xcd\xcb\xb1\x7d\x47\x29\x04\xdc\x7d\xff\x1c\xc2\xde\x0d\xa5\x7d\xef\x8f\xbb\x64\x14\x5d\x44\x23\x3e\x93\x84\xe8\x4f\xd1\xc8\x64\x1f\x2f\x61\xdd\x34\x9f\xd5\x66\xb9\x44\x0b\xb5\x59\x96\x3f\x87\xc7\x02\xe4\x02\xa8\x21\x4e\xdf\x14\x74\x87\x15\x38\xf7\xc6\x22\x28\x53\xfe\xdb\x2a\x8f\x36\x07\xfa\xff\xa1\x9c\xb4\x6d\xad\x04\xa7\xeb\x2d\xdc\x0f\x12\x22\x94\xcf\x76\x0f\xbc\x89\x21\x15\x58\xfc\x6f\x88\xc6\xab\x1b\xbb\xd1\xe4\xd2\xd3\xa1\x29\x6d\xbb\x8d\x34\xcf\x4f\x4e\xcc\x94\x56\xcd\xa6\x79\x8b\xe8\xbe\x3c\xd5\x74\x9b\x9f\x2b\x44\xd7\x1d\xcd\x07\x33\xbf\x79\x59\xc9\x12\xf6\x72\xfb\x8b\x24\x87\xec\x04\x67\x0a\xf8\xf8\xe9\xa4\x94\x06\x29\x01\x33\x8c\xcd\x9f\xf6\x52\x5e\x5e\x9e\x6a\x7b\xa0\x6b\x9e\xec\x93\xff\x0f\x00\xf9\x63\x44\x50\xcf\x13\x00\x00"),
+		},
+		"/staking/genesis.go.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "genesis.go.tmpl",
+			modTime:          time.Date(2026, 10, 19, 10, 53, 39, 551317957, time.UTC),
+			uncompressedSize: 3916,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x56\x51\x6f\xdb\xb6\x13\x7f\x96\x3e\xc5\x55\x40\xfb\x97\x0a\xff\x95\x16\x18\xf6\xe0\xc2\x03\xd2\x7a\x2b\x3a\x20\xe9\xb0\x64\x7b\x58\x10\x34\x0c\x75\x96\x39\x4b\xa4\x4b\x52\x8e\x0b\xc3\xdf\x7d\x38\x8a\x94\x25\x5b\x6e\xd3\x87\xc6\x3a\xde\xfd\xee\xee\x77\xc7\x3b\xae\x19\x5f\xb1\x12\x81\xad\xd7\x71\x2c\xea\xb5\xd2\x16\xd2\x38\x4a\x50\x72\x55\x08\x59\x5e\xfc\x6b\x94\x4c\x48\xa0\xb5\xd2\x86\x7e\x2d\x6a\x9b\xc4\x71\x94\x94\xc2\x2e\x9b\xc7\x9c\xab\xfa\x82\x2b\x53\x2b\xe3\xff\xfc\xdf\x14\xab\x0b\xae\x0a\xe4\x49\x1c\x99\x62\x05\xdf\xd7\xb4\xdf\xd6\x68\x92\x1f\xe1\x6d\x2f\x58\x63\x97\x49\x1c\x15\xc2\x58\xfd\x03\xc8\xed\x85\xd3\x12\x8f\x8d\x15\x4a\x3e\x03\xbb\x54\x9b\x67\x68\xd5\x42\xda\x67\xa8\x99\x8a\x99\xa5\x90\xe5\x73\x54\x2d\x5b\x61\x12\x67\x71\x7c\x71\x01\x1f\x51\xa2\x11\xe6\xc6\x32\x8b\x20\x0c\xd8\x25\x82\x71\x1f\x6a\xe1\x3e\xd8\x7a\x5d\x09\xce\x28\x27\x10\xd2\x89\xca\xd6\x06\x16\xa2\xc2\x3c\x26\x2a\x87\x30\xc6\xea\x86\x5b\xd8\xc5\xd1\x25\xe7\xaa\x91\xd6\x00\xfd\xbb\xbb\xf7\x5a\x5e\x0a\x4e\xfa\x40\xb5\x9e\x26\xcc\x6b\x26\x0f\x71\x74\xd9\xd8\xe5\x9c\x59\x46\xc7\x40\x15\xc8\x07\xf0\x7d\x2b\x2a\xcf\x43\x1c\xdd\x50\x4a\xc1\xc4\xd0\xc7\x89\x89\xb7\x70\x87\xe4\xe4\x4a\x48\xdb\x39\x21\x92\xcf\x3a\xa1\x43\xb2\x98\x53\x79\x83\x89\xab\xf5\x39\x27\xee\x90\x4c\x3e\xaa\x4d\xe7\x03\x4a\xb5\x39\xf5\x11\x4c\xa8\x19\x28\x11\x5f\x46\x67\x15\x6a\x3a\xb4\xf2\x06\x5d\xc1\x1f\xe2\xa8\xad\xe3\xed\xd6\x00\xd3\x38\x28\x91\xd5\x4c\x1a\xc6\xa9\x7a\x06\xb8\x46\x66\x85\x2c\x9d\xc6\x86\x55\xa2\x60\x56\x69\x03\x6a\x01\x0c\x24\x3e\x39\x20\xbe\x64\x42\xe6\x71\xe4\x01\xef\xee\xc9\x5d\xfe\x27\x7b\xba\x42\x63\x58\xd9\xf9\x2f\x51\xda\xad\x49\x1e\xe2\x7d\xbf\x8f\x42\x69\x85\x01\x26\xc1\x17\x15\xd4\x62\x10\xd4\x69\xdf\x04\xb3\x5e\xe7\x14\x85\x46\xd3\x36\x0e\x80\x29\x56\xf9\x25\xe7\x41\xe8\x43\x60\xed\x27\x11\xfd\x41\x09\x19\x94\x5b\xf5\x9e\xc4\xab\x73\x92\x90\xf2\x0d\x7e\x6d\x50\x72\x5f\x00\x21\xed\xcf\x3f\x05\xcb\x4e\xd9\x78\x1d\xd2\xf7\xd1\x5d\x37\xf5\x23\xea\x33\xfa\x3e\xd5\x2f\xd2\x29\x75\xb4\x5c\xe3\xd3\x51\x8a\x1a\x6d\xa3\xa5\x19\x10\xd2\xe3\x89\x71\x9e\xc7\x8b\x46\xf2\x53\xd3\x94\x71\xde\x5e\x07\x2f\xc8\x8e\xe9\xdb\xc5\x51\x0b\x7f\x74\xb0\x8b\xa3\x40\xe8\xd4\x87\x4d\x7e\x3e\xa2\xf5\xd2\x34\x9b\xc4\x51\xe4\x28\x0b\x0a\x9d\x8a\x93\xb6\x0a\x1e\xae\x25\x62\x1a\x14\x06\xd2\x56\x31\x30\x3c\x1d\x20\x05\xa9\xd3\xd9\x7b\x86\x6e\x95\xb7\x07\xae\xe4\x06\xb5\x1d\xa7\xc6\xaa\x5e\x43\x79\x86\xd2\x92\xc1\xeb\x61\xa6\xd9\x01\x2f\xcd\xe0\xb5\x63\xeb\x3d\x33\x78\xca\xd0\xab\xe3\xb3\x11\x92\x4a\x96\x7b\xc9\x08\x3d\x25\xcb\x9d\x24\xbf\x51\xda\x8e\xf2\x43\xe6\x7d\xc9\x08\x33\x25\xcb\x83\xa4\xc7\xc9\x35\x3e\xcd\x71\xc1\x9a\xca\xfa\xec\xda\x9b\x3f\xd6\x3a\xdd\xac\x76\x37\xb8\xbd\xbd\x13\x98\xa3\x54\x35\x5d\xcb\x47\xa4\xfb\xee\x86\x5e\x31\x01\x9a\x64\x58\x00\x93\x05\x14\xb8\x56\x46\x58\x2c\x40\x49\x58\x6b\xb5\x56\x86\x55\xe6\xd0\x7a\x23\xfe\xd3\xae\xdf\xdc\x27\xdd\x52\xd3\xcd\xdd\xe9\xcc\xcf\xdd\x51\xc3\x9e\x66\xfe\x07\xd3\xac\x36\xf9\x7b\x25\x0b\x17\x26\xcc\x7c\xb8\x51\x1d\x46\xf2\x74\xe6\x42\x3d\x87\x15\xf4\x02\x94\x1b\xe5\x43\xa8\xd2\x0f\xde\xe9\xcc\x8d\xdd\x33\x40\x5e\x2b\x9f\xb7\x64\x1c\xe0\xbc\x00\x66\x87\x51\xb2\xa3\x5f\xd7\xf8\xf4\x89\xae\x3f\x15\x3e\x75\x51\x4f\xe0\xed\x9b\x6c\x1f\x1f\x5f\x3c\xe7\x62\x77\x68\x09\x33\x1d\x5d\x81\xbb\xbd\xeb\x89\xc0\xcd\xb4\x5b\x5f\xf4\x45\x47\x61\x4b\x4d\xbb\x35\x15\x4e\xba\x6d\x34\x3d\xac\xa3\xd1\x34\x49\xd9\xef\xa1\xd0\x74\xed\x17\x1d\xf4\xf7\xcd\xf4\xb0\x70\xce\x03\xb9\xad\xe0\x71\x4e\x97\xc3\x6e\xdf\xeb\xe2\xbf\xdb\x0d\x83\x7d\x14\xe0\x4b\xe4\xab\x91\x16\x9e\x86\xbb\x6d\xa0\x6e\x8c\x85\x47\x84\x46\x8a\xaf\x0d\x4e\xa8\x8d\xa9\x63\x47\x57\x9a\xd3\x45\xc6\x97\xed\x72\x43\x60\x87\xc5\xe6\x9b\x79\x2c\x8c\x94\x17\x1c\x5e\xbb\xe7\x62\xfe\x81\xfe\x9f\x04\x78\x97\xeb\xa0\x8a\x19\xb8\x57\xa8\x6b\x77\x44\xe9\x9a\x93\xad\xef\xe8\xa9\x27\xcb\xfb\x47\xa5\xaa\xdd\x3e\x8e\x16\x4a\xc3\x97\x09\x25\x41\x0a\x9a\xc9\xb2\x4b\xd0\xa1\x84\x49\x60\x08\x27\x12\x0b\x30\x88\xf2\x8e\x26\xa8\x9f\x30\xf9\x8d\x03\x4c\xb3\x7b\xa7\x11\xfa\x69\x51\xdb\xfc\x57\xf2\xbf\x48\x93\xa2\x69\x9f\x62\x18\xb8\x82\x97\xe6\xf8\x4d\xe6\xc8\x4c\x26\xd0\x43\xce\xe2\x28\xda\xc7\x51\xf4\x1d\x8f\x33\xb0\xba\x41\x1a\x40\x6d\x26\xc2\xf1\x71\xbb\x3d\x93\x8b\x7f\x1b\x50\x9c\x1b\xa6\xc1\x6e\xdb\xb5\x74\x63\x8b\xdb\x6d\x9b\x1d\x6a\x4d\xb6\xbc\xe0\xf9\x5f\xb2\x66\xda\x2c\x59\xf5\xfb\xcd\xe7\xeb\xd4\xc1\x4e\xe0\x95\xdd\x66\xef\x88\x58\x78\x31\x03\x29\xaa\xb3\x39\x0b\xe9\xea\x39\x56\x7d\x78\x59\x4c\xe1\xe5\x26\x99\x80\x98\x10\x54\xc8\xb3\x36\xa5\x21\xe7\x76\x4b\x4b\xe7\xca\x94\x26\xa5\x23\xb1\x80\x0a\x65\x4a\xa7\x19\x79\x7d\x3b\xf0\xe9\x6a\x6c\xf2\x6b\x7c\x4a\x93\xf3\x9d\xb6\x64\x1b\x04\xdc\x32\x6e\xab\x6f\xa0\x24\xc2\x95\x29\x3f\xb8\xc6\xf3\x5d\xa6\x34\xd4\xed\x3b\x29\x09\xe1\x88\x05\x35\x86\x5a\x51\x48\xe4\xfc\xee\xcd\x7d\x9e\xba\x71\x98\x9f\x5a\x67\xef\xe0\x85\x5a\x9d\x65\x63\x9c\x05\x28\x14\x1a\xf9\x3f\x3b\x72\x07\x88\x1c\x1f\x08\xd5\x96\xf6\xed\xf8\xdb\xaf\xdd\x1c\xee\xfd\xd8\xa2\x14\xf0\xf8\x6d\xd0\x59\x7d\x36\xf2\x38\xd0\x39\xd2\x17\x19\xfc\x02\x6f\x5c\x0a\x3e\x03\x29\x2a\x6a\xad\x90\x50\x9b\xfb\xd1\xb5\x1c\x02\x75\xef\xf9\xcc\xcf\x92\x4a\x29\x83\xb7\x6a\x85\xd2\x0c\xd6\x20\x61\xb9\x17\x6d\x7b\xb4\xc4\xea\x24\xee\x30\x57\xfc\x38\xe8\x21\xa5\xe1\xe8\x64\x2e\x4f\xa0\xa0\xe1\x0e\xed\x25\xcf\xdc\x16\x98\x23\xa7\xa4\xbc\x27\xda\x77\xc5\x2a\xff\x07\xb5\x9a\x23\x4f\xb3\xf1\x09\xd0\x39\x20\x36\xbc\xe5\xcc\x07\x4b\xd7\x30\xf5\x4b\x65\x8e\xfc\x37\xad\xea\x4f\xd2\x52\x4c\xfe\x5d\x71\x59\x53\x70\x9f\x17\xa9\x8b\x25\xcb\xb2\x3e\x89\x56\xad\x50\x9a\x78\x1f\xff\x37\x00\xc7\x5f\x62\xef\x4c\x0f\x00\x00"),
+		},
+		"/staking/hooks.go.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "hooks.go.tmpl",
+			modTime:          time.Date(2026, 10, 19, 10, 53, 18, 865470511, time.UTC),
+			uncompressedSize: 2366,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x94\x4f\x8f\x9b\x30\x10\xc5\xcf\xf8\x53\x58\x39\x05\x69\x0b\x9f\xa0\x87\x6d\xf6\xd0\x4b\xff\xa8\xab\xee\xb5\xf2\xe2\x09\xb6\x00\x0f\xf2\x0c\x49\xaa\x88\xef\x5e\x41\xd2\x04\x37\xd9\xc5\xab\x6e\x4e\x09\xe6\xbd\x37\xef\x87\xa5\x69\x55\x51\xa9\x12\xa4\x6a\x5b\x21\x6c\xd3\xa2\x67\xb9\x14\x09\xe9\x4a\x2e\x4a\xcb\xa6\x7b\xce\x0a\x6c\xf2\x02\xa9\x41\x3a\xfe\x7c\x20\x5d\xe5\xfc\xbb\x05\x5a\x88\x44\x5b\x62\x3f\xa3\xdd\xe5\xa3\xca\x3e\x77\x6c\xd1\x2d\x44\x32\x27\xa7\x5a\x91\xb1\xae\x5c\x88\x54\x88\x3c\x97\x8f\xac\x2a\xeb\xca\xcf\x88\x15\xc9\x35\xfa\xad\xf2\x9a\x24\x1b\x90\xb0\x01\xc7\x24\x71\x3d\x3e\x11\xab\x0a\x64\x83\xba\xab\x41\x32\x8e\x67\xd3\xd1\x43\x96\x72\x5a\xfe\xcd\x3f\x4a\x29\x13\x03\x4e\x38\x86\xd8\x77\x05\xcb\xbd\x48\xb4\x39\x84\x64\xe3\x0b\x91\x90\x39\x05\x1c\x8f\xfa\xb1\xe5\x57\xd8\x86\x45\xed\xae\x01\xb1\xee\x5c\xf1\xef\xab\x65\x18\x79\x27\x2f\x22\xd3\xb0\xcc\x5e\x24\x1e\xb8\xf3\x2e\x38\xde\x6b\x33\x58\x7b\xd1\x0b\xb1\x51\x5e\xfe\x92\xa4\xab\x2c\x30\x7e\x0c\x0d\x87\xa2\x0e\x6b\xeb\xf8\xd0\x6c\x69\x02\x45\x2a\xbf\xb9\x27\x55\x5b\xad\x18\xfd\xca\x83\x62\xd0\xcb\x82\x77\x63\xf2\x0a\x1d\xc3\x8e\xef\xe4\x46\xd5\xf7\x5a\xfb\xf1\xf0\xe9\xf0\x1f\x88\xd2\xe1\x63\x99\x4c\x9b\xec\x7a\xc6\xc9\x97\x0e\x32\x8a\x90\xf5\xf3\x1d\xbf\xa0\xb6\x6b\xfb\x9f\x25\xa7\x21\xaf\xb5\x7c\x41\x17\x51\xf3\x07\x34\xb8\xb9\xd6\xb2\x40\x47\xa7\x9a\xab\xe3\x03\x10\xbd\xa9\xff\x24\xfd\x9c\xf8\x1a\xc8\x9c\x21\x82\xe8\x13\x3a\x7d\x33\xa0\x73\x78\x1c\xcf\x8c\x3e\x02\xe7\x3b\x6e\xc1\x3f\x58\xbd\x32\xca\x95\x70\x23\xac\xcb\x21\x71\x78\x91\xbe\x98\x5b\x83\xd2\xba\x9f\xee\x19\x9d\xb6\xae\xbc\xd5\xed\x5d\x0c\x89\xc3\x8c\xf4\xbd\x8c\xf9\x00\x35\x94\x6a\xd8\xf4\x93\x7d\x12\x02\x6a\x38\x63\xdc\x17\xc5\x1b\xf0\xae\x86\x9f\x02\xaf\x70\x45\x1b\x62\x80\x1e\x8d\xf2\x40\xd3\x05\xf4\xfe\x5c\x97\x33\xe2\xf0\xe2\x7c\x31\x94\x93\xbd\xf4\xfe\x78\xc1\xd2\x8b\xe1\x9a\x31\xf4\xe2\xcf\x00\xa1\xe7\x1a\xb3\x3e\x09\x00\x00"),
+		},
+		"/staking/template.yml": &vfsgen۰CompressedFileInfo{
+			name:             "template.yml",
+			modTime:          time.Date(2026, 10, 19, 10, 48, 32, 875941645, time.UTC),
+			uncompressedSize: 167,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x1c\xcb\x41\x8a\xc3\x30\x0c\x46\xe1\xbd\x4f\xf1\x1f\x20\x13\xc2\x2c\x66\x91\x53\xcc\x0d\x8a\x6c\x2b\x8e\x48\x90\x8b\xa4\xba\xf4\xf6\x25\xd9\x3d\x1e\x7c\x95\xbd\x98\x3c\x43\xba\xae\xf8\xb7\xde\x37\xf4\x0d\x1e\x74\x30\xde\x12\xfb\x9d\xa2\x6d\x82\x9f\xe4\xfb\x5d\x55\x3c\x4c\xf2\xeb\x42\x20\xad\x68\x7d\xb0\x29\x69\xe1\x09\x83\x4e\xa9\x14\xdd\x1c\x99\x45\x1b\x8a\x31\x05\x57\xe4\x0f\x1a\x2b\xbb\x38\xc2\x48\x9d\xca\xe5\x3d\x65\x72\x5e\x91\x49\x8f\xe4\xf5\x78\x0c\x36\xbf\xfe\x9a\x80\x1f\x8c\x65\xfe\xfd\x9b\x97\xf4\x1d\x00\x85\x16\x77\x23\xa7\x00\x00\x00"),
 		},
 	}
 	fs["/"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
-		fs["/.gitignore"].(os.FileInfo),
-		fs["/Dockerfile.tmpl"].(os.FileInfo),
-		fs["/Gopkg.lock"].(os.FileInfo),
-		fs["/Gopkg.toml"].(os.FileInfo),
-		fs["/app.go.tmpl"].(os.FileInfo),
-		fs["/cmd"].(os.FileInfo),
-		fs["/k8s"].(os.FileInfo),
-	}
-	fs["/cmd"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
-		fs["/cmd/{{ .Name }}cli"].(os.FileInfo),
-		fs["/cmd/{{ .Name }}d"].(os.FileInfo),
-	}
-	fs["/cmd/{{ .Name }}cli"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
-		fs["/cmd/{{ .Name }}cli/main.go.tmpl"].(os.FileInfo),
-	}
-	fs["/cmd/{{ .Name }}d"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
-		fs["/cmd/{{ .Name }}d/main.go.tmpl"].(os.FileInfo),
-	}
-	fs["/k8s"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
-		fs["/k8s/.helmignore"].(os.FileInfo),
-		fs["/k8s/Chart.yaml"].(os.FileInfo),
-		fs["/k8s/templates"].(os.FileInfo),
-		fs["/k8s/values.yaml"].(os.FileInfo),
-	}
-	fs["/k8s/templates"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
-		fs["/k8s/templates/NOTES.txt"].(os.FileInfo),
-		fs["/k8s/templates/_helpers.tpl"].(os.FileInfo),
-		fs["/k8s/templates/configmap.yaml"].(os.FileInfo),
-		fs["/k8s/templates/service.yaml"].(os.FileInfo),
-		fs["/k8s/templates/statefulset.yml"].(os.FileInfo),
-		fs["/k8s/templates/storageclass.yaml"].(os.FileInfo),
+		fs["/bank"].(os.FileInfo),
+		fs["/bare"].(os.FileInfo),
+		fs["/generators"].(os.FileInfo),
+		fs["/module"].(os.FileInfo),
+		fs["/rest"].(os.FileInfo),
+		fs["/staking"].(os.FileInfo),
+	}
+	fs["/bank"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/bank/app.go.tmpl"].(os.FileInfo),
+		fs["/bank/cmd"].(os.FileInfo),
+		fs["/bank/template.yml"].(os.FileInfo),
+	}
+	fs["/bank/cmd"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/bank/cmd/{{ .Name }}cli"].(os.FileInfo),
+	}
+	fs["/bank/cmd/{{ .Name }}cli"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/bank/cmd/{{ .Name }}cli/main.go.tmpl"].(os.FileInfo),
+	}
+	fs["/bare"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/bare/.dockerignore"].(os.FileInfo),
+		fs["/bare/.gitignore"].(os.FileInfo),
+		fs["/bare/Dockerfile.tmpl"].(os.FileInfo),
+		fs["/bare/app.go.tmpl"].(os.FileInfo),
+		fs["/bare/cmd"].(os.FileInfo),
+		fs["/bare/config.go.tmpl"].(os.FileInfo),
+		fs["/bare/go.mod.tmpl"].(os.FileInfo),
+		fs["/bare/go.sum"].(os.FileInfo),
+		fs["/bare/k8s"].(os.FileInfo),
+		fs["/bare/template.yml"].(os.FileInfo),
+	}
+	fs["/bare/cmd"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/bare/cmd/{{ .Name }}cli"].(os.FileInfo),
+		fs["/bare/cmd/{{ .Name }}d"].(os.FileInfo),
+	}
+	fs["/bare/cmd/{{ .Name }}cli"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/bare/cmd/{{ .Name }}cli/main.go.tmpl"].(os.FileInfo),
+	}
+	fs["/bare/cmd/{{ .Name }}d"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/bare/cmd/{{ .Name }}d/main.go.tmpl"].(os.FileInfo),
+	}
+	fs["/bare/k8s"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/bare/k8s/.helmignore"].(os.FileInfo),
+		fs["/bare/k8s/Chart.yaml"].(os.FileInfo),
+		fs["/bare/k8s/templates"].(os.FileInfo),
+		fs["/bare/k8s/values.yaml"].(os.FileInfo),
+	}
+	fs["/bare/k8s/templates"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/bare/k8s/templates/NOTES.txt"].(os.FileInfo),
+		fs["/bare/k8s/templates/_helpers.tpl"].(os.FileInfo),
+		fs["/bare/k8s/templates/configmap.yaml"].(os.FileInfo),
+		fs["/bare/k8s/templates/service.yaml"].(os.FileInfo),
+		fs["/bare/k8s/templates/statefulset.yml"].(os.FileInfo),
+		fs["/bare/k8s/templates/storageclass.yaml"].(os.FileInfo),
+	}
+	fs["/generators"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/generators/message"].(os.FileInfo),
+	}
+	fs["/generators/message"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/generators/message/x"].(os.FileInfo),
+	}
+	fs["/generators/message/x"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/generators/message/x/{{ .Message.Module }}"].(os.FileInfo),
+	}
+	fs["/generators/message/x/{{ .Message.Module }}"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/generators/message/x/{{ .Message.Module }}/client"].(os.FileInfo),
+		fs["/generators/message/x/{{ .Message.Module }}/msg_{{ .Message.Type }}.go.tmpl"].(os.FileInfo),
+		fs["/generators/message/x/{{ .Message.Module }}/msg_{{ .Message.Type }}_test.go.tmpl"].(os.FileInfo),
+	}
+	fs["/generators/message/x/{{ .Message.Module }}/client"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/generators/message/x/{{ .Message.Module }}/client/cli"].(os.FileInfo),
+		fs["/generators/message/x/{{ .Message.Module }}/client/rest"].(os.FileInfo),
+	}
+	fs["/generators/message/x/{{ .Message.Module }}/client/cli"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/generators/message/x/{{ .Message.Module }}/client/cli/tx_{{ .Message.Type }}.go.tmpl"].(os.FileInfo),
+	}
+	fs["/generators/message/x/{{ .Message.Module }}/client/rest"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/generators/message/x/{{ .Message.Module }}/client/rest/tx_{{ .Message.Type }}.go.tmpl"].(os.FileInfo),
+	}
+	fs["/module"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/module/app.go.tmpl"].(os.FileInfo),
+		fs["/module/cmd"].(os.FileInfo),
+		fs["/module/template.yml"].(os.FileInfo),
+		fs["/module/x"].(os.FileInfo),
+	}
+	fs["/module/cmd"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/module/cmd/{{ .Name }}cli"].(os.FileInfo),
+	}
+	fs["/module/cmd/{{ .Name }}cli"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/module/cmd/{{ .Name }}cli/main.go.tmpl"].(os.FileInfo),
+		fs["/module/cmd/{{ .Name }}cli/routes.go.tmpl"].(os.FileInfo),
+	}
+	fs["/module/x"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/module/x/{{ .Vars.module }}"].(os.FileInfo),
+	}
+	fs["/module/x/{{ .Vars.module }}"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/module/x/{{ .Vars.module }}/client"].(os.FileInfo),
+		fs["/module/x/{{ .Vars.module }}/codec.go.tmpl"].(os.FileInfo),
+		fs["/module/x/{{ .Vars.module }}/handler.go.tmpl"].(os.FileInfo),
+		fs["/module/x/{{ .Vars.module }}/keeper.go.tmpl"].(os.FileInfo),
+		fs["/module/x/{{ .Vars.module }}/msgs.go.tmpl"].(os.FileInfo),
+		fs["/module/x/{{ .Vars.module }}/querier.go.tmpl"].(os.FileInfo),
+	}
+	fs["/module/x/{{ .Vars.module }}/client"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/module/x/{{ .Vars.module }}/client/cli"].(os.FileInfo),
+		fs["/module/x/{{ .Vars.module }}/client/rest"].(os.FileInfo),
+	}
+	fs["/module/x/{{ .Vars.module }}/client/cli"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/module/x/{{ .Vars.module }}/client/cli/query.go.tmpl"].(os.FileInfo),
+		fs["/module/x/{{ .Vars.module }}/client/cli/tx.go.tmpl"].(os.FileInfo),
+	}
+	fs["/module/x/{{ .Vars.module }}/client/rest"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/module/x/{{ .Vars.module }}/client/rest/rest.go.tmpl"].(os.FileInfo),
+	}
+	fs["/rest"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/rest/cmd"].(os.FileInfo),
+		fs["/rest/template.yml"].(os.FileInfo),
+	}
+	fs["/rest/cmd"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/rest/cmd/{{ .Name }}cli"].(os.FileInfo),
+	}
+	fs["/rest/cmd/{{ .Name }}cli"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/rest/cmd/{{ .Name }}cli/main.go.tmpl"].(os.FileInfo),
+		fs["/rest/cmd/{{ .Name }}cli/rest.go.tmpl"].(os.FileInfo),
+		fs["/rest/cmd/{{ .Name }}cli/routes.go.tmpl"].(os.FileInfo),
+	}
+	fs["/staking"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/staking/app.go.tmpl"].(os.FileInfo),
+		fs["/staking/cmd"].(os.FileInfo),
+		fs["/staking/genesis.go.tmpl"].(os.FileInfo),
+		fs["/staking/hooks.go.tmpl"].(os.FileInfo),
+		fs["/staking/template.yml"].(os.FileInfo),
+	}
+	fs["/staking/cmd"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/staking/cmd/{{ .Name }}cli"].(os.FileInfo),
+		fs["/staking/cmd/{{ .Name }}d"].(os.FileInfo),
+	}
+	fs["/staking/cmd/{{ .Name }}cli"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/staking/cmd/{{ .Name }}cli/main.go.tmpl"].(os.FileInfo),
+	}
+	fs["/staking/cmd/{{ .Name }}d"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/staking/cmd/{{ .Name }}d/gentx.go.tmpl"].(os.FileInfo),
+		fs["/staking/cmd/{{ .Name }}d/main.go.tmpl"].(os.FileInfo),
 	}
 
 	return fs
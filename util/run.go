@@ -1,6 +1,7 @@
 package util
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -15,6 +16,8 @@ import (
 	"github.com/blocklayerhq/chainkit/config"
 	"github.com/blocklayerhq/chainkit/project"
 	"github.com/blocklayerhq/chainkit/ui"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ssh/terminal"
 )
 
 // DockerRun runs a command within the project's container.
@@ -24,27 +27,132 @@ func DockerRun(ctx context.Context, config *config.Config, p *project.Project, a
 
 // DockerRunWithFD is like DockerRun but accepts stdin/stdout/stderr.
 func DockerRunWithFD(ctx context.Context, config *config.Config, p *project.Project, stdin io.Reader, stdout, stderr io.Writer, args ...string) error {
+	return dockerRun(ctx, config, p, false, stdin, stdout, stderr, args...)
+}
+
+// DockerRunWithInput is like DockerRunWithFD but keeps stdin open, for
+// commands reading from it.
+func DockerRunWithInput(ctx context.Context, config *config.Config, p *project.Project, stdin io.Reader, stdout, stderr io.Writer, args ...string) error {
+	return dockerRun(ctx, config, p, true, stdin, stdout, stderr, args...)
+}
+
+func dockerRun(ctx context.Context, config *config.Config, p *project.Project, interactive bool, stdin io.Reader, stdout, stderr io.Writer, args ...string) error {
 	var (
 		daemonDirContainer = path.Join("/", "root", "."+p.Binaries.Daemon)
 		cliDirContainer    = path.Join("/", "root", "."+p.Binaries.CLI)
 	)
 
-	cmd := []string{
-		"run", "--rm",
+	cmd := []string{"run", "--rm"}
+	if interactive {
+		cmd = append(cmd, "-i")
+	}
+	cmd = append(cmd,
 		"-p", fmt.Sprintf("%d:26656", config.Ports.TendermintP2P),
 		"-p", fmt.Sprintf("%d:26657", config.Ports.TendermintRPC),
-		"-v", config.StateDir() + ":" + daemonDirContainer,
-		"-v", config.CLIDir() + ":" + cliDirContainer,
+		"-v", config.StateDir()+":"+daemonDirContainer,
+		"-v", config.CLIDir()+":"+cliDirContainer,
 		"-l", "chainkit.cosmos.daemon",
+		"-l", "chainkit.project="+p.Name,
+		"-l", "chainkit.root="+config.RootDir,
+		p.Image+":latest",
+		p.Binaries.Daemon,
+	)
+	cmd = append(cmd, args...)
+
+	return RunWithFD(ctx, stdin, stdout, stderr, "docker", cmd...)
+}
+
+// DockerRunDaemonOneOff runs the application daemon within a one-off
+// container, for commands which don't need the node (e.g. --help). Unlike
+// DockerRun, no ports are published and nothing is mounted, so that it can
+// run alongside the node.
+func DockerRunDaemonOneOff(ctx context.Context, p *project.Project, stdout, stderr io.Writer, args ...string) error {
+	cmd := []string{"run", "--rm",
 		"-l", "chainkit.project=" + p.Name,
 		p.Image + ":latest",
 		p.Binaries.Daemon,
 	}
 	cmd = append(cmd, args...)
 
+	return RunWithFD(ctx, nil, stdout, stderr, "docker", cmd...)
+}
+
+// DaemonContainer returns the ID of the running daemon container of the node
+// rooted at config.RootDir.
+func DaemonContainer(ctx context.Context, config *config.Config) (string, error) {
+	var out bytes.Buffer
+	err := RunWithFD(ctx, nil, &out, ioutil.Discard, "docker", "ps", "-q",
+		"-f", "label=chainkit.cosmos.daemon",
+		"-f", "label=chainkit.root="+config.RootDir,
+	)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to list containers")
+	}
+	ids := strings.Fields(out.String())
+	if len(ids) == 0 {
+		return "", errors.New("the daemon is not running")
+	}
+	return ids[0], nil
+}
+
+// DockerRunCLI runs the application CLI within a one-off container.
+// Unlike DockerRun, no ports are published and only the CLI directory is mounted.
+// A TTY is allocated if stdin is a terminal, so that the CLI can prompt for passwords.
+func DockerRunCLI(ctx context.Context, config *config.Config, p *project.Project, args ...string) error {
+	return dockerRunCLI(ctx, config, p, IsTerminal(os.Stdin), os.Stdin, os.Stdout, os.Stderr, args...)
+}
+
+// DockerRunCLIWithFD is like DockerRunCLI but accepts stdin/stdout/stderr.
+func DockerRunCLIWithFD(ctx context.Context, config *config.Config, p *project.Project, stdin io.Reader, stdout, stderr io.Writer, args ...string) error {
+	return dockerRunCLI(ctx, config, p, false, stdin, stdout, stderr, args...)
+}
+
+func dockerRunCLI(ctx context.Context, config *config.Config, p *project.Project, tty bool, stdin io.Reader, stdout, stderr io.Writer, args ...string) error {
+	cliDirContainer := path.Join("/", "root", "."+p.Binaries.CLI)
+
+	cmd := []string{"run", "--rm", "-i"}
+	if tty {
+		cmd = append(cmd, "-t")
+	}
+	cmd = append(cmd,
+		"-v", config.CLIDir()+":"+cliDirContainer,
+		"-l", "chainkit.cosmos.cli",
+		"-l", "chainkit.project="+p.Name,
+		p.Image+":latest",
+		p.Binaries.CLI,
+	)
+	cmd = append(cmd, args...)
+
 	return RunWithFD(ctx, stdin, stdout, stderr, "docker", cmd...)
 }
 
+// DockerExecCLI runs the application CLI within a running container.
+// A TTY is allocated if stdin is a terminal.
+func DockerExecCLI(ctx context.Context, containerID string, p *project.Project, args ...string) error {
+	return dockerExecCLI(ctx, containerID, p, IsTerminal(os.Stdin), os.Stdin, os.Stdout, os.Stderr, args...)
+}
+
+// DockerExecCLIWithFD is like DockerExecCLI but accepts stdin/stdout/stderr.
+func DockerExecCLIWithFD(ctx context.Context, containerID string, p *project.Project, stdin io.Reader, stdout, stderr io.Writer, args ...string) error {
+	return dockerExecCLI(ctx, containerID, p, false, stdin, stdout, stderr, args...)
+}
+
+func dockerExecCLI(ctx context.Context, containerID string, p *project.Project, tty bool, stdin io.Reader, stdout, stderr io.Writer, args ...string) error {
+	cmd := []string{"exec", "-i"}
+	if tty {
+		cmd = append(cmd, "-t")
+	}
+	cmd = append(cmd, containerID, p.Binaries.CLI)
+	cmd = append(cmd, args...)
+
+	return RunWithFD(ctx, stdin, stdout, stderr, "docker", cmd...)
+}
+
+// IsTerminal returns true if f is a terminal.
+func IsTerminal(f *os.File) bool {
+	return terminal.IsTerminal(int(f.Fd()))
+}
+
 // DockerLoad loads an image into docker from an io.Reader
 func DockerLoad(ctx context.Context, image io.Reader) error {
 	errCh := make(chan error)
@@ -79,6 +187,12 @@ func RunWithFD(ctx context.Context, stdin io.Reader, stdout, stderr io.Writer, c
 	cmd.Stdout = stdout
 	cmd.Stderr = stderr
 
+	return RunCmd(ctx, cmd)
+}
+
+// RunCmd starts an already configured command and waits for it to complete.
+// The command is gracefully shut down if the context is cancelled.
+func RunCmd(ctx context.Context, cmd *exec.Cmd) error {
 	if err := cmd.Start(); err != nil {
 		return err
 	}